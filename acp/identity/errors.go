@@ -17,11 +17,15 @@ import (
 )
 
 const (
-	errDIDCreation = "could not produce did for key"
+	errDIDCreation       = "could not produce did for key"
+	errInvalidDID        = "invalid did"
+	errInvalidDIDKeyType = "invalid did key type"
 )
 
 var (
-	ErrDIDCreation = errors.New(errDIDCreation)
+	ErrDIDCreation       = errors.New(errDIDCreation)
+	ErrInvalidDID        = errors.New(errInvalidDID)
+	ErrInvalidDIDKeyType = errors.New(errInvalidDIDKeyType)
 )
 
 func newErrDIDCreation(inner error, keytype string, pubKey []byte) error {
@@ -32,3 +36,16 @@ func newErrDIDCreation(inner error, keytype string, pubKey []byte) error {
 		errors.NewKV("PubKey", hex.EncodeToString(pubKey)),
 	)
 }
+
+func newErrInvalidDID(inner error, did string) error {
+	return errors.Wrap(errInvalidDID, inner, errors.NewKV("DID", did))
+}
+
+func newErrInvalidDIDKeyType(did string, keyType string) error {
+	return errors.New(
+		errInvalidDIDKeyType,
+		errors.NewKV("DID", did),
+		errors.NewKV("Expected", "secp256k1"),
+		errors.NewKV("Actual", keyType),
+	)
+}
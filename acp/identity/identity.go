@@ -149,6 +149,22 @@ func DIDFromPublicKey(publicKey *secp256k1.PublicKey) (string, error) {
 	return didFromPublicKey(publicKey, key.CreateDIDKey)
 }
 
+// PublicKeyFromDID returns the secp256k1 public key held by the given did:key.
+func PublicKeyFromDID(did string) (*secp256k1.PublicKey, error) {
+	bytes, keyType, err := key.DIDKey(did).Decode()
+	if err != nil {
+		return nil, newErrInvalidDID(err, did)
+	}
+	if keyType != crypto.SECP256k1 {
+		return nil, newErrInvalidDIDKeyType(did, string(keyType))
+	}
+	publicKey, err := secp256k1.ParsePubKey(bytes)
+	if err != nil {
+		return nil, newErrInvalidDID(err, did)
+	}
+	return publicKey, nil
+}
+
 // didFromPublicKey produces a did from a secp256k1 key and a producer function
 func didFromPublicKey(publicKey *secp256k1.PublicKey, producer didProducer) (string, error) {
 	bytes := publicKey.SerializeUncompressed()
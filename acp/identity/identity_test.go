@@ -11,6 +11,7 @@
 package identity
 
 import (
+	"encoding/hex"
 	"fmt"
 	"testing"
 
@@ -76,3 +77,18 @@ func Test_RawIdentityGenerationIsNotFixed_ReturnsUniqueRawIdentites(t *testing.T
 	require.NotEqual(t, newIdentity1.PublicKey, newIdentity2.PublicKey)
 	require.NotEqual(t, newIdentity1.DID, newIdentity2.DID)
 }
+
+func Test_PublicKeyFromDID_ReturnsPublicKeyOfDID(t *testing.T) {
+	newIdentity, err := Generate()
+	require.NoError(t, err)
+
+	publicKey, err := PublicKeyFromDID(newIdentity.DID)
+	require.NoError(t, err)
+
+	require.Equal(t, newIdentity.PublicKey, hex.EncodeToString(publicKey.SerializeCompressed()))
+}
+
+func Test_PublicKeyFromDID_WithInvalidDID_ReturnsError(t *testing.T) {
+	_, err := PublicKeyFromDID("did:key:invalid")
+	require.ErrorIs(t, err, ErrInvalidDID)
+}
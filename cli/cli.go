@@ -90,6 +90,7 @@ func NewDefraCommand() *cobra.Command {
 		MakeIndexCreateCommand(),
 		MakeIndexDropCommand(),
 		MakeIndexListCommand(),
+		MakeIndexVerifyCommand(),
 	)
 
 	backup := MakeBackupCommand()
@@ -98,6 +99,11 @@ func NewDefraCommand() *cobra.Command {
 		MakeBackupImportCommand(),
 	)
 
+	dag := MakeDAGCommand()
+	dag.AddCommand(
+		MakeDAGCompactCommand(),
+	)
+
 	tx := MakeTxCommand()
 	tx.AddCommand(
 		MakeTxCreateCommand(),
@@ -110,6 +116,7 @@ func NewDefraCommand() *cobra.Command {
 		MakeCollectionGetCommand(),
 		MakeCollectionListDocIDsCommand(),
 		MakeCollectionDeleteCommand(),
+		MakeCollectionRevertCommand(),
 		MakeCollectionUpdateCommand(),
 		MakeCollectionCreateCommand(),
 		MakeCollectionDescribeCommand(),
@@ -127,6 +134,7 @@ func NewDefraCommand() *cobra.Command {
 		index,
 		p2p,
 		backup,
+		dag,
 		tx,
 		collection,
 	)
@@ -0,0 +1,59 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/ipfs/go-cid"
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func MakeCollectionRevertCommand() *cobra.Command {
+	var argDocID string
+	var argCid string
+	var cmd = &cobra.Command{
+		Use:   "revert [-i --identity] --docID <docID> --cid <cid>",
+		Short: "Revert a document to a previous version.",
+		Long: `Revert a document to a previous version.
+
+The revert is written as a new update of the document, the history of the document is kept.
+
+Example: revert by docID and version cid:
+  defradb client collection revert --name User --docID bae-123 \
+  	--cid bafybeieelb43ol5e5jiick2p7k4p577ph72ecwcuowlhbops4hpz24zhz4
+
+Example: revert with identity:
+  defradb client collection revert --name User --docID bae-123 \
+  	--cid bafybeieelb43ol5e5jiick2p7k4p577ph72ecwcuowlhbops4hpz24zhz4 \
+  	-i 028d53f37a19afb9a0dbc5b4be30c65731479ee8cfa0c9bc8f8bf198cc3c075f
+		`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			col, ok := tryGetContextCollection(cmd)
+			if !ok {
+				return cmd.Usage()
+			}
+
+			docID, err := client.NewDocIDFromString(argDocID)
+			if err != nil {
+				return err
+			}
+			version, err := cid.Decode(argCid)
+			if err != nil {
+				return err
+			}
+			return col.Revert(cmd.Context(), docID, version)
+		},
+	}
+	cmd.Flags().StringVar(&argDocID, "docID", "", "Document ID")
+	cmd.Flags().StringVar(&argCid, "cid", "", "Version CID to revert the document to")
+	return cmd
+}
@@ -43,31 +43,33 @@ var configPaths = []string{
 
 // configFlags is a mapping of cli flag names to config keys to bind.
 var configFlags = map[string]string{
-	"log-level":          "log.level",
-	"log-output":         "log.output",
-	"log-format":         "log.format",
-	"log-stacktrace":     "log.stacktrace",
-	"log-source":         "log.source",
-	"log-overrides":      "log.overrides",
-	"no-log-color":       "log.colordisabled",
-	"url":                "api.address",
-	"max-txn-retries":    "datastore.maxtxnretries",
-	"store":              "datastore.store",
-	"no-encryption":      "datastore.noencryption",
-	"valuelogfilesize":   "datastore.badger.valuelogfilesize",
-	"peers":              "net.peers",
-	"p2paddr":            "net.p2paddresses",
-	"no-p2p":             "net.p2pdisabled",
-	"allowed-origins":    "api.allowed-origins",
-	"pubkeypath":         "api.pubkeypath",
-	"privkeypath":        "api.privkeypath",
-	"keyring-namespace":  "keyring.namespace",
-	"keyring-backend":    "keyring.backend",
-	"keyring-path":       "keyring.path",
-	"no-keyring":         "keyring.disabled",
-	"source-hub-address": "acp.sourceHub.address",
-	"development":        "development",
-	"secret-file":        "secretfile",
+	"log-level":             "log.level",
+	"log-output":            "log.output",
+	"log-format":            "log.format",
+	"log-stacktrace":        "log.stacktrace",
+	"log-source":            "log.source",
+	"log-overrides":         "log.overrides",
+	"no-log-color":          "log.colordisabled",
+	"url":                   "api.address",
+	"max-txn-retries":       "datastore.maxtxnretries",
+	"store":                 "datastore.store",
+	"no-encryption":         "datastore.noencryption",
+	"valuelogfilesize":      "datastore.badger.valuelogfilesize",
+	"peers":                 "net.peers",
+	"p2paddr":               "net.p2paddresses",
+	"no-p2p":                "net.p2pdisabled",
+	"require-signed-blocks": "net.requiresignedblocks",
+	"block-timestamps":      "datastore.blocktimestamps",
+	"allowed-origins":       "api.allowed-origins",
+	"pubkeypath":            "api.pubkeypath",
+	"privkeypath":           "api.privkeypath",
+	"keyring-namespace":     "keyring.namespace",
+	"keyring-backend":       "keyring.backend",
+	"keyring-path":          "keyring.path",
+	"no-keyring":            "keyring.disabled",
+	"source-hub-address":    "acp.sourceHub.address",
+	"development":           "development",
+	"secret-file":           "secretfile",
 }
 
 // configDefaults contains default values for config entries.
@@ -78,12 +80,14 @@ var configDefaults = map[string]any{
 	"datastore.maxtxnretries":           5,
 	"datastore.store":                   "badger",
 	"datastore.badger.valuelogfilesize": 1 << 30,
+	"datastore.blocktimestamps":         false,
 	"development":                       false,
 	"net.p2pdisabled":                   false,
 	"net.p2paddresses":                  []string{"/ip4/127.0.0.1/tcp/9171"},
 	"net.peers":                         []string{},
 	"net.pubSubEnabled":                 true,
 	"net.relay":                         false,
+	"net.requiresignedblocks":           false,
 	"keyring.backend":                   "file",
 	"keyring.disabled":                  false,
 	"keyring.namespace":                 "defradb",
@@ -44,6 +44,7 @@ func TestLoadConfigNotExist(t *testing.T) {
 	assert.Equal(t, filepath.Join(rootdir, "data"), cfg.GetString("datastore.badger.path"))
 	assert.Equal(t, 1<<30, cfg.GetInt("datastore.badger.valuelogfilesize"))
 	assert.Equal(t, "badger", cfg.GetString("datastore.store"))
+	assert.Equal(t, false, cfg.GetBool("datastore.blocktimestamps"))
 
 	assert.Equal(t, "127.0.0.1:9181", cfg.GetString("api.address"))
 	assert.Equal(t, []string{}, cfg.GetStringSlice("api.allowed-origins"))
@@ -54,6 +55,7 @@ func TestLoadConfigNotExist(t *testing.T) {
 	assert.Equal(t, []string{"/ip4/127.0.0.1/tcp/9171"}, cfg.GetStringSlice("net.p2paddresses"))
 	assert.Equal(t, true, cfg.GetBool("net.pubsubenabled"))
 	assert.Equal(t, false, cfg.GetBool("net.relay"))
+	assert.Equal(t, false, cfg.GetBool("net.requiresignedblocks"))
 	assert.Equal(t, []string{}, cfg.GetStringSlice("net.peers"))
 
 	assert.Equal(t, "info", cfg.GetString("log.level"))
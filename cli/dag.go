@@ -0,0 +1,24 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func MakeDAGCommand() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "dag",
+		Short: "Interact with the document DAGs",
+		Long:  `Maintenance operations on the merkle DAGs holding the history of the documents.`,
+	}
+	return cmd
+}
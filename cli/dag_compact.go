@@ -0,0 +1,71 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func MakeDAGCompactCommand() *cobra.Command {
+	var keepHeight uint64
+	var maxAge time.Duration
+	var collections []string
+	var cmd = &cobra.Command{
+		Use:   "compact [--keep-height <height>] [--max-age <duration>] [-c --collections]",
+		Short: "Squash the history of documents into snapshots",
+		Long: `Squash the history of documents into snapshots and remove the squashed blocks from the blockstore.
+
+Versions of a document are squashed if they are more than --keep-height versions older than the
+current version, and if --max-age is provided, if they were created longer ago than the given
+duration. At least one of --keep-height or --max-age must be provided. The age of versions is
+only known if the node records block timestamps, --max-age returns an error otherwise.
+
+The current heads of the documents are always kept, so they can still be merged with the blocks
+of peers that hold the squashed history. Squashed versions can no longer be queried, and peers
+can no longer fetch the squashed history from this node.
+
+If the --collections flag is provided, only the documents of those collections are compacted.
+Otherwise, the documents of all collections are compacted.
+
+Example: keep the last 10 versions of every document:
+  defradb client dag compact --keep-height 10
+
+Example: squash the versions of the 'Users' documents that are older than a week:
+  defradb client dag compact --max-age 168h --collections Users`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store := mustGetContextStore(cmd)
+
+			for i := range collections {
+				collections[i] = strings.Trim(collections[i], " ")
+			}
+
+			result, err := store.CompactDAG(cmd.Context(), client.CompactionConfig{
+				KeepHeight:  keepHeight,
+				MaxAge:      maxAge,
+				Collections: collections,
+			})
+			if err != nil {
+				return err
+			}
+			return writeJSON(cmd, result)
+		},
+	}
+	cmd.Flags().Uint64Var(&keepHeight, "keep-height", 0, "Number of the most recent versions of each document to keep")
+	cmd.Flags().DurationVar(&maxAge, "max-age", 0, "Age after which versions of a document are squashed")
+	cmd.Flags().StringSliceVarP(&collections, "collections", "c", []string{}, "List of collections")
+	return cmd
+}
@@ -18,7 +18,7 @@ func MakeIndexCommand() *cobra.Command {
 	var cmd = &cobra.Command{
 		Use:   "index",
 		Short: "Manage collections' indexes of a running DefraDB instance",
-		Long:  `Manage (create, drop, or list) collection indexes on a DefraDB node.`,
+		Long:  `Manage (create, drop, list, or verify) collection indexes on a DefraDB node.`,
 	}
 
 	return cmd
@@ -11,6 +11,8 @@
 package cli
 
 import (
+	"encoding/json"
+
 	"github.com/spf13/cobra"
 
 	"github.com/sourcenetwork/defradb/client"
@@ -21,19 +23,52 @@ func MakeIndexCreateCommand() *cobra.Command {
 	var nameArg string
 	var fieldsArg []string
 	var uniqueArg bool
+	var typeArg string
+	var filterArg string
+	var backgroundArg bool
 	var cmd = &cobra.Command{
-		Use:   "create -c --collection <collection> --fields <fields> [-n --name <name>] [--unique]",
+		Use:   "create -c --collection <collection> --fields <fields> [-n --name <name>] [--unique] [--type <type>] [--filter <filter>] [--background]",
 		Short: "Creates a secondary index on a collection's field(s)",
 		Long: `Creates a secondary index on a collection's field(s).
 		
 The --name flag is optional. If not provided, a name will be generated automatically.
 The --unique flag is optional. If provided, the index will be unique.
+The --type flag is optional. If set to FULLTEXT, a full-text index will be created on a single
+String field, to be used by the _search filter operator. If set to VECTOR, an approximate nearest
+neighbour index will be created on a single vector field, to be used by the _similar argument.
+If set to GEOHASH, a spatial index will be created on a single GeoPoint field, to be used by the
+_withinRadius and _withinBox filter operators.
+The --filter flag is optional. If provided, only the documents matching the JSON filter are indexed,
+and the index is only used by queries with a filter implying it. Only value indexes can have a filter.
+A field can also be the path of a value inside a JSON field, such as 'meta.customerId', in which case
+the scalar value found at that path is indexed.
+The --background flag is optional. If provided, the existing documents are indexed in batches after
+the index is created, and the index is only used by queries once the build is ready. The progress of
+the build is reported by 'defradb client index list'.
 
 Example: create an index for 'Users' collection on 'name' field:
   defradb client index create --collection Users --fields name
 
 Example: create a named index for 'Users' collection on 'name' field:
-  defradb client index create --collection Users --fields name --name UsersByName`,
+  defradb client index create --collection Users --fields name --name UsersByName
+
+Example: create a full-text index for 'Products' collection on 'description' field:
+  defradb client index create --collection Products --fields description --type FULLTEXT
+
+Example: create a vector index for 'Products' collection on 'embedding' field:
+  defradb client index create --collection Products --fields embedding --type VECTOR
+
+Example: create a geohash index for 'Shops' collection on 'location' field:
+  defradb client index create --collection Shops --fields location --type GEOHASH
+
+Example: create an index for 'Orders' collection on 'customer' field holding only open orders:
+  defradb client index create --collection Orders --fields customer --filter '{"status": {"_eq": "open"}}'
+
+Example: create an index for 'Orders' collection on the 'customerId' value of the 'meta' JSON field:
+  defradb client index create --collection Orders --fields meta.customerId
+
+Example: create an index for 'Users' collection on 'name' field in the background:
+  defradb client index create --collection Users --fields name --background`,
 		ValidArgs: []string{"collection", "fields", "name"},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			store := mustGetContextStore(cmd)
@@ -43,9 +78,16 @@ Example: create a named index for 'Users' collection on 'name' field:
 				fields = append(fields, client.IndexedFieldDescription{Name: name})
 			}
 			desc := client.IndexDescription{
-				Name:   nameArg,
-				Fields: fields,
-				Unique: uniqueArg,
+				Name:       nameArg,
+				Fields:     fields,
+				Unique:     uniqueArg,
+				Type:       client.IndexType(typeArg),
+				Background: backgroundArg,
+			}
+			if filterArg != "" {
+				if err := json.Unmarshal([]byte(filterArg), &desc.Filter); err != nil {
+					return err
+				}
 			}
 			col, err := store.GetCollectionByName(cmd.Context(), collectionArg)
 			if err != nil {
@@ -62,6 +104,9 @@ Example: create a named index for 'Users' collection on 'name' field:
 	cmd.Flags().StringVarP(&nameArg, "name", "n", "", "Index name")
 	cmd.Flags().StringSliceVar(&fieldsArg, "fields", []string{}, "Fields to index")
 	cmd.Flags().BoolVarP(&uniqueArg, "unique", "u", false, "Make the index unique")
+	cmd.Flags().StringVar(&typeArg, "type", "", "Index type (FULLTEXT, VECTOR, GEOHASH)")
+	cmd.Flags().StringVar(&filterArg, "filter", "", "JSON filter of the documents to index")
+	cmd.Flags().BoolVar(&backgroundArg, "background", false, "Index the existing documents in the background")
 
 	return cmd
 }
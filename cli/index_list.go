@@ -23,6 +23,8 @@ func MakeIndexListCommand() *cobra.Command {
 		
 If the --collection flag is provided, only the indexes for that collection will be shown.
 Otherwise, all indexes in the database will be shown.
+The indexes created with --background report the state of their build (BUILDING, READY or FAILED)
+and the number of documents processed so far.
 
 Example: show all index for 'Users' collection:
   defradb client index list --collection Users`,
@@ -0,0 +1,63 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func MakeIndexVerifyCommand() *cobra.Command {
+	var repairArg bool
+	var cmd = &cobra.Command{
+		Use:   "verify <collection> [index] [--repair]",
+		Short: "Verify the entries of a collection's secondary indexes",
+		Long: `Verify the entries of a collection's secondary indexes against its documents.
+
+Every entry of the indexes is cross-checked with the documents of the collection. The documents
+with entries missing from an index, the documents with stale entries that no longer match their
+values, and the documents that no longer exist but still have entries are reported.
+
+If the index argument is provided, only that index is verified. Otherwise, all the indexes of the
+collection are verified. Vector indexes and indexes still being built can not be verified
+and are reported as skipped with the reason, unless requested by name.
+The --repair flag is optional. If provided, the missing entries are stored and the stale and
+orphaned entries are removed.
+
+Example: verify all the indexes of the 'Users' collection:
+  defradb client index verify Users
+
+Example: verify and repair the index 'UsersByName' of the 'Users' collection:
+  defradb client index verify Users UsersByName --repair`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store := mustGetContextStore(cmd)
+
+			col, err := store.GetCollectionByName(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			opts := client.IndexVerifyOptions{Repair: repairArg}
+			if len(args) > 1 {
+				opts.IndexName = args[1]
+			}
+			results, err := col.VerifyIndexes(cmd.Context(), opts)
+			if err != nil {
+				return err
+			}
+			return writeJSON(cmd, results)
+		},
+	}
+	cmd.Flags().BoolVar(&repairArg, "repair", false, "Fix the inconsistencies found")
+
+	return cmd
+}
@@ -70,6 +70,8 @@ func MakeStartCommand() *cobra.Command {
 				node.WithBadgerInMemory(cfg.GetString("datastore.store") == configStoreMemory),
 				// db options
 				db.WithMaxRetries(cfg.GetInt("datastore.MaxTxnRetries")),
+				db.WithRequireSignedBlocks(cfg.GetBool("net.requireSignedBlocks")),
+				db.WithEnableTimestamps(cfg.GetBool("datastore.blockTimestamps")),
 				// net node options
 				net.WithListenAddresses(cfg.GetStringSlice("net.p2pAddresses")...),
 				net.WithEnablePubSub(cfg.GetBool("net.pubSubEnabled")),
@@ -211,6 +213,12 @@ func MakeStartCommand() *cobra.Command {
 		cfg.GetString(configFlags["store"]),
 		"Specify the datastore to use (supported: badger, memory)",
 	)
+	cmd.PersistentFlags().Bool(
+		"block-timestamps",
+		cfg.GetBool(configFlags["block-timestamps"]),
+		"Record the hybrid logical clock time at which blocks are created, "+
+			"required by asOf queries and age based DAG compactions",
+	)
 	cmd.PersistentFlags().Int(
 		"valuelogfilesize",
 		cfg.GetInt(configFlags["valuelogfilesize"]),
@@ -226,6 +234,11 @@ func MakeStartCommand() *cobra.Command {
 		cfg.GetBool(configFlags["no-p2p"]),
 		"Disable the peer-to-peer network synchronization system",
 	)
+	cmd.PersistentFlags().Bool(
+		"require-signed-blocks",
+		cfg.GetBool(configFlags["require-signed-blocks"]),
+		"Reject blocks received from other peers that are not signed by their author",
+	)
 	cmd.PersistentFlags().StringArray(
 		"allowed-origins",
 		cfg.GetStringSlice(configFlags["allowed-origins"]),
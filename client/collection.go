@@ -13,6 +13,7 @@ package client
 import (
 	"context"
 
+	"github.com/ipfs/go-cid"
 	"github.com/sourcenetwork/immutable"
 )
 
@@ -73,6 +74,13 @@ type Collection interface {
 	// This includes data, block, and head storage.
 	Delete(ctx context.Context, docID DocID) (bool, error)
 
+	// Revert reverts the document with the given DocID to the state it had at the given version.
+	//
+	// The history of the document is kept, the revert is written as a new update that makes
+	// the current state of the document equal to the state at the given version. If the document
+	// doesn't exist, it will return a ErrDocumentNotFoundOrNotAuthorized error.
+	Revert(ctx context.Context, docID DocID, version cid.Cid) error
+
 	// Exists checks if a given document exists with supplied DocID.
 	//
 	// Will return true if a matching document exists, otherwise will return false.
@@ -122,6 +130,12 @@ type Collection interface {
 
 	// GetIndexes returns all the indexes that exist on the collection.
 	GetIndexes(ctx context.Context) ([]IndexDescription, error)
+
+	// VerifyIndexes cross-checks the entries of the indexes of the collection against its documents.
+	//
+	// It reports the documents with missing or stale entries, and the entries of documents that
+	// no longer exist. If [IndexVerifyOptions.Repair] is set, the inconsistencies are fixed in place.
+	VerifyIndexes(ctx context.Context, opts IndexVerifyOptions) ([]IndexVerifyResult, error)
 }
 
 // DocIDResult wraps the result of an attempt at a DocID retrieval operation.
@@ -0,0 +1,40 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package client
+
+import (
+	"time"
+)
+
+// CompactionConfig holds the configuration parameters for DAG compactions.
+//
+// A composite block, along with the field blocks it links to, is pruned if it satisfies every
+// configured criterion. At least one of KeepHeight or MaxAge must be set.
+type CompactionConfig struct {
+	// KeepHeight is the number of the most recent versions of each document to keep.
+	KeepHeight uint64 `json:"keepHeight"`
+	// MaxAge is the age after which versions of a document are pruned.
+	//
+	// Versions are dated using block timestamps, so this has no effect on blocks created
+	// without timestamps.
+	MaxAge time.Duration `json:"maxAge"`
+	// List of collection names to select which one to compact. If empty, all collections
+	// are compacted.
+	Collections []string `json:"collections"`
+}
+
+// CompactionResult is the result of a DAG compaction.
+type CompactionResult struct {
+	// Documents is the number of documents that had blocks pruned.
+	Documents int `json:"documents"`
+	// PrunedBlocks is the number of blocks removed from the blockstore.
+	PrunedBlocks int `json:"prunedBlocks"`
+}
@@ -24,12 +24,16 @@ const (
 	COMPOSITE
 	PN_COUNTER
 	P_COUNTER
+	OR_SET
+	MV_REGISTER
+	RGA_TEXT
+	LWW_MAP
 )
 
 // IsSupportedFieldCType returns true if the type is supported as a document field type.
 func (t CType) IsSupportedFieldCType() bool {
 	switch t {
-	case NONE_CRDT, LWW_REGISTER, PN_COUNTER, P_COUNTER:
+	case NONE_CRDT, LWW_REGISTER, PN_COUNTER, P_COUNTER, OR_SET, MV_REGISTER, RGA_TEXT, LWW_MAP:
 		return true
 	default:
 		return false
@@ -44,6 +48,14 @@ func (t CType) IsCompatibleWith(kind FieldKind) bool {
 			return true
 		}
 		return false
+	case OR_SET:
+		return kind.IsArray() && !kind.IsObject()
+	case MV_REGISTER:
+		return !kind.IsObject()
+	case RGA_TEXT:
+		return kind == FieldKind_NILLABLE_STRING
+	case LWW_MAP:
+		return kind == FieldKind_NILLABLE_JSON
 	default:
 		return true
 	}
@@ -64,6 +76,14 @@ func (t CType) String() string {
 		return "pncounter"
 	case P_COUNTER:
 		return "pcounter"
+	case OR_SET:
+		return "orset"
+	case MV_REGISTER:
+		return "mvregister"
+	case RGA_TEXT:
+		return "rga"
+	case LWW_MAP:
+		return "lwwmap"
 	default:
 		return "unknown"
 	}
@@ -240,6 +240,14 @@ type Store interface {
 	// when making this call.
 	RefreshViews(context.Context, CollectionFetchOptions) error
 
+	// CompactDAG squashes the history of the documents that is older than the configured height or
+	// age into snapshots, and removes the squashed blocks from the blockstore.
+	//
+	// The current heads of the documents are always kept, so they can still be merged with the
+	// blocks of peers that hold the pruned history. Versions that have been pruned can no longer
+	// be queried.
+	CompactDAG(context.Context, CompactionConfig) (CompactionResult, error)
+
 	// SetMigration sets the migration for all collections using the given source-destination schema version IDs.
 	//
 	// There may only be one migration per collection version.  If another migration was registered it will be
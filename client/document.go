@@ -14,6 +14,7 @@ import (
 	"encoding/json"
 	"errors"
 	"regexp"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -251,6 +252,17 @@ func validateFieldSchema(val any, field FieldDefinition) (NormalValue, error) {
 		return NewNormalString(v), nil
 	}
 
+	if vectorKind, ok := field.Kind.(*VectorKind); ok {
+		v, err := getArray(val, getFloat64)
+		if err != nil {
+			return nil, err
+		}
+		if len(v) != vectorKind.Dimension {
+			return nil, NewErrVectorDimensionMismatch(field.Name, vectorKind.Dimension, len(v))
+		}
+		return NewNormalFloatArray(v), nil
+	}
+
 	switch field.Kind {
 	case FieldKind_DocID:
 		v, err := getString(val)
@@ -363,6 +375,13 @@ func validateFieldSchema(val any, field FieldDefinition) (NormalValue, error) {
 			return nil, err
 		}
 		return NewNormalJSON(&JSON{v}), nil
+
+	case FieldKind_NILLABLE_GEOPOINT:
+		v, err := getGeoPoint(val)
+		if err != nil {
+			return nil, err
+		}
+		return NewNormalGeoPoint(v), nil
 	}
 
 	return nil, NewErrUnhandledType("FieldKind", field.Kind)
@@ -504,6 +523,51 @@ func getJSON(v any) (any, error) {
 	}
 }
 
+// getGeoPoint returns the geo point of the given value, which must either be a [GeoPoint] or
+// an object with `lat` and `lon` number properties.
+func getGeoPoint(v any) (GeoPoint, error) {
+	var lat, lon any
+	switch val := v.(type) {
+	case GeoPoint:
+		return NewGeoPoint(val.Lat, val.Lon)
+	case *GeoPoint:
+		return NewGeoPoint(val.Lat, val.Lon)
+	case *fastjson.Value:
+		obj, err := val.Object()
+		if err != nil {
+			return GeoPoint{}, err
+		}
+		if obj.Len() != 2 {
+			return GeoPoint{}, NewErrUnexpectedType[GeoPoint]("field", v)
+		}
+		latVal, lonVal := obj.Get(GeoPointLatFieldName), obj.Get(GeoPointLonFieldName)
+		if latVal == nil || lonVal == nil {
+			return GeoPoint{}, NewErrUnexpectedType[GeoPoint]("field", v)
+		}
+		lat, lon = latVal, lonVal
+	case map[string]any:
+		if len(val) != 2 {
+			return GeoPoint{}, NewErrUnexpectedType[GeoPoint]("field", v)
+		}
+		lat = val[GeoPointLatFieldName]
+		lon = val[GeoPointLonFieldName]
+	default:
+		return GeoPoint{}, NewErrUnexpectedType[GeoPoint]("field", v)
+	}
+	if lat == nil || lon == nil {
+		return GeoPoint{}, NewErrUnexpectedType[GeoPoint]("field", v)
+	}
+	latVal, err := getFloat64(lat)
+	if err != nil {
+		return GeoPoint{}, err
+	}
+	lonVal, err := getFloat64(lon)
+	if err != nil {
+		return GeoPoint{}, err
+	}
+	return NewGeoPoint(latVal, lonVal)
+}
+
 func getArray[T any](
 	v any,
 	typeGetter func(any) (T, error),
@@ -740,6 +804,55 @@ func (doc *Document) Set(field string, value any) error {
 	return doc.setCBOR(fd.Typ, field, val)
 }
 
+// Splice replaces the range of characters of a String field described by the given splice.
+//
+// The splice is kept along with the new value until the document is saved, so that text CRDTs
+// can insert and delete the characters at the spliced positions instead of diffing the whole
+// value. It is only kept if the field has not been set as a whole since it was last saved.
+func (doc *Document) Splice(splice request.TextSplice) error {
+	fd, exists := doc.collectionDefinition.GetFieldByName(splice.Field)
+	if !exists {
+		return NewErrFieldNotExist(splice.Field)
+	}
+	if fd.Kind != FieldKind_NILLABLE_STRING {
+		return NewErrSpliceFieldNotString(splice.Field)
+	}
+
+	current, err := doc.TryGetValue(splice.Field)
+	if err != nil {
+		return err
+	}
+	var text []rune
+	var splices []request.TextSplice
+	keepSplices := true
+	if current != nil {
+		if str, ok := current.Value().(string); ok {
+			text = []rune(str)
+		}
+		splices = current.splices
+		keepSplices = !current.IsDirty() || len(splices) > 0
+	}
+
+	if splice.Index < 0 || splice.Delete < 0 || splice.Index+splice.Delete > len(text) {
+		return NewErrSpliceOutOfRange(splice.Field, splice.Index, splice.Delete, len(text))
+	}
+
+	result := make([]rune, 0, len(text)-splice.Delete+len(splice.Insert))
+	result = append(result, text[:splice.Index]...)
+	result = append(result, []rune(splice.Insert)...)
+	result = append(result, text[splice.Index+splice.Delete:]...)
+
+	val, err := validateFieldSchema(string(result), fd)
+	if err != nil {
+		return err
+	}
+	value := NewFieldValue(fd.Typ, val)
+	if keepSplices {
+		value.splices = append(slices.Clone(splices), splice)
+	}
+	return doc.set(fd.Typ, splice.Field, value)
+}
+
 func (doc *Document) set(t CType, field string, value *FieldValue) error {
 	doc.mu.Lock()
 	defer doc.mu.Unlock()
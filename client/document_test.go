@@ -18,6 +18,7 @@ import (
 
 	"github.com/sourcenetwork/immutable"
 
+	"github.com/sourcenetwork/defradb/client/request"
 	ccid "github.com/sourcenetwork/defradb/internal/core/cid"
 )
 
@@ -287,3 +288,58 @@ func TestIsJSONArray(t *testing.T) {
 		})
 	}
 }
+
+func TestSplice_WithSavedValue_KeepsSplices(t *testing.T) {
+	doc, err := NewDocFromJSON(testJSONObj, def)
+	require.NoError(t, err)
+	doc.Clean()
+
+	splices := []request.TextSplice{
+		{Field: "Name", Index: 4, Insert: "ny"},
+		{Field: "Name", Index: 0, Delete: 1, Insert: "D"},
+	}
+	for _, splice := range splices {
+		err = doc.Splice(splice)
+		require.NoError(t, err)
+	}
+
+	value, err := doc.GetValue("Name")
+	require.NoError(t, err)
+	assert.Equal(t, "Dohnny", value.Value())
+	assert.Equal(t, splices, value.Splices())
+
+	doc.Clean()
+	assert.Nil(t, value.Splices())
+}
+
+func TestSplice_WithValueSetAsWhole_DropsSplices(t *testing.T) {
+	doc, err := NewDocFromJSON(testJSONObj, def)
+	require.NoError(t, err)
+	doc.Clean()
+
+	err = doc.Set("Name", "Bob")
+	require.NoError(t, err)
+	err = doc.Splice(request.TextSplice{Field: "Name", Index: 3, Insert: "by"})
+	require.NoError(t, err)
+
+	value, err := doc.GetValue("Name")
+	require.NoError(t, err)
+	assert.Equal(t, "Bobby", value.Value())
+	assert.Nil(t, value.Splices())
+}
+
+func TestSplice_OutOfRange_Error(t *testing.T) {
+	doc, err := NewDocFromJSON(testJSONObj, def)
+	require.NoError(t, err)
+
+	err = doc.Splice(request.TextSplice{Field: "Name", Index: 3, Delete: 2})
+	require.ErrorIs(t, err, ErrSpliceOutOfRange)
+}
+
+func TestSplice_OnNonStringField_Error(t *testing.T) {
+	doc, err := NewDocFromJSON(testJSONObj, def)
+	require.NoError(t, err)
+
+	err = doc.Splice(request.TextSplice{Field: "Age", Index: 0, Insert: "1"})
+	require.ErrorIs(t, err, ErrSpliceFieldNotString)
+}
@@ -35,6 +35,10 @@ const (
 	errCanNotMakeNormalNilFromFieldKind    string = "can not make normal nil from field kind"
 	errFailedToParseKind                   string = "failed to parse kind"
 	errCannotSetRelationFromSecondarySide  string = "cannot set relation from secondary side"
+	errVectorDimensionMismatch             string = "vector dimension mismatch"
+	errInvalidGeoPoint                     string = "invalid geo point"
+	errSpliceFieldNotString                string = "splice can only be applied to String fields"
+	errSpliceOutOfRange                    string = "splice is out of the range of the field value"
 )
 
 // Errors returnable from this package.
@@ -62,6 +66,10 @@ var (
 	ErrCanNotMakeNormalNilFromFieldKind     = errors.New(errCanNotMakeNormalNilFromFieldKind)
 	ErrCollectionNotFound                   = errors.New(errCollectionNotFound)
 	ErrFailedToParseKind                    = errors.New(errFailedToParseKind)
+	ErrVectorDimensionMismatch              = errors.New(errVectorDimensionMismatch)
+	ErrInvalidGeoPoint                      = errors.New(errInvalidGeoPoint)
+	ErrSpliceFieldNotString                 = errors.New(errSpliceFieldNotString)
+	ErrSpliceOutOfRange                     = errors.New(errSpliceOutOfRange)
 )
 
 // NewErrFieldNotExist returns an error indicating that the given field does not exist.
@@ -195,3 +203,42 @@ func ReviveError(message string) error {
 func NewErrCannotSetRelationFromSecondarySide(name string) error {
 	return errors.New(errCannotSetRelationFromSecondarySide, errors.NewKV("Name", name))
 }
+
+// NewErrVectorDimensionMismatch returns an error indicating that a vector given for the
+// given field does not have the dimension of the field.
+func NewErrVectorDimensionMismatch(name string, expected int, actual int) error {
+	return errors.New(
+		errVectorDimensionMismatch,
+		errors.NewKV("Field", name),
+		errors.NewKV("Expected", expected),
+		errors.NewKV("Actual", actual),
+	)
+}
+
+// NewErrInvalidGeoPoint returns an error indicating that the given latitude or longitude
+// is out of range.
+func NewErrInvalidGeoPoint(lat float64, lon float64) error {
+	return errors.New(
+		errInvalidGeoPoint,
+		errors.NewKV("Lat", lat),
+		errors.NewKV("Lon", lon),
+	)
+}
+
+// NewErrSpliceFieldNotString returns an error indicating that a splice was applied to
+// a field that is not a String field.
+func NewErrSpliceFieldNotString(field string) error {
+	return errors.New(errSpliceFieldNotString, errors.NewKV("Field", field))
+}
+
+// NewErrSpliceOutOfRange returns an error indicating that the range of characters of
+// a splice is not within the value of the field.
+func NewErrSpliceOutOfRange(field string, index int, delete int, length int) error {
+	return errors.New(
+		errSpliceOutOfRange,
+		errors.NewKV("Field", field),
+		errors.NewKV("Index", index),
+		errors.NewKV("Delete", delete),
+		errors.NewKV("Length", length),
+	)
+}
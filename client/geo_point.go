@@ -0,0 +1,44 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package client
+
+const (
+	// GeoPointLatFieldName is the name of the latitude property of a geo point.
+	GeoPointLatFieldName = "lat"
+	// GeoPointLonFieldName is the name of the longitude property of a geo point.
+	GeoPointLonFieldName = "lon"
+)
+
+// GeoPoint is a geographic location given in decimal degrees.
+type GeoPoint struct {
+	// Lat is the latitude of the point, between -90 and 90.
+	Lat float64 `json:"lat"`
+	// Lon is the longitude of the point, between -180 and 180.
+	Lon float64 `json:"lon"`
+}
+
+// NewGeoPoint returns a new GeoPoint with the given latitude and longitude.
+//
+// An error is returned if either of them is out of range.
+func NewGeoPoint(lat float64, lon float64) (GeoPoint, error) {
+	if lat < -90 || lat > 90 || lon < -180 || lon > 180 {
+		return GeoPoint{}, NewErrInvalidGeoPoint(lat, lon)
+	}
+	return GeoPoint{Lat: lat, Lon: lon}, nil
+}
+
+// ToMap returns the point as a map of its properties.
+func (p GeoPoint) ToMap() map[string]any {
+	return map[string]any{
+		GeoPointLatFieldName: p.Lat,
+		GeoPointLonFieldName: p.Lon,
+	}
+}
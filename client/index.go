@@ -12,18 +12,68 @@ package client
 
 import (
 	"context"
+	"slices"
+	"strings"
 
+	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/datastore"
 )
 
+// IndexedFieldPathSeparator separates the name of a JSON field from the path of the
+// indexed value inside of it, for example `meta.customerId`.
+const IndexedFieldPathSeparator = "."
+
 // IndexFieldDescription describes how a field is being indexed.
 type IndexedFieldDescription struct {
 	// Name contains the name of the field.
+	//
+	// It can also be the path of a value inside a JSON field, for example `meta.customerId`,
+	// in which case the scalar value found at that path is indexed.
 	Name string
 	// Descending indicates whether the field is indexed in descending order.
 	Descending bool
 }
 
+// FieldName returns the name of the indexed field of the collection.
+func (f IndexedFieldDescription) FieldName() string {
+	name, _, _ := strings.Cut(f.Name, IndexedFieldPathSeparator)
+	return name
+}
+
+// JSONPath returns the path of the indexed value inside the JSON field, or nil if
+// the whole field is indexed.
+func (f IndexedFieldDescription) JSONPath() []string {
+	_, path, found := strings.Cut(f.Name, IndexedFieldPathSeparator)
+	if !found {
+		return nil
+	}
+	return strings.Split(path, IndexedFieldPathSeparator)
+}
+
+// IndexType is the type of an index.
+type IndexType string
+
+const (
+	// IndexTypeValue is the default index type.
+	//
+	// It stores the values of the indexed fields so that documents can be fetched by value.
+	IndexTypeValue IndexType = ""
+	// IndexTypeFullText is an inverted index of the words of a single String field.
+	//
+	// It is used by the `_search` filter operator to fetch and rank the documents
+	// matching a text query.
+	IndexTypeFullText IndexType = "FULLTEXT"
+	// IndexTypeVector is an approximate nearest neighbour index of a single vector field.
+	//
+	// It is used by `_similar` queries to fetch the documents nearest to a vector.
+	IndexTypeVector IndexType = "VECTOR"
+	// IndexTypeGeoHash is a geohash index of a single GeoPoint field.
+	//
+	// It is used by the `_withinRadius` and `_withinBox` filter operators to fetch the
+	// documents located in the cells covering the filtered area.
+	IndexTypeGeoHash IndexType = "GEOHASH"
+)
+
 // IndexDescription describes an index.
 type IndexDescription struct {
 	// Name contains the name of the index.
@@ -34,6 +84,120 @@ type IndexDescription struct {
 	Fields []IndexedFieldDescription
 	// Unique indicates whether the index is unique.
 	Unique bool
+	// Type is the type of the index.
+	Type IndexType
+	// Filter, if set, restricts the index to the documents matching it, for example
+	// `{"status": {"_eq": "open"}}`. Only value indexes can have a filter.
+	//
+	// Such an index is only used by queries with a filter implying the filter of the index.
+	Filter map[string]any `json:",omitempty"`
+	// Background indicates whether the existing documents are indexed asynchronously.
+	//
+	// If set, the index is created straight away and the existing documents are indexed
+	// in batches after the transaction creating it is committed. The progress of the
+	// build is reported by [Build].
+	Background bool `json:",omitempty"`
+	// Build contains the progress of the background build of the index.
+	//
+	// It is nil if the index was built synchronously.
+	Build *IndexBuildStatus `json:",omitempty"`
+}
+
+// IsBuilding returns true if the index is still being built in the background.
+//
+// Such an index is kept up to date with the documents already indexed but can not be
+// used to fetch documents yet.
+func (d IndexDescription) IsBuilding() bool {
+	return d.Build != nil && d.Build.State != IndexBuildStateReady
+}
+
+// IndexBuildState is the state of the background build of an index.
+type IndexBuildState string
+
+const (
+	// IndexBuildStateBuilding indicates that the existing documents are still being indexed.
+	IndexBuildStateBuilding IndexBuildState = "BUILDING"
+	// IndexBuildStateReady indicates that all the existing documents have been indexed.
+	IndexBuildStateReady IndexBuildState = "READY"
+	// IndexBuildStateFailed indicates that the build stopped because of an error,
+	// for example a document violating a unique index.
+	IndexBuildStateFailed IndexBuildState = "FAILED"
+)
+
+// IndexBuildStatus describes the progress of the background build of an index.
+type IndexBuildStatus struct {
+	// State is the state of the build.
+	State IndexBuildState
+	// DocsProcessed is the number of existing documents indexed so far.
+	DocsProcessed uint64
+	// Error contains the reason the build failed.
+	Error string `json:",omitempty"`
+}
+
+// IndexVerifyOptions holds the options of an index verification.
+type IndexVerifyOptions struct {
+	// IndexName is the name of the index to verify.
+	//
+	// If empty, all the indexes of the collection are verified.
+	IndexName string `json:"indexName"`
+	// Repair indicates whether the inconsistencies found are fixed in place.
+	Repair bool `json:"repair"`
+}
+
+// IndexVerifyResult is the result of the verification of an index against the documents
+// of its collection.
+type IndexVerifyResult struct {
+	// IndexName is the name of the verified index.
+	IndexName string `json:"indexName"`
+	// DocsChecked is the number of documents checked against the index.
+	DocsChecked uint64 `json:"docsChecked"`
+	// Missing holds the IDs of the documents of which some entries are missing from the index.
+	Missing []string `json:"missing"`
+	// Stale holds the IDs of the documents of which some entries no longer match their values.
+	Stale []string `json:"stale"`
+	// Orphaned holds the IDs of the documents that no longer exist but still have entries.
+	Orphaned []string `json:"orphaned"`
+	// Repaired indicates whether the inconsistencies found have been fixed.
+	Repaired bool `json:"repaired"`
+	// Skipped indicates whether the index has not been verified, for example because it is
+	// still being built.
+	Skipped bool `json:"skipped"`
+	// SkipReason holds the reason the index has not been verified.
+	SkipReason string `json:"skipReason,omitempty"`
+}
+
+// IsConsistent returns true if no inconsistency was found between the index and the documents.
+func (r IndexVerifyResult) IsConsistent() bool {
+	return len(r.Missing) == 0 && len(r.Stale) == 0 && len(r.Orphaned) == 0
+}
+
+// FilterFields returns the names of the fields the filter of the index depends on.
+func (d IndexDescription) FilterFields() []string {
+	var fields []string
+	var collect func(conditions map[string]any)
+	collect = func(conditions map[string]any) {
+		for key, value := range conditions {
+			switch key {
+			case request.FilterOpAnd, request.FilterOpOr:
+				list, _ := value.([]any)
+				for _, item := range list {
+					if itemConditions, ok := item.(map[string]any); ok {
+						collect(itemConditions)
+					}
+				}
+			case request.FilterOpNot:
+				if notConditions, ok := value.(map[string]any); ok {
+					collect(notConditions)
+				}
+			default:
+				if !slices.Contains(fields, key) {
+					fields = append(fields, key)
+				}
+			}
+		}
+	}
+	collect(d.Filter)
+	return fields
 }
 
 // CollectionIndex is an interface for indexing documents in a collection.
@@ -57,13 +221,20 @@ func (d CollectionDefinition) CollectIndexedFields() []FieldDefinition {
 	fieldsMap := make(map[string]bool)
 	fields := make([]FieldDefinition, 0, len(d.Description.Indexes))
 	for _, index := range d.Description.Indexes {
+		names := make([]string, 0, len(index.Fields))
 		for _, field := range index.Fields {
-			if fieldsMap[field.Name] {
+			names = append(names, field.FieldName())
+		}
+		// the fields of the filter of a partial index decide whether a document is indexed
+		names = append(names, index.FilterFields()...)
+		for _, name := range names {
+			if fieldsMap[name] {
 				// If the FieldDescription has already been added to the result do not add it a second time
 				// this can happen if a field is referenced by multiple indexes
 				continue
 			}
-			colField, ok := d.GetFieldByName(field.Name)
+			fieldsMap[name] = true
+			colField, ok := d.GetFieldByName(name)
 			if ok {
 				fields = append(fields, colField)
 			}
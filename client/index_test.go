@@ -127,3 +127,37 @@ func TestCollectIndexesOnField(t *testing.T) {
 		})
 	}
 }
+
+func TestIndexedFieldDescriptionPath(t *testing.T) {
+	tests := []struct {
+		name         string
+		field        IndexedFieldDescription
+		expectedName string
+		expectedPath []string
+	}{
+		{
+			name:         "field",
+			field:        IndexedFieldDescription{Name: "meta"},
+			expectedName: "meta",
+		},
+		{
+			name:         "path inside field",
+			field:        IndexedFieldDescription{Name: "meta.customerId"},
+			expectedName: "meta",
+			expectedPath: []string{"customerId"},
+		},
+		{
+			name:         "nested path inside field",
+			field:        IndexedFieldDescription{Name: "meta.customer.id"},
+			expectedName: "meta",
+			expectedPath: []string{"customer", "id"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expectedName, test.field.FieldName())
+			assert.Equal(t, test.expectedPath, test.field.JSONPath())
+		})
+	}
+}
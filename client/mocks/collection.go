@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	cid "github.com/ipfs/go-cid"
+
 	client "github.com/sourcenetwork/defradb/client"
 
 	immutable "github.com/sourcenetwork/immutable"
@@ -752,6 +754,54 @@ func (_c *Collection_Name_Call) RunAndReturn(run func() immutable.Option[string]
 	return _c
 }
 
+// Revert provides a mock function with given fields: ctx, docID, version
+func (_m *Collection) Revert(ctx context.Context, docID client.DocID, version cid.Cid) error {
+	ret := _m.Called(ctx, docID, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, client.DocID, cid.Cid) error); ok {
+		r0 = rf(ctx, docID, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Collection_Revert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revert'
+type Collection_Revert_Call struct {
+	*mock.Call
+}
+
+// Revert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - docID client.DocID
+//   - version cid.Cid
+func (_e *Collection_Expecter) Revert(ctx interface{}, docID interface{}, version interface{}) *Collection_Revert_Call {
+	return &Collection_Revert_Call{Call: _e.mock.On("Revert", ctx, docID, version)}
+}
+
+func (_c *Collection_Revert_Call) Run(run func(ctx context.Context, docID client.DocID, version cid.Cid)) *Collection_Revert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(client.DocID), args[2].(cid.Cid))
+	})
+	return _c
+}
+
+func (_c *Collection_Revert_Call) Return(_a0 error) *Collection_Revert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Collection_Revert_Call) RunAndReturn(run func(context.Context, client.DocID, cid.Cid) error) *Collection_Revert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Save provides a mock function with given fields: ctx, doc
 func (_m *Collection) Save(ctx context.Context, doc *client.Document) error {
 	ret := _m.Called(ctx, doc)
@@ -996,6 +1046,65 @@ func (_c *Collection_UpdateWithFilter_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// VerifyIndexes provides a mock function with given fields: ctx, opts
+func (_m *Collection) VerifyIndexes(ctx context.Context, opts client.IndexVerifyOptions) ([]client.IndexVerifyResult, error) {
+	ret := _m.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyIndexes")
+	}
+
+	var r0 []client.IndexVerifyResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, client.IndexVerifyOptions) ([]client.IndexVerifyResult, error)); ok {
+		return rf(ctx, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, client.IndexVerifyOptions) []client.IndexVerifyResult); ok {
+		r0 = rf(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.IndexVerifyResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, client.IndexVerifyOptions) error); ok {
+		r1 = rf(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Collection_VerifyIndexes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyIndexes'
+type Collection_VerifyIndexes_Call struct {
+	*mock.Call
+}
+
+// VerifyIndexes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - opts client.IndexVerifyOptions
+func (_e *Collection_Expecter) VerifyIndexes(ctx interface{}, opts interface{}) *Collection_VerifyIndexes_Call {
+	return &Collection_VerifyIndexes_Call{Call: _e.mock.On("VerifyIndexes", ctx, opts)}
+}
+
+func (_c *Collection_VerifyIndexes_Call) Run(run func(ctx context.Context, opts client.IndexVerifyOptions)) *Collection_VerifyIndexes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(client.IndexVerifyOptions))
+	})
+	return _c
+}
+
+func (_c *Collection_VerifyIndexes_Call) Return(_a0 []client.IndexVerifyResult, _a1 error) *Collection_VerifyIndexes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Collection_VerifyIndexes_Call) RunAndReturn(run func(context.Context, client.IndexVerifyOptions) ([]client.IndexVerifyResult, error)) *Collection_VerifyIndexes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewCollection creates a new instance of Collection. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewCollection(t interface {
@@ -492,6 +492,63 @@ func (_c *DB_Close_Call) RunAndReturn(run func()) *DB_Close_Call {
 	return _c
 }
 
+// CompactDAG provides a mock function with given fields: _a0, _a1
+func (_m *DB) CompactDAG(_a0 context.Context, _a1 client.CompactionConfig) (client.CompactionResult, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompactDAG")
+	}
+
+	var r0 client.CompactionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, client.CompactionConfig) (client.CompactionResult, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, client.CompactionConfig) client.CompactionResult); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(client.CompactionResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, client.CompactionConfig) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DB_CompactDAG_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompactDAG'
+type DB_CompactDAG_Call struct {
+	*mock.Call
+}
+
+// CompactDAG is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 client.CompactionConfig
+func (_e *DB_Expecter) CompactDAG(_a0 interface{}, _a1 interface{}) *DB_CompactDAG_Call {
+	return &DB_CompactDAG_Call{Call: _e.mock.On("CompactDAG", _a0, _a1)}
+}
+
+func (_c *DB_CompactDAG_Call) Run(run func(_a0 context.Context, _a1 client.CompactionConfig)) *DB_CompactDAG_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(client.CompactionConfig))
+	})
+	return _c
+}
+
+func (_c *DB_CompactDAG_Call) Return(_a0 client.CompactionResult, _a1 error) *DB_CompactDAG_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DB_CompactDAG_Call) RunAndReturn(run func(context.Context, client.CompactionConfig) (client.CompactionResult, error)) *DB_CompactDAG_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteDocActorRelationship provides a mock function with given fields: ctx, collectionName, docID, relation, targetActor
 func (_m *DB) DeleteDocActorRelationship(ctx context.Context, collectionName string, docID string, relation string, targetActor string) (client.DeleteDocActorRelationshipResult, error) {
 	ret := _m.Called(ctx, collectionName, docID, relation, targetActor)
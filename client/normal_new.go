@@ -66,6 +66,8 @@ func NewNormalValue(val any) (NormalValue, error) {
 		return NewNormalDocument(v), nil
 	case *JSON:
 		return NewNormalJSON(v), nil
+	case GeoPoint:
+		return NewNormalGeoPoint(v), nil
 
 	case immutable.Option[bool]:
 		return NewNormalNillableBool(v), nil
@@ -101,6 +103,8 @@ func NewNormalValue(val any) (NormalValue, error) {
 		return NewNormalNillableTime(v), nil
 	case immutable.Option[*Document]:
 		return NewNormalNillableDocument(v), nil
+	case immutable.Option[GeoPoint]:
+		return NewNormalNillableGeoPoint(v), nil
 
 	case []bool:
 		return NewNormalBoolArray(v), nil
@@ -21,6 +21,9 @@ func NewNormalNil(kind FieldKind) (NormalValue, error) {
 	if kind.IsObject() {
 		return NewNormalNillableDocument(immutable.None[*Document]()), nil
 	}
+	if _, ok := kind.(*VectorKind); ok {
+		return NewNormalFloatNillableArray(immutable.None[[]float64]()), nil
+	}
 	switch kind {
 	case FieldKind_NILLABLE_BOOL:
 		return NewNormalNillableBool(immutable.None[bool]()), nil
@@ -34,6 +37,8 @@ func NewNormalNil(kind FieldKind) (NormalValue, error) {
 		return NewNormalNillableString(immutable.None[string]()), nil
 	case FieldKind_NILLABLE_BLOB:
 		return NewNormalNillableBytes(immutable.None[[]byte]()), nil
+	case FieldKind_NILLABLE_GEOPOINT:
+		return NewNormalNillableGeoPoint(immutable.None[GeoPoint]()), nil
 	case FieldKind_BOOL_ARRAY:
 		return NewNormalBoolNillableArray(immutable.None[[]bool]()), nil
 	case FieldKind_INT_ARRAY:
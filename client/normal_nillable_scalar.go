@@ -124,6 +124,18 @@ func (v normalNillableDocument) Equal(other NormalValue) bool {
 	return areNormalScalarsEqual(v.val, other.NillableDocument)
 }
 
+type normalNillableGeoPoint struct {
+	baseNillableNormalValue[GeoPoint]
+}
+
+func (v normalNillableGeoPoint) NillableGeoPoint() (immutable.Option[GeoPoint], bool) {
+	return v.val, true
+}
+
+func (v normalNillableGeoPoint) Equal(other NormalValue) bool {
+	return areNormalScalarsEqual(v.val, other.NillableGeoPoint)
+}
+
 // NewNormalNillableBool creates a new NormalValue that represents a `immutable.Option[bool]` value.
 func NewNormalNillableBool(val immutable.Option[bool]) NormalValue {
 	return normalNillableBool{newBaseNillableNormalValue(val)}
@@ -159,6 +171,11 @@ func NewNormalNillableDocument(val immutable.Option[*Document]) NormalValue {
 	return normalNillableDocument{newBaseNillableNormalValue(val)}
 }
 
+// NewNormalNillableGeoPoint creates a new NormalValue that represents a `immutable.Option[GeoPoint]` value.
+func NewNormalNillableGeoPoint(val immutable.Option[GeoPoint]) NormalValue {
+	return normalNillableGeoPoint{newBaseNillableNormalValue(val)}
+}
+
 func normalizeNillableNum[R int64 | float64, T constraints.Integer | constraints.Float](
 	val immutable.Option[T],
 ) immutable.Option[R] {
@@ -137,6 +137,18 @@ func (v normalJSON) Unwrap() any {
 	return v.val.inner
 }
 
+type normalGeoPoint struct {
+	baseNormalValue[GeoPoint]
+}
+
+func (v normalGeoPoint) GeoPoint() (GeoPoint, bool) {
+	return v.val, true
+}
+
+func (v normalGeoPoint) Equal(other NormalValue) bool {
+	return areNormalScalarsEqual(v.val, other.GeoPoint)
+}
+
 func newNormalInt(val int64) NormalValue {
 	return normalInt{newBaseNormalValue(val)}
 }
@@ -185,6 +197,11 @@ func NewNormalJSON(val *JSON) NormalValue {
 	return normalJSON{baseNormalValue[*JSON]{val: val}}
 }
 
+// NewNormalGeoPoint creates a new NormalValue that represents a `GeoPoint` value.
+func NewNormalGeoPoint(val GeoPoint) NormalValue {
+	return normalGeoPoint{baseNormalValue[GeoPoint]{val: val}}
+}
+
 func areNormalScalarsEqual[T comparable](val T, f func() (T, bool)) bool {
 	if otherVal, ok := f(); ok {
 		return val == otherVal
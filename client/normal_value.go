@@ -65,6 +65,9 @@ type NormalValue interface {
 	// JSON returns the value as JSON. The second return flag is true if the value is JSON.
 	// Otherwise it will return nil and false.
 	JSON() (*JSON, bool)
+	// GeoPoint returns the value as a [GeoPoint]. The second return flag is true if the value is a [GeoPoint].
+	// Otherwise it will return an empty [GeoPoint] and false.
+	GeoPoint() (GeoPoint, bool)
 
 	// NillableBool returns the value as a nillable bool.
 	// The second return flag is true if the value is [immutable.Option[bool]].
@@ -94,6 +97,10 @@ type NormalValue interface {
 	// The second return flag is true if the value is [immutable.Option[*Document]].
 	// Otherwise it will return [immutable.None[*Document]()] and false.
 	NillableDocument() (immutable.Option[*Document], bool)
+	// NillableGeoPoint returns the value as a nillable [GeoPoint].
+	// The second return flag is true if the value is [immutable.Option[GeoPoint]].
+	// Otherwise it will return [immutable.None[GeoPoint]()] and false.
+	NillableGeoPoint() (immutable.Option[GeoPoint], bool)
 
 	// BoolArray returns the value as a bool array.
 	// The second return flag is true if the value is a []bool.
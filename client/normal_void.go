@@ -69,6 +69,10 @@ func (NormalVoid) JSON() (*JSON, bool) {
 	return nil, false
 }
 
+func (NormalVoid) GeoPoint() (GeoPoint, bool) {
+	return GeoPoint{}, false
+}
+
 func (NormalVoid) NillableBool() (immutable.Option[bool], bool) {
 	return immutable.None[bool](), false
 }
@@ -97,6 +101,10 @@ func (NormalVoid) NillableDocument() (immutable.Option[*Document], bool) {
 	return immutable.None[*Document](), false
 }
 
+func (NormalVoid) NillableGeoPoint() (immutable.Option[GeoPoint], bool) {
+	return immutable.None[GeoPoint](), false
+}
+
 func (NormalVoid) IsArray() bool {
 	return false
 }
@@ -26,6 +26,11 @@ type Aggregate struct {
 	// and average of the Friends.Age and Parents.Age fields, the result will be the average
 	// age of all their friends and parents, it will not be an average of their average ages.
 	Targets []*AggregateTarget
+
+	// Percentile is the percentile, from 0 to 100, to return.
+	//
+	// It is only set for `_percentile` aggregates.
+	Percentile immutable.Option[float64]
 }
 
 // AggregateTarget represents the target of an [Aggregate].
@@ -23,6 +23,7 @@ type CommitSelect struct {
 
 	CIDFilter
 
+	Filterable
 	Limitable
 	Offsetable
 	Orderable
@@ -52,6 +53,7 @@ func (c CommitSelect) ToSelect() *Select {
 			Name:  c.Name,
 			Alias: c.Alias,
 		},
+		Filterable:  c.Filterable,
 		Limitable:   c.Limitable,
 		Offsetable:  c.Offsetable,
 		Orderable:   c.Orderable,
@@ -30,14 +30,25 @@ const (
 	EncryptDocArgName    = "encrypt"
 	EncryptFieldsArgName = "encryptFields"
 
-	FilterClause  = "filter"
-	GroupByClause = "groupBy"
-	LimitClause   = "limit"
-	OffsetClause  = "offset"
-	OrderClause   = "order"
-	DepthClause   = "depth"
-
-	DocIDArgName = "docID"
+	FilterClause   = "filter"
+	GroupByClause  = "groupBy"
+	DistinctClause = "distinct"
+	LimitClause    = "limit"
+	OffsetClause   = "offset"
+	FirstClause    = "first"
+	AfterClause    = "after"
+	LastClause     = "last"
+	BeforeClause   = "before"
+	OrderClause    = "order"
+	DepthClause    = "depth"
+
+	DocIDArgName   = "docID"
+	HeadsArgName   = "heads"
+	SpliceArgName  = "splice"
+	AsOfArgName    = "asOf"
+	SimilarArgName = "_similar"
+	FromArgName    = "from"
+	ToArgName      = "to"
 
 	AverageFieldName = "_avg"
 	CountFieldName   = "_count"
@@ -49,6 +60,22 @@ const (
 	MaxFieldName     = "_max"
 	MinFieldName     = "_min"
 
+	MedianFieldName        = "_median"
+	StdDevFieldName        = "_stddev"
+	VarianceFieldName      = "_variance"
+	PercentileFieldName    = "_percentile"
+	CountDistinctFieldName = "_countDistinct"
+
+	// PercentileArgName is the name of the `_percentile` argument declaring which percentile,
+	// from 0 to 100, to return.
+	PercentileArgName = "p"
+
+	ConflictsFieldName = "_conflicts"
+	DiffFieldName      = "_diff"
+	ScoreFieldName     = "_score"
+	DistanceFieldName  = "_distance"
+	CursorFieldName    = "_cursor"
+
 	// New generated document id from a backed up document,
 	// which might have a different _docID originally.
 	NewDocIDFieldName = "_docIDNew"
@@ -67,6 +94,8 @@ const (
 	FieldNameFieldName       = "fieldName"
 	FieldIDFieldName         = "fieldId"
 	DeltaFieldName           = "delta"
+	TimestampFieldName       = "timestamp"
+	SignerFieldName          = "_signer"
 
 	DeltaArgFieldName       = "FieldName"
 	DeltaArgData            = "Data"
@@ -83,6 +112,22 @@ const (
 	LinksNameFieldName = "name"
 	LinksCidFieldName  = "cid"
 
+	ConflictTypeName        = "Conflict"
+	ConflictValuesFieldName = "values"
+	ConflictHeadsFieldName  = "heads"
+
+	SimilarVectorFieldName = "vector"
+	SimilarKFieldName      = "k"
+
+	DiffTypeName          = "Diff"
+	DiffOldValueFieldName = "old"
+	DiffNewValueFieldName = "new"
+
+	TextSpliceTypeName        = "TextSplice"
+	TextSpliceIndexFieldName  = "index"
+	TextSpliceDeleteFieldName = "delete"
+	TextSpliceInsertFieldName = "insert"
+
 	ASC  = OrderDirection("ASC")
 	DESC = OrderDirection("DESC")
 )
@@ -103,16 +148,27 @@ var (
 	}
 
 	ReservedFields = map[string]struct{}{
-		TypeNameFieldName: {},
-		VersionFieldName:  {},
-		GroupFieldName:    {},
-		CountFieldName:    {},
-		SumFieldName:      {},
-		AverageFieldName:  {},
-		DocIDFieldName:    {},
-		DeletedFieldName:  {},
-		MaxFieldName:      {},
-		MinFieldName:      {},
+		TypeNameFieldName:  {},
+		VersionFieldName:   {},
+		GroupFieldName:     {},
+		CountFieldName:     {},
+		SumFieldName:       {},
+		AverageFieldName:   {},
+		DocIDFieldName:     {},
+		DeletedFieldName:   {},
+		MaxFieldName:       {},
+		MinFieldName:       {},
+		ConflictsFieldName: {},
+		DiffFieldName:      {},
+		ScoreFieldName:     {},
+		DistanceFieldName:  {},
+		CursorFieldName:    {},
+
+		MedianFieldName:        {},
+		StdDevFieldName:        {},
+		VarianceFieldName:      {},
+		PercentileFieldName:    {},
+		CountDistinctFieldName: {},
 	}
 
 	Aggregates = map[string]struct{}{
@@ -121,6 +177,12 @@ var (
 		AverageFieldName: {},
 		MaxFieldName:     {},
 		MinFieldName:     {},
+
+		MedianFieldName:        {},
+		StdDevFieldName:        {},
+		VarianceFieldName:      {},
+		PercentileFieldName:    {},
+		CountDistinctFieldName: {},
 	}
 
 	CommitQueries = map[string]struct{}{
@@ -137,10 +199,24 @@ var (
 		FieldNameFieldName,
 		FieldIDFieldName,
 		DeltaFieldName,
+		TimestampFieldName,
+		SignerFieldName,
 	}
 
 	LinksFields = []string{
 		LinksNameFieldName,
 		LinksCidFieldName,
 	}
+
+	ConflictFields = []string{
+		FieldNameFieldName,
+		ConflictValuesFieldName,
+		ConflictHeadsFieldName,
+	}
+
+	DiffFields = []string{
+		FieldNameFieldName,
+		DiffOldValueFieldName,
+		DiffNewValueFieldName,
+	}
 )
@@ -0,0 +1,38 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package request
+
+import "github.com/sourcenetwork/immutable"
+
+// Cursorable is an embeddable struct that hosts a consistent set of properties
+// for paginating the results of a request with cursors.
+//
+// Cursors are opaque values returned by the `_cursor` field of each result.
+type Cursorable struct {
+	// First is an optional value that caps the number of results to the number provided,
+	// counting from the start of the page.
+	First immutable.Option[uint64]
+
+	// After is an optional cursor, the page starts right after the result it was returned by.
+	After immutable.Option[string]
+
+	// Last is an optional value that caps the number of results to the number provided,
+	// counting from the end of the page.
+	Last immutable.Option[uint64]
+
+	// Before is an optional cursor, the page ends right before the result it was returned by.
+	Before immutable.Option[string]
+}
+
+// HasCursorArgs returns true if any of the cursor pagination arguments is set.
+func (c Cursorable) HasCursorArgs() bool {
+	return c.First.HasValue() || c.After.HasValue() || c.Last.HasValue() || c.Before.HasValue()
+}
@@ -0,0 +1,29 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package request
+
+import "github.com/sourcenetwork/immutable"
+
+type Distinct struct {
+	Fields []string
+}
+
+// Distinctable is an embeddable struct that hosts a consistent set of properties
+// for deduplicating the results of a request.
+type Distinctable struct {
+	// Distinct is an optional set of fields by which the results of this request are
+	// deduplicated.
+	//
+	// If this argument is provided, only the first result of each unique combination
+	// of the values of the given fields is returned. Unlike with 'groupBy', any field
+	// may be selected and the results are not nested.
+	Distinct immutable.Option[Distinct]
+}
@@ -16,6 +16,9 @@ import (
 
 const (
 	errSelectOfNonGroupField string = "cannot select a non-group-by field at group-level"
+	errAsOfWithCID           string = "asOf cannot be used together with cid"
+	errCursorWithGroupBy     string = "cursor pagination cannot be used together with groupBy"
+	errDistinctWithGroupBy   string = "distinct cannot be used together with groupBy"
 )
 
 // Errors returnable from this package.
@@ -24,6 +27,9 @@ const (
 // Errors returned from this package may be tested against these errors with errors.Is.
 var (
 	ErrSelectOfNonGroupField = errors.New(errSelectOfNonGroupField)
+	ErrAsOfWithCID           = errors.New(errAsOfWithCID)
+	ErrCursorWithGroupBy     = errors.New(errCursorWithGroupBy)
+	ErrDistinctWithGroupBy   = errors.New(errDistinctWithGroupBy)
 )
 
 // NewErrSelectOfNonGroupField returns an error indicating that a non-group-by field
@@ -16,8 +16,57 @@ const (
 	FilterOpOr  = "_or"
 	FilterOpAnd = "_and"
 	FilterOpNot = "_not"
+
+	// FilterOpField references another field of the same document as an operator value.
+	FilterOpField = "_field"
+	// FilterOpParentField references a field of the parent document as an operator value.
+	FilterOpParentField = "_parentField"
 )
 
+// FieldReference is a filter operator value that refers to another field of the document
+// being filtered, or of its parent document, for example the `{_field: "publishedAt"}`
+// of `{updatedAt: {_gt: {_field: "publishedAt"}}}`.
+type FieldReference struct {
+	// Name is the name of the referenced field.
+	Name string
+	// IsParent is true if the referenced field is a field of the parent document.
+	IsParent bool
+}
+
+// ToMap returns the referenced field in the form it is requested with.
+func (r FieldReference) ToMap() map[string]any {
+	if r.IsParent {
+		return map[string]any{FilterOpParentField: r.Name}
+	}
+	return map[string]any{FilterOpField: r.Name}
+}
+
+// ParseFieldReference returns the field reference of the given operator value, which is either
+// a [FieldReference] or its map form, for example `{_field: "publishedAt"}`.
+//
+// False is returned if the value is not a field reference.
+func ParseFieldReference(value any) (FieldReference, bool) {
+	switch value := value.(type) {
+	case FieldReference:
+		return value, true
+
+	case map[string]any:
+		if len(value) != 1 {
+			return FieldReference{}, false
+		}
+		if name, ok := value[FilterOpField].(string); ok {
+			return FieldReference{Name: name}, true
+		}
+		if name, ok := value[FilterOpParentField].(string); ok {
+			return FieldReference{Name: name, IsParent: true}, true
+		}
+		return FieldReference{}, false
+
+	default:
+		return FieldReference{}, false
+	}
+}
+
 // Filter contains the parsed condition map to be
 // run by the Filter Evaluator.
 // @todo: Cache filter structure for faster condition
@@ -18,6 +18,8 @@ const (
 	UpdateObjects
 	DeleteObjects
 	UpsertObjects
+	ResolveObjects
+	RevertObjects
 )
 
 // ObjectMutation is a field on the `mutation` operation of a graphql request. It includes
@@ -43,6 +45,16 @@ type ObjectMutation struct {
 	// UpdateInput is a map of fields and values used for an update mutation.
 	UpdateInput map[string]any
 
+	// Splices is the list of text edits applied to the String fields of an update mutation
+	// after the UpdateInput.
+	Splices []TextSplice
+
+	// Heads is the list of hex encoded conflicting value IDs that a resolve mutation supersedes.
+	Heads []string
+
+	// Cid is the version of the document that a revert mutation restores.
+	Cid string
+
 	// Encrypt is a boolean flag that indicates whether the input data should be encrypted.
 	Encrypt bool
 
@@ -50,6 +62,21 @@ type ObjectMutation struct {
 	EncryptFields []string
 }
 
+// TextSplice replaces a range of characters of a String field.
+type TextSplice struct {
+	// Field is the name of the String field to edit.
+	Field string
+
+	// Index is the position, in characters, at which the edit starts.
+	Index int
+
+	// Delete is the number of characters removed from Index.
+	Delete int
+
+	// Insert is the text inserted at Index.
+	Insert string
+}
+
 // ToSelect returns a basic Select object, with the same Name, Alias, and Fields as
 // the Mutation object. Used to create a Select planNode for the mutation return objects.
 func (m ObjectMutation) ToSelect() *Select {
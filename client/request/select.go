@@ -12,6 +12,9 @@ package request
 
 import (
 	"encoding/json"
+	"time"
+
+	"github.com/sourcenetwork/immutable"
 )
 
 // Select is a complex Field with strong typing.
@@ -23,15 +26,35 @@ type Select struct {
 
 	Limitable
 	Offsetable
+	Cursorable
 	Orderable
 	Filterable
 	DocIDsFilter
 	CIDFilter
 	Groupable
+	Distinctable
 
 	// ShowDeleted will return deleted documents along with non-deleted ones
 	// if set to true.
 	ShowDeleted bool
+
+	// AsOf is an optional value that selects the documents in the state they were in
+	// at the given time.
+	//
+	// It cannot be used together with a CID.
+	AsOf immutable.Option[time.Time]
+
+	// DiffFrom is the version of the document that a _diff selection compares from.
+	DiffFrom immutable.Option[string]
+
+	// DiffTo is the version of the document that a _diff selection compares to.
+	//
+	// If it has no value the current state of the document is compared.
+	DiffTo immutable.Option[string]
+
+	// Similar is an optional k-nearest-neighbour search that selects the documents whose
+	// vector field is nearest to a given vector.
+	Similar immutable.Option[Similar]
 }
 
 // ChildSelect represents a type with selectable child properties.
@@ -67,6 +90,18 @@ func (s *Select) validateShallow() []error {
 
 	result = append(result, s.validateGroupBy()...)
 
+	if s.AsOf.HasValue() && s.CID.HasValue() {
+		result = append(result, ErrAsOfWithCID)
+	}
+
+	if s.HasCursorArgs() && s.GroupBy.HasValue() {
+		result = append(result, ErrCursorWithGroupBy)
+	}
+
+	if s.Distinct.HasValue() && s.GroupBy.HasValue() {
+		result = append(result, ErrDistinctWithGroupBy)
+	}
+
 	return result
 }
 
@@ -116,12 +151,18 @@ type selectJson struct {
 	Field
 	Limitable
 	Offsetable
+	Cursorable
 	Orderable
 	Filterable
 	DocIDsFilter
 	CIDFilter
 	Groupable
+	Distinctable
 	ShowDeleted bool
+	AsOf        immutable.Option[time.Time]
+	DiffFrom    immutable.Option[string]
+	DiffTo      immutable.Option[string]
+	Similar     immutable.Option[Similar]
 }
 
 func (s *Select) UnmarshalJSON(bytes []byte) error {
@@ -136,10 +177,16 @@ func (s *Select) UnmarshalJSON(bytes []byte) error {
 	s.CID = selectMap.CID
 	s.Limitable = selectMap.Limitable
 	s.Offsetable = selectMap.Offsetable
+	s.Cursorable = selectMap.Cursorable
 	s.Orderable = selectMap.Orderable
 	s.Groupable = selectMap.Groupable
+	s.Distinctable = selectMap.Distinctable
 	s.Filterable = selectMap.Filterable
 	s.ShowDeleted = selectMap.ShowDeleted
+	s.AsOf = selectMap.AsOf
+	s.DiffFrom = selectMap.DiffFrom
+	s.DiffTo = selectMap.DiffTo
+	s.Similar = selectMap.Similar
 
 	var childSelect ChildSelect
 	err = json.Unmarshal(bytes, &childSelect)
@@ -0,0 +1,23 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package request
+
+// Similar represents a k-nearest-neighbour search on a vector field.
+type Similar struct {
+	// Field is the name of the vector field to compare.
+	Field string
+
+	// Vector is the vector that the field is compared with.
+	Vector []float64
+
+	// K is the number of nearest documents to return.
+	K uint64
+}
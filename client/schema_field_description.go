@@ -109,12 +109,21 @@ type SelfKind struct {
 	Array bool
 }
 
+// VectorKind represents fixed-dimension vectors of floats, such as embeddings.
+//
+// Vectors are held as `[Float!]` values whose length must match the dimension.
+type VectorKind struct {
+	// The number of elements of the vectors.
+	Dimension int
+}
+
 var _ FieldKind = ScalarKind(0)
 var _ FieldKind = ScalarArrayKind(0)
 var _ FieldKind = (*CollectionKind)(nil)
 var _ FieldKind = (*SchemaKind)(nil)
 var _ FieldKind = (*SelfKind)(nil)
 var _ FieldKind = (*NamedKind)(nil)
+var _ FieldKind = (*VectorKind)(nil)
 
 func (k ScalarKind) String() string {
 	switch k {
@@ -134,6 +143,8 @@ func (k ScalarKind) String() string {
 		return "Blob"
 	case FieldKind_NILLABLE_JSON:
 		return "JSON"
+	case FieldKind_NILLABLE_GEOPOINT:
+		return "GeoPoint"
 	default:
 		return strconv.Itoa(int(k))
 	}
@@ -320,6 +331,33 @@ func (k *NamedKind) IsArray() bool {
 	return k.Array
 }
 
+func NewVectorKind(dimension int) *VectorKind {
+	return &VectorKind{
+		Dimension: dimension,
+	}
+}
+
+func (k *VectorKind) String() string {
+	return fmt.Sprintf("Vector(%v)", k.Dimension)
+}
+
+func (k *VectorKind) IsNillable() bool {
+	return true
+}
+
+func (k *VectorKind) IsObject() bool {
+	return false
+}
+
+func (k *VectorKind) IsArray() bool {
+	return true
+}
+
+// SubKind returns the kind of the elements of the vectors.
+func (k *VectorKind) SubKind() FieldKind {
+	return FieldKind_NILLABLE_FLOAT
+}
+
 // Note: These values are serialized and persisted in the database, avoid modifying existing values.
 const (
 	FieldKind_None                  ScalarKind      = 0
@@ -344,6 +382,7 @@ const (
 	FieldKind_NILLABLE_INT_ARRAY    ScalarArrayKind = 19
 	FieldKind_NILLABLE_FLOAT_ARRAY  ScalarArrayKind = 20
 	FieldKind_NILLABLE_STRING_ARRAY ScalarArrayKind = 21
+	FieldKind_NILLABLE_GEOPOINT     ScalarKind      = 22
 )
 
 // FieldKindStringToEnumMapping maps string representations of [FieldKind] values to
@@ -370,6 +409,7 @@ var FieldKindStringToEnumMapping = map[string]FieldKind{
 	"[String!]":          FieldKind_STRING_ARRAY,
 	"Blob":               FieldKind_NILLABLE_BLOB,
 	"JSON":               FieldKind_NILLABLE_JSON,
+	"GeoPoint":           FieldKind_NILLABLE_GEOPOINT,
 	request.SelfTypeName: NewSelfKind("", false),
 	fmt.Sprintf("[%s]", request.SelfTypeName): NewSelfKind("", true),
 }
@@ -412,6 +452,7 @@ type objectKind struct {
 	Array      bool
 	Root       any
 	RelativeID string
+	Dimension  int
 }
 
 func parseFieldKind(bytes json.RawMessage) (FieldKind, error) {
@@ -426,6 +467,10 @@ func parseFieldKind(bytes json.RawMessage) (FieldKind, error) {
 			return nil, err
 		}
 
+		if objKind.Dimension > 0 {
+			return NewVectorKind(objKind.Dimension), nil
+		}
+
 		if objKind.Root == nil {
 			return NewSelfKind(objKind.RelativeID, objKind.Array), nil
 		}
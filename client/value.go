@@ -12,12 +12,18 @@ package client
 
 import (
 	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client/request"
 )
 
 type FieldValue struct {
 	t       CType
 	value   NormalValue
 	isDirty bool
+
+	// splices holds the splices that turned the saved value of the field into this value,
+	// it is nil if the value has been set as a whole.
+	splices []request.TextSplice
 }
 
 func NewFieldValue(t CType, val NormalValue) *FieldValue {
@@ -52,6 +58,13 @@ func (val FieldValue) IsDirty() bool {
 
 func (val *FieldValue) Clean() {
 	val.isDirty = false
+	val.splices = nil
+}
+
+// Splices returns the splices that turned the saved value of the field into this value,
+// in the order they were applied. It returns nil if the value has been set as a whole.
+func (val FieldValue) Splices() []request.TextSplice {
+	return val.splices
 }
 
 func (val *FieldValue) SetType(t CType) {
@@ -76,6 +76,11 @@ func (shim *iteratorShim) IteratePrefix(
 			}
 			lastSharedIndex += 1
 		}
+		// The query prefix matches whole path segments only, so the shared prefix must be
+		// cut back to the last segment that both keys fully share.
+		for lastSharedIndex > 0 && (lastSharedIndex >= len(startBytes) || startBytes[lastSharedIndex] != '/') {
+			lastSharedIndex -= 1
+		}
 		query.Prefix = string(startBytes[:lastSharedIndex])
 		query.Filters = append(query.Filters, betweenFilter{
 			start: startPrefix.String(),
@@ -116,6 +116,24 @@ func (c *Client) BasicExport(ctx context.Context, config *client.BackupConfig) e
 	return err
 }
 
+func (c *Client) CompactDAG(ctx context.Context, config client.CompactionConfig) (client.CompactionResult, error) {
+	methodURL := c.http.baseURL.JoinPath("dag", "compact")
+
+	body, err := json.Marshal(config)
+	if err != nil {
+		return client.CompactionResult{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, methodURL.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return client.CompactionResult{}, err
+	}
+	var result client.CompactionResult
+	if err := c.http.requestJson(req, &result); err != nil {
+		return client.CompactionResult{}, err
+	}
+	return result, nil
+}
+
 func (c *Client) AddSchema(ctx context.Context, schema string) ([]client.CollectionDescription, error) {
 	methodURL := c.http.baseURL.JoinPath("schema")
 
@@ -19,6 +19,7 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/ipfs/go-cid"
 	"github.com/sourcenetwork/immutable"
 	sse "github.com/vito/go-sse/sse"
 
@@ -206,6 +207,30 @@ func (c *Collection) Delete(
 	return true, nil
 }
 
+func (c *Collection) Revert(
+	ctx context.Context,
+	docID client.DocID,
+	version cid.Cid,
+) error {
+	if !c.Description().Name.HasValue() {
+		return client.ErrOperationNotPermittedOnNamelessCols
+	}
+
+	methodURL := c.http.baseURL.JoinPath("collections", c.Description().Name.Value(), docID.String(), "revert")
+
+	body, err := json.Marshal(CollectionRevertRequest{Cid: version.String()})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, methodURL.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.http.request(req)
+	return err
+}
+
 func (c *Collection) Exists(
 	ctx context.Context,
 	docID client.DocID,
@@ -434,3 +459,28 @@ func (c *Collection) GetIndexes(ctx context.Context) ([]client.IndexDescription,
 	}
 	return indexes, nil
 }
+
+func (c *Collection) VerifyIndexes(
+	ctx context.Context,
+	opts client.IndexVerifyOptions,
+) ([]client.IndexVerifyResult, error) {
+	if !c.Description().Name.HasValue() {
+		return nil, client.ErrOperationNotPermittedOnNamelessCols
+	}
+
+	methodURL := c.http.baseURL.JoinPath("collections", c.Description().Name.Value(), "indexes", "verify")
+
+	body, err := json.Marshal(opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, methodURL.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	var results []client.IndexVerifyResult
+	if err := c.http.requestJson(req, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
+	"github.com/ipfs/go-cid"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/internal/encryption"
@@ -39,6 +40,10 @@ type CollectionUpdateRequest struct {
 	Updater string `json:"updater"`
 }
 
+type CollectionRevertRequest struct {
+	Cid string `json:"cid"`
+}
+
 func (s *collectionHandler) Create(rw http.ResponseWriter, req *http.Request) {
 	col := mustGetContextClientCollection(req)
 
@@ -176,6 +181,35 @@ func (s *collectionHandler) Delete(rw http.ResponseWriter, req *http.Request) {
 	rw.WriteHeader(http.StatusOK)
 }
 
+func (s *collectionHandler) Revert(rw http.ResponseWriter, req *http.Request) {
+	col := mustGetContextClientCollection(req)
+
+	docID, err := client.NewDocIDFromString(chi.URLParam(req, "docID"))
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+
+	var request CollectionRevertRequest
+	if err := requestJSON(req, &request); err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+
+	version, err := cid.Decode(request.Cid)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+
+	err = col.Revert(req.Context(), docID, version)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	rw.WriteHeader(http.StatusOK)
+}
+
 func (s *collectionHandler) Get(rw http.ResponseWriter, req *http.Request) {
 	col := mustGetContextClientCollection(req)
 	showDeleted, _ := strconv.ParseBool(req.URL.Query().Get("show_deleted"))
@@ -293,6 +327,22 @@ func (s *collectionHandler) DropIndex(rw http.ResponseWriter, req *http.Request)
 	rw.WriteHeader(http.StatusOK)
 }
 
+func (s *collectionHandler) VerifyIndexes(rw http.ResponseWriter, req *http.Request) {
+	col := mustGetContextClientCollection(req)
+
+	var opts client.IndexVerifyOptions
+	if err := requestJSON(req, &opts); err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	results, err := col.VerifyIndexes(req.Context(), opts)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	responseJSON(rw, http.StatusOK, results)
+}
+
 func (h *collectionHandler) bindRoutes(router *Router) {
 	errorResponse := &openapi3.ResponseRef{
 		Ref: "#/components/responses/error",
@@ -309,6 +359,9 @@ func (h *collectionHandler) bindRoutes(router *Router) {
 	collectionDeleteSchema := &openapi3.SchemaRef{
 		Ref: "#/components/schemas/collection_delete",
 	}
+	collectionRevertSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/collection_revert",
+	}
 	deleteResultSchema := &openapi3.SchemaRef{
 		Ref: "#/components/schemas/delete_result",
 	}
@@ -318,6 +371,12 @@ func (h *collectionHandler) bindRoutes(router *Router) {
 	indexSchema := &openapi3.SchemaRef{
 		Ref: "#/components/schemas/index",
 	}
+	indexVerifyOptionsSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/index_verify_options",
+	}
+	indexVerifyResultSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/index_verify_result",
+	}
 
 	collectionNamePathParam := openapi3.NewPathParameter("name").
 		WithDescription("Collection name").
@@ -434,6 +493,28 @@ func (h *collectionHandler) bindRoutes(router *Router) {
 	dropIndex.Responses.Set("200", successResponse)
 	dropIndex.Responses.Set("400", errorResponse)
 
+	verifyIndexesRequest := openapi3.NewRequestBody().
+		WithRequired(true).
+		WithContent(openapi3.NewContentWithJSONSchemaRef(indexVerifyOptionsSchema))
+
+	indexVerifyResultArraySchema := openapi3.NewArraySchema()
+	indexVerifyResultArraySchema.Items = indexVerifyResultSchema
+
+	verifyIndexesResponse := openapi3.NewResponse().
+		WithDescription("Verification result of each index").
+		WithJSONSchema(indexVerifyResultArraySchema)
+
+	verifyIndexes := openapi3.NewOperation()
+	verifyIndexes.OperationID = "index_verify"
+	verifyIndexes.Description = "Verify and optionally repair the entries of secondary indexes"
+	verifyIndexes.Tags = []string{"index"}
+	verifyIndexes.AddParameter(collectionNamePathParam)
+	verifyIndexes.RequestBody = &openapi3.RequestBodyRef{
+		Value: verifyIndexesRequest,
+	}
+	verifyIndexes.AddResponse(200, verifyIndexesResponse)
+	verifyIndexes.Responses.Set("400", errorResponse)
+
 	documentIDPathParam := openapi3.NewPathParameter("docID").
 		WithRequired(true).
 		WithSchema(openapi3.NewStringSchema())
@@ -471,6 +552,23 @@ func (h *collectionHandler) bindRoutes(router *Router) {
 	collectionDelete.Responses.Set("200", successResponse)
 	collectionDelete.Responses.Set("400", errorResponse)
 
+	collectionRevertRequest := openapi3.NewRequestBody().
+		WithRequired(true).
+		WithContent(openapi3.NewContentWithJSONSchemaRef(collectionRevertSchema))
+
+	collectionRevert := openapi3.NewOperation()
+	collectionRevert.Description = "Revert a document by docID to a previous version"
+	collectionRevert.OperationID = "collection_revert"
+	collectionRevert.Tags = []string{"collection"}
+	collectionRevert.AddParameter(collectionNamePathParam)
+	collectionRevert.AddParameter(documentIDPathParam)
+	collectionRevert.RequestBody = &openapi3.RequestBodyRef{
+		Value: collectionRevertRequest,
+	}
+	collectionRevert.Responses = openapi3.NewResponses()
+	collectionRevert.Responses.Set("200", successResponse)
+	collectionRevert.Responses.Set("400", errorResponse)
+
 	collectionKeys := openapi3.NewOperation()
 	collectionKeys.AddParameter(collectionNamePathParam)
 	collectionKeys.Description = "Get all document IDs"
@@ -486,8 +584,10 @@ func (h *collectionHandler) bindRoutes(router *Router) {
 	router.AddRoute("/collections/{name}", http.MethodDelete, collectionDeleteWith, h.DeleteWithFilter)
 	router.AddRoute("/collections/{name}/indexes", http.MethodPost, createIndex, h.CreateIndex)
 	router.AddRoute("/collections/{name}/indexes", http.MethodGet, getIndexes, h.GetIndexes)
+	router.AddRoute("/collections/{name}/indexes/verify", http.MethodPost, verifyIndexes, h.VerifyIndexes)
 	router.AddRoute("/collections/{name}/indexes/{index}", http.MethodDelete, dropIndex, h.DropIndex)
 	router.AddRoute("/collections/{name}/{docID}", http.MethodGet, collectionGet, h.Get)
 	router.AddRoute("/collections/{name}/{docID}", http.MethodPatch, collectionUpdate, h.Update)
 	router.AddRoute("/collections/{name}/{docID}", http.MethodDelete, collectionDelete, h.Delete)
+	router.AddRoute("/collections/{name}/{docID}/revert", http.MethodPost, collectionRevert, h.Revert)
 }
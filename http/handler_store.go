@@ -57,6 +57,22 @@ func (s *storeHandler) BasicExport(rw http.ResponseWriter, req *http.Request) {
 	rw.WriteHeader(http.StatusOK)
 }
 
+func (s *storeHandler) CompactDAG(rw http.ResponseWriter, req *http.Request) {
+	store := mustGetContextClientStore(req)
+
+	var config client.CompactionConfig
+	if err := requestJSON(req, &config); err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	result, err := store.CompactDAG(req.Context(), config)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	responseJSON(rw, http.StatusOK, result)
+}
+
 func (s *storeHandler) AddSchema(rw http.ResponseWriter, req *http.Request) {
 	store := mustGetContextClientStore(req)
 
@@ -364,6 +380,12 @@ func (h *storeHandler) bindRoutes(router *Router) {
 	backupConfigSchema := &openapi3.SchemaRef{
 		Ref: "#/components/schemas/backup_config",
 	}
+	compactionConfigSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/compaction_config",
+	}
+	compactionResultSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/compaction_result",
+	}
 	addViewSchema := &openapi3.SchemaRef{
 		Ref: "#/components/schemas/add_view_request",
 	}
@@ -621,6 +643,24 @@ func (h *storeHandler) bindRoutes(router *Router) {
 	graphQLGet.AddResponse(200, graphQLResponse)
 	graphQLGet.Responses.Set("400", errorResponse)
 
+	dagCompactRequest := openapi3.NewRequestBody().
+		WithRequired(true).
+		WithJSONSchemaRef(compactionConfigSchema)
+	dagCompactResponse := openapi3.NewResponse().
+		WithDescription("Compaction result").
+		WithJSONSchemaRef(compactionResultSchema)
+
+	dagCompact := openapi3.NewOperation()
+	dagCompact.OperationID = "dag_compact"
+	dagCompact.Description = "Squash the history of documents into snapshots and prune the squashed blocks"
+	dagCompact.Tags = []string{"dag"}
+	dagCompact.Responses = openapi3.NewResponses()
+	dagCompact.AddResponse(200, dagCompactResponse)
+	dagCompact.Responses.Set("400", errorResponse)
+	dagCompact.RequestBody = &openapi3.RequestBodyRef{
+		Value: dagCompactRequest,
+	}
+
 	debugDump := openapi3.NewOperation()
 	debugDump.Description = "Dump database"
 	debugDump.OperationID = "debug_dump"
@@ -631,6 +671,7 @@ func (h *storeHandler) bindRoutes(router *Router) {
 
 	router.AddRoute("/backup/export", http.MethodPost, backupExport, h.BasicExport)
 	router.AddRoute("/backup/import", http.MethodPost, backupImport, h.BasicImport)
+	router.AddRoute("/dag/compact", http.MethodPost, dagCompact, h.CompactDAG)
 	router.AddRoute("/collections", http.MethodGet, collectionDescribe, h.GetCollection)
 	router.AddRoute("/collections", http.MethodPatch, patchCollection, h.PatchCollection)
 	router.AddRoute("/view", http.MethodPost, views, h.AddView)
@@ -24,13 +24,18 @@ var openApiSchemas = map[string]any{
 	"create_tx":                       &CreateTxResponse{},
 	"collection_update":               &CollectionUpdateRequest{},
 	"collection_delete":               &CollectionDeleteRequest{},
+	"collection_revert":               &CollectionRevertRequest{},
 	"peer_info":                       &peer.AddrInfo{},
 	"graphql_request":                 &GraphQLRequest{},
 	"backup_config":                   &client.BackupConfig{},
+	"compaction_config":               &client.CompactionConfig{},
+	"compaction_result":               &client.CompactionResult{},
 	"collection":                      &client.CollectionDescription{},
 	"schema":                          &client.SchemaDescription{},
 	"collection_definition":           &client.CollectionDefinition{},
 	"index":                           &client.IndexDescription{},
+	"index_verify_options":            &client.IndexVerifyOptions{},
+	"index_verify_result":             &client.IndexVerifyResult{},
 	"delete_result":                   &client.DeleteResult{},
 	"update_result":                   &client.UpdateResult{},
 	"lens_config":                     &client.LensConfig{},
@@ -158,6 +163,10 @@ func NewOpenAPISpec() (*openapi3.T, error) {
 				Name:        "backup",
 				Description: "Database backup operations",
 			},
+			&openapi3.Tag{
+				Name:        "dag",
+				Description: "Document DAG maintenance operations",
+			},
 			&openapi3.Tag{
 				Name:        "graphql",
 				Description: "GraphQL query endpoints",
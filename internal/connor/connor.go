@@ -29,6 +29,11 @@ const (
 	NotLikeOp                = "_nlike"
 	CaseInsensitiveLikeOp    = "_ilike"
 	CaseInsensitiveNotLikeOp = "_nilike"
+	RegexOp                  = "_regex"
+	NotRegexOp               = "_nregex"
+	SearchOp                 = "_search"
+	WithinRadiusOp           = "_withinRadius"
+	WithinBoxOp              = "_withinBox"
 )
 
 // IsOpSimple returns true if the given operator is simple (not compound).
@@ -38,7 +43,9 @@ func IsOpSimple(op string) bool {
 	switch op {
 	case EqualOp, GreaterOrEqualOp, GreaterOp, InOp,
 		LesserOrEqualOp, LesserOp, NotEqualOp, NotInOp,
-		LikeOp, NotLikeOp, CaseInsensitiveLikeOp, CaseInsensitiveNotLikeOp:
+		LikeOp, NotLikeOp, CaseInsensitiveLikeOp, CaseInsensitiveNotLikeOp,
+		RegexOp, NotRegexOp,
+		SearchOp, WithinRadiusOp, WithinBoxOp:
 		return true
 	default:
 		return false
@@ -88,6 +95,16 @@ func matchWith(op string, conditions, data any) (bool, error) {
 		return ilike(conditions, data)
 	case CaseInsensitiveNotLikeOp:
 		return nilike(conditions, data)
+	case RegexOp:
+		return regex(conditions, data)
+	case NotRegexOp:
+		return nregex(conditions, data)
+	case SearchOp:
+		return search(conditions, data)
+	case WithinRadiusOp:
+		return withinRadius(conditions, data)
+	case WithinBoxOp:
+		return withinBox(conditions, data)
 	case NoneOp:
 		return none(conditions, data)
 	case NotOp:
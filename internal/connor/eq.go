@@ -6,6 +6,7 @@ import (
 
 	"github.com/sourcenetwork/immutable"
 
+	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/internal/connor/numbers"
 	ctime "github.com/sourcenetwork/defradb/internal/connor/time"
 	"github.com/sourcenetwork/defradb/internal/core"
@@ -29,11 +30,19 @@ func eq(condition, data any) (bool, error) {
 
 	case immutable.Option[string]:
 		data = immutableValueOrNil(arr)
+
+	case immutable.Option[client.GeoPoint]:
+		data = immutableValueOrNil(arr)
 	}
 
 	switch cn := condition.(type) {
 	case map[FilterKey]any:
 		for prop, cond := range cn {
+			if prop.GetOperatorOrDefault("") == "" {
+				// Property conditions may compare the property with other
+				// values of the same data.
+				cond, _ = resolveReferences(cond, data)
+			}
 			m, err := matchWith(prop.GetOperatorOrDefault(EqualOp), cond, prop.GetProp(data))
 			if err != nil {
 				return false, err
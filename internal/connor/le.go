@@ -31,6 +31,15 @@ func le(condition, data any) (bool, error) {
 		default:
 			return false, client.NewErrUnhandledType("data", d)
 		}
+	case string:
+		switch d := data.(type) {
+		case string:
+			return d <= c, nil
+		case nil:
+			return false, nil
+		default:
+			return false, client.NewErrUnhandledType("condition", c)
+		}
 	default:
 		switch cn := numbers.TryUpcast(condition).(type) {
 		case float64:
@@ -0,0 +1,26 @@
+package connor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNRegex(t *testing.T) {
+	const testString = "Source is the glue of web3"
+
+	// match
+	result, err := nregex(newTestRegex(t, "^Source.*web[0-9]$", false), testString)
+	require.NoError(t, err)
+	require.False(t, result)
+
+	// mismatch
+	result, err = nregex(newTestRegex(t, "^glue", false), testString)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	// case insensitive match
+	result, err = nregex(newTestRegex(t, "^SOURCE", true), testString)
+	require.NoError(t, err)
+	require.False(t, result)
+}
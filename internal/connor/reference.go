@@ -0,0 +1,65 @@
+package connor
+
+// ValueReference represents a condition value that is not known until the
+// data is filtered, such as the value of another field of the same document.
+type ValueReference interface {
+	// GetValue returns the referenced value from the given data.
+	GetValue(data any) any
+}
+
+// resolveReferences replaces any value references within the given
+// property condition with the values they refer to in the given data.
+//
+// Conditions on nested properties are left untouched as they are resolved
+// against their own data when matched. The given condition is never mutated,
+// a copy is returned if any reference has been resolved.
+func resolveReferences(condition, data any) (any, bool) {
+	switch cn := condition.(type) {
+	case ValueReference:
+		return cn.GetValue(data), true
+
+	case map[FilterKey]any:
+		var resolved map[FilterKey]any
+		for prop, cond := range cn {
+			if prop.GetOperatorOrDefault("") == "" {
+				continue
+			}
+			value, ok := resolveReferences(cond, data)
+			if !ok {
+				continue
+			}
+			if resolved == nil {
+				resolved = make(map[FilterKey]any, len(cn))
+				for k, v := range cn {
+					resolved[k] = v
+				}
+			}
+			resolved[prop] = value
+		}
+		if resolved == nil {
+			return condition, false
+		}
+		return resolved, true
+
+	case []any:
+		var resolved []any
+		for i, cond := range cn {
+			value, ok := resolveReferences(cond, data)
+			if !ok {
+				continue
+			}
+			if resolved == nil {
+				resolved = make([]any, len(cn))
+				copy(resolved, cn)
+			}
+			resolved[i] = value
+		}
+		if resolved == nil {
+			return condition, false
+		}
+		return resolved, true
+
+	default:
+		return condition, false
+	}
+}
@@ -0,0 +1,85 @@
+package connor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testProperty string
+
+func (k testProperty) GetProp(data any) any {
+	return data.(map[string]any)[string(k)]
+}
+
+func (k testProperty) GetOperatorOrDefault(defaultOp string) string {
+	return defaultOp
+}
+
+func (k testProperty) Equal(other FilterKey) bool {
+	return other == k
+}
+
+type testOperator string
+
+func (k testOperator) GetProp(data any) any {
+	return data
+}
+
+func (k testOperator) GetOperatorOrDefault(defaultOp string) string {
+	return string(k)
+}
+
+func (k testOperator) Equal(other FilterKey) bool {
+	return other == k
+}
+
+type testReference string
+
+func (r testReference) GetValue(data any) any {
+	return data.(map[string]any)[string(r)]
+}
+
+func TestMatchWithValueReference(t *testing.T) {
+	conditions := map[FilterKey]any{
+		testProperty("stock"): map[FilterKey]any{
+			testOperator(LesserOp): testReference("reorderLevel"),
+		},
+	}
+
+	result, err := Match(conditions, map[string]any{"stock": int64(3), "reorderLevel": int64(5)})
+	require.NoError(t, err)
+	require.True(t, result)
+
+	result, err = Match(conditions, map[string]any{"stock": int64(6), "reorderLevel": int64(5)})
+	require.NoError(t, err)
+	require.False(t, result)
+
+	// the conditions must not be altered by the resolution
+	require.Equal(t, testReference("reorderLevel"), conditions[testProperty("stock")].(map[FilterKey]any)[testOperator(LesserOp)])
+}
+
+func TestMatchWithValueReferenceWithinCompoundOperator(t *testing.T) {
+	conditions := map[FilterKey]any{
+		testOperator(OrOp): []any{
+			map[FilterKey]any{
+				testProperty("name"): map[FilterKey]any{
+					testOperator(EqualOp): testReference("nickname"),
+				},
+			},
+			map[FilterKey]any{
+				testProperty("name"): map[FilterKey]any{
+					testOperator(GreaterOp): testReference("nickname"),
+				},
+			},
+		},
+	}
+
+	result, err := Match(conditions, map[string]any{"name": "John", "nickname": "John"})
+	require.NoError(t, err)
+	require.True(t, result)
+
+	result, err = Match(conditions, map[string]any{"name": "John", "nickname": "Johnny"})
+	require.NoError(t, err)
+	require.False(t, result)
+}
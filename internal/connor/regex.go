@@ -0,0 +1,103 @@
+package connor
+
+import (
+	"regexp"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+const (
+	// RegexPatternFieldName is the name of the field holding the pattern of the regular
+	// expression operators.
+	RegexPatternFieldName = "pattern"
+	// RegexCaseInsensitiveFieldName is the name of the field of the regular expression
+	// operators indicating whether the pattern is matched case insensitively.
+	RegexCaseInsensitiveFieldName = "caseInsensitive"
+)
+
+// Regex is a compiled regular expression condition.
+//
+// Filters hold it in place of the condition of the regular expression operators, so that
+// the pattern is compiled once and not for every document it is matched against.
+type Regex struct {
+	// Pattern is the regular expression, using the RE2 syntax.
+	Pattern string
+	// CaseInsensitive is true if the pattern is matched case insensitively.
+	CaseInsensitive bool
+
+	re *regexp.Regexp
+}
+
+// NewRegex compiles the given regular expression condition, for example
+// `{pattern: "^rob", caseInsensitive: true}`.
+func NewRegex(condition any) (*Regex, error) {
+	props, ok := condition.(map[string]any)
+	if !ok {
+		return nil, client.NewErrUnhandledType("condition", condition)
+	}
+	pattern, ok := props[RegexPatternFieldName].(string)
+	if !ok {
+		return nil, client.NewErrUnhandledType(RegexPatternFieldName, props[RegexPatternFieldName])
+	}
+	caseInsensitive, _ := props[RegexCaseInsensitiveFieldName].(bool)
+
+	r := &Regex{
+		Pattern:         pattern,
+		CaseInsensitive: caseInsensitive,
+	}
+	re, err := regexp.Compile(r.String())
+	if err != nil {
+		return nil, NewErrInvalidRegex(pattern, err)
+	}
+	r.re = re
+	return r, nil
+}
+
+// MatchString returns true if the given string matches the regular expression.
+func (r *Regex) MatchString(s string) bool {
+	return r.re.MatchString(s)
+}
+
+// String returns the regular expression in the RE2 syntax, including its flags.
+func (r *Regex) String() string {
+	if r.CaseInsensitive {
+		return "(?i)" + r.Pattern
+	}
+	return r.Pattern
+}
+
+// ToMap returns the regular expression in the form it is requested with.
+func (r *Regex) ToMap() map[string]any {
+	return map[string]any{
+		RegexPatternFieldName:         r.Pattern,
+		RegexCaseInsensitiveFieldName: r.CaseInsensitive,
+	}
+}
+
+// regex is an operator which tests if a string matches a regular expression.
+func regex(condition, data any) (bool, error) {
+	switch d := data.(type) {
+	case immutable.Option[string]:
+		if !d.HasValue() {
+			return condition == nil, nil
+		}
+		data = d.Value()
+	}
+
+	re, ok := condition.(*Regex)
+	if !ok {
+		// the condition has not been compiled when the filter was mapped, for example
+		// because its pattern is invalid
+		var err error
+		re, err = NewRegex(condition)
+		if err != nil {
+			return false, err
+		}
+	}
+	if d, ok := data.(string); ok {
+		return re.MatchString(d), nil
+	}
+	return false, nil
+}
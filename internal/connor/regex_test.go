@@ -0,0 +1,72 @@
+package connor
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRegex(t *testing.T, pattern string, caseInsensitive bool) *Regex {
+	re, err := NewRegex(map[string]any{
+		RegexPatternFieldName:         pattern,
+		RegexCaseInsensitiveFieldName: caseInsensitive,
+	})
+	require.NoError(t, err)
+	return re
+}
+
+func TestRegex(t *testing.T) {
+	const testString = "Source is the glue of web3"
+
+	// match anywhere
+	result, err := regex(newTestRegex(t, "glue", false), testString)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	// match anchored
+	result, err = regex(newTestRegex(t, "^Source.*web[0-9]$", false), testString)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	// anchored mismatch
+	result, err = regex(newTestRegex(t, "^glue", false), testString)
+	require.NoError(t, err)
+	require.False(t, result)
+
+	// case sensitive mismatch
+	result, err = regex(newTestRegex(t, "^source", false), testString)
+	require.NoError(t, err)
+	require.False(t, result)
+
+	// case insensitive match
+	result, err = regex(newTestRegex(t, "^SOURCE.*WEB3$", true), testString)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	// inline case insensitive flag
+	result, err = regex(newTestRegex(t, "(?i)^source", false), testString)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	// nil value
+	result, err = regex(newTestRegex(t, "glue", false), immutable.None[string]())
+	require.NoError(t, err)
+	require.False(t, result)
+}
+
+func TestRegex_WithUncompiledCondition_ShouldCompileIt(t *testing.T) {
+	result, err := regex(map[string]any{RegexPatternFieldName: "glue"}, "Source is the glue of web3")
+	require.NoError(t, err)
+	require.True(t, result)
+}
+
+func TestRegex_WithInvalidPattern_ReturnsError(t *testing.T) {
+	_, err := regex(map[string]any{RegexPatternFieldName: "(glue"}, "Source is the glue of web3")
+	require.ErrorIs(t, err, ErrInvalidRegex)
+}
+
+func TestNewRegex_WithoutPattern_ReturnsError(t *testing.T) {
+	_, err := NewRegex(map[string]any{RegexCaseInsensitiveFieldName: true})
+	require.Error(t, err)
+}
@@ -0,0 +1,32 @@
+package connor
+
+import (
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/fulltext"
+)
+
+// search is an operator which performs full-text search tests.
+//
+// It matches the text if it contains at least one of the terms of the
+// search query.
+func search(condition, data any) (bool, error) {
+	switch d := data.(type) {
+	case immutable.Option[string]:
+		if !d.HasValue() {
+			return false, nil
+		}
+		data = d.Value()
+	}
+
+	switch cn := condition.(type) {
+	case string:
+		if d, ok := data.(string); ok {
+			return fulltext.Match(d, cn), nil
+		}
+		return false, nil
+	default:
+		return false, client.NewErrUnhandledType("condition", cn)
+	}
+}
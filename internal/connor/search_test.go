@@ -0,0 +1,32 @@
+package connor
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearch(t *testing.T) {
+	const testString = "Source Is The Glue of Web3"
+
+	// match a single term
+	result, err := search("glue", testString)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	// match any of the terms
+	result, err = search("paper GLUE", testString)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	// terms must match whole words
+	result, err = search("web", testString)
+	require.NoError(t, err)
+	require.False(t, result)
+
+	// no value never matches
+	result, err = search("glue", immutable.None[string]())
+	require.NoError(t, err)
+	require.False(t, result)
+}
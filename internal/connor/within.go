@@ -0,0 +1,41 @@
+package connor
+
+import (
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/geo"
+)
+
+// withinRadius is an operator which tests whether a geo point is within
+// a distance of another one.
+func withinRadius(condition, data any) (bool, error) {
+	circle, err := geo.NewCircle(condition)
+	if err != nil {
+		return false, err
+	}
+	return within(circle, data)
+}
+
+// withinBox is an operator which tests whether a geo point is within
+// a latitude and longitude range.
+func withinBox(condition, data any) (bool, error) {
+	box, err := geo.NewBox(condition)
+	if err != nil {
+		return false, err
+	}
+	return within(box, data)
+}
+
+func within(area geo.Area, data any) (bool, error) {
+	switch d := data.(type) {
+	case client.GeoPoint:
+		return area.Contains(d), nil
+	case immutable.Option[client.GeoPoint]:
+		return d.HasValue() && area.Contains(d.Value()), nil
+	case nil:
+		return false, nil
+	default:
+		return false, client.NewErrUnhandledType("data", d)
+	}
+}
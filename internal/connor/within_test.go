@@ -0,0 +1,52 @@
+package connor
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func TestWithinRadius(t *testing.T) {
+	paris := client.GeoPoint{Lat: 48.8566, Lon: 2.3522}
+	versailles := client.GeoPoint{Lat: 48.8049, Lon: 2.1204}
+
+	result, err := withinRadius(map[string]any{"lat": 48.8566, "lon": 2.3522, "distance": float64(20_000)}, versailles)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	result, err = withinRadius(map[string]any{"lat": 48.8566, "lon": 2.3522, "distance": float64(5_000)}, versailles)
+	require.NoError(t, err)
+	require.False(t, result)
+
+	result, err = withinRadius(map[string]any{"lat": 48.8566, "lon": 2.3522, "distance": float64(0)}, paris)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	// no value never matches
+	result, err = withinRadius(
+		map[string]any{"lat": 48.8566, "lon": 2.3522, "distance": float64(5_000)},
+		immutable.None[client.GeoPoint](),
+	)
+	require.NoError(t, err)
+	require.False(t, result)
+}
+
+func TestWithinBox(t *testing.T) {
+	box := map[string]any{"minLat": float64(48), "minLon": float64(2), "maxLat": float64(49), "maxLon": float64(3)}
+
+	result, err := withinBox(box, client.GeoPoint{Lat: 48.8566, Lon: 2.3522})
+	require.NoError(t, err)
+	require.True(t, result)
+
+	result, err = withinBox(box, client.GeoPoint{Lat: 51.5074, Lon: -0.1278})
+	require.NoError(t, err)
+	require.False(t, result)
+}
+
+func TestWithinBox_WithInvalidCondition_ReturnsError(t *testing.T) {
+	_, err := withinBox("not a box", client.GeoPoint{})
+	require.Error(t, err)
+}
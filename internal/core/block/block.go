@@ -33,6 +33,8 @@ var (
 	SchemaPrototype           ipld.NodePrototype
 	EncryptionSchema          schema.Type
 	EncryptionSchemaPrototype ipld.NodePrototype
+	SnapshotSchema            schema.Type
+	SnapshotSchemaPrototype   ipld.NodePrototype
 )
 
 func init() {
@@ -44,12 +46,23 @@ func init() {
 		&crdt.LWWRegDelta{},
 		&crdt.CompositeDAGDelta{},
 		&crdt.CounterDelta{},
+		&crdt.ORSetDelta{},
+		&crdt.MVRegDelta{},
+		&crdt.RGATextDelta{},
+		&crdt.LWWMapDelta{},
+		&Signature{},
 	)
 
 	EncryptionSchema, EncryptionSchemaPrototype = mustSetSchema(
 		"Encryption",
 		&Encryption{},
 	)
+
+	SnapshotSchema, SnapshotSchemaPrototype = mustSetSchema(
+		"Snapshot",
+		&Snapshot{},
+		&SnapshotValue{},
+	)
 }
 
 type schemaDefinition interface {
@@ -144,6 +157,18 @@ type Block struct {
 	// Encryption contains the encryption information for the block's delta.
 	// It needs to be a pointer so that it can be translated from and to `optional` in the IPLD schema.
 	Encryption *cidlink.Link
+
+	// Timestamp is the hybrid logical clock timestamp of the time at which the block was created.
+	//
+	// It is nil for blocks created without a clock.
+	// It needs to be a pointer so that it can be translated from and to `optional` in the IPLD schema.
+	Timestamp *uint64
+
+	// Signature is the signature of the block by the identity that created it.
+	//
+	// It is nil for blocks created without an identity holding a private key.
+	// It needs to be a pointer so that it can be translated from and to `optional` in the IPLD schema.
+	Signature *Signature
 }
 
 // IsEncrypted returns true if the block is encrypted.
@@ -158,6 +183,8 @@ func (block *Block) Clone() *Block {
 		Heads:      block.Heads,
 		Links:      block.Links,
 		Encryption: block.Encryption,
+		Timestamp:  block.Timestamp,
+		Signature:  block.Signature,
 	}
 }
 
@@ -186,6 +213,8 @@ func (block *Block) IPLDSchemaBytes() []byte {
 			heads       optional [Link]
 			links       optional [DAGLink]
 			encryption  optional Link
+			timestamp   optional Int
+			signature   optional Signature
 		}
 	`)
 }
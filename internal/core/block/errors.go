@@ -23,6 +23,10 @@ const (
 	errGeneratingLink              string = "failed to generate link"
 	errInvalidBlockEncryptionType  string = "invalid block encryption type"
 	errInvalidBlockEncryptionKeyID string = "invalid block encryption key id"
+	errSigningBlock                string = "failed to sign block"
+	errMissingPrivateKey           string = "identity has no private key"
+	errBlockNotSigned              string = "block is not signed"
+	errInvalidBlockSignature       string = "invalid block signature"
 )
 
 // Errors returnable from this package.
@@ -36,6 +40,10 @@ var (
 	ErrGeneratingLink              = errors.New(errGeneratingLink)
 	ErrInvalidBlockEncryptionType  = errors.New(errInvalidBlockEncryptionType)
 	ErrInvalidBlockEncryptionKeyID = errors.New(errInvalidBlockEncryptionKeyID)
+	ErrSigningBlock                = errors.New(errSigningBlock)
+	ErrMissingPrivateKey           = errors.New(errMissingPrivateKey)
+	ErrBlockNotSigned              = errors.New(errBlockNotSigned)
+	ErrInvalidBlockSignature       = errors.New(errInvalidBlockSignature)
 )
 
 // NewErrFailedToGetPriority returns an error indicating that the priority could not be retrieved.
@@ -70,3 +78,20 @@ func NewErrGeneratingLink(err error) error {
 		err,
 	)
 }
+
+// NewErrSigningBlock returns an error indicating that the block could not be signed.
+func NewErrSigningBlock(err error) error {
+	return errors.Wrap(
+		errSigningBlock,
+		err,
+	)
+}
+
+// NewErrInvalidBlockSignature returns an error indicating that the block signature does not
+// match the block content and the identity of the signer.
+func NewErrInvalidBlockSignature(signer string, inner error) error {
+	if inner == nil {
+		return errors.New(errInvalidBlockSignature, errors.NewKV("Signer", signer))
+	}
+	return errors.Wrap(errInvalidBlockSignature, inner, errors.NewKV("Signer", signer))
+}
@@ -0,0 +1,103 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package coreblock
+
+import (
+	"crypto/sha256"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+
+	acpIdentity "github.com/sourcenetwork/defradb/acp/identity"
+)
+
+// Signature contains the signature of a block by the identity that created it.
+type Signature struct {
+	// Identity is the DID of the identity that signed the block.
+	Identity string
+	// Value is the DER encoded secp256k1 ECDSA signature of the SHA-256 hash of the
+	// block encoded without its signature.
+	Value []byte
+}
+
+// IPLDSchemaBytes returns the IPLD schema representation for the signature.
+//
+// This needs to match the [Signature] struct or [mustSetSchema] will panic on init.
+func (sig *Signature) IPLDSchemaBytes() []byte {
+	return []byte(`
+		type Signature struct {
+			identity String
+			value    Bytes
+		}
+	`)
+}
+
+// IsSigned returns true if the block is signed.
+func (block *Block) IsSigned() bool {
+	return block.Signature != nil
+}
+
+// Sign signs the block with the private key of the given identity.
+//
+// The signature covers every other field of the block, so it must be called after
+// the block content is final.
+func (block *Block) Sign(identity acpIdentity.Identity) error {
+	if identity.PrivateKey == nil {
+		return NewErrSigningBlock(ErrMissingPrivateKey)
+	}
+	hash, err := block.signingHash()
+	if err != nil {
+		return NewErrSigningBlock(err)
+	}
+	sig := ecdsa.Sign(identity.PrivateKey, hash)
+	block.Signature = &Signature{
+		Identity: identity.DID,
+		Value:    sig.Serialize(),
+	}
+	return nil
+}
+
+// VerifySignature verifies that the block signature was made by the signer identity
+// over the current content of the block.
+//
+// It returns [ErrBlockNotSigned] if the block has no signature.
+func (block *Block) VerifySignature() error {
+	if block.Signature == nil {
+		return ErrBlockNotSigned
+	}
+	publicKey, err := acpIdentity.PublicKeyFromDID(block.Signature.Identity)
+	if err != nil {
+		return NewErrInvalidBlockSignature(block.Signature.Identity, err)
+	}
+	sig, err := ecdsa.ParseDERSignature(block.Signature.Value)
+	if err != nil {
+		return NewErrInvalidBlockSignature(block.Signature.Identity, err)
+	}
+	hash, err := block.signingHash()
+	if err != nil {
+		return err
+	}
+	if !sig.Verify(hash, publicKey) {
+		return NewErrInvalidBlockSignature(block.Signature.Identity, nil)
+	}
+	return nil
+}
+
+// signingHash returns the hash of the block encoded without its signature.
+func (block *Block) signingHash() ([]byte, error) {
+	unsigned := *block
+	unsigned.Signature = nil
+	b, err := unsigned.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(b)
+	return hash[:], nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package coreblock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+
+	acpIdentity "github.com/sourcenetwork/defradb/acp/identity"
+	"github.com/sourcenetwork/defradb/internal/core/crdt"
+)
+
+func newTestIdentity(t *testing.T) acpIdentity.Identity {
+	privateKey, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	identity, err := acpIdentity.FromPrivateKey(
+		privateKey,
+		time.Hour,
+		immutable.None[string](),
+		immutable.None[string](),
+		true,
+	)
+	require.NoError(t, err)
+	return identity
+}
+
+func newTestSignedBlock(t *testing.T, identity acpIdentity.Identity) *Block {
+	block := New(&crdt.LWWRegDelta{
+		DocID:           []byte("docID"),
+		FieldName:       "name",
+		Priority:        1,
+		SchemaVersionID: "schemaVersionID",
+		Data:            []byte("John"),
+	}, nil)
+	err := block.Sign(identity)
+	require.NoError(t, err)
+	return block
+}
+
+func TestBlockSign_VerifySignature_Succeed(t *testing.T) {
+	identity := newTestIdentity(t)
+	block := newTestSignedBlock(t, identity)
+
+	require.True(t, block.IsSigned())
+	require.Equal(t, identity.DID, block.Signature.Identity)
+	require.NoError(t, block.VerifySignature())
+}
+
+func TestBlockSign_AfterMarshalAndUnmarshal_VerifySignatureSucceed(t *testing.T) {
+	block := newTestSignedBlock(t, newTestIdentity(t))
+
+	b, err := block.Marshal()
+	require.NoError(t, err)
+
+	loadedBlock, err := GetFromBytes(b)
+	require.NoError(t, err)
+
+	require.Equal(t, block.Signature, loadedBlock.Signature)
+	require.NoError(t, loadedBlock.VerifySignature())
+}
+
+func TestBlockSign_WithoutPrivateKey_Error(t *testing.T) {
+	identity := newTestIdentity(t)
+	identity.PrivateKey = nil
+
+	block := New(&crdt.LWWRegDelta{Data: []byte("John")}, nil)
+	err := block.Sign(identity)
+	require.ErrorIs(t, err, ErrSigningBlock)
+}
+
+func TestBlockVerifySignature_WithoutSignature_Error(t *testing.T) {
+	block := New(&crdt.LWWRegDelta{Data: []byte("John")}, nil)
+
+	require.False(t, block.IsSigned())
+	require.ErrorIs(t, block.VerifySignature(), ErrBlockNotSigned)
+}
+
+func TestBlockVerifySignature_WithTamperedDelta_Error(t *testing.T) {
+	block := newTestSignedBlock(t, newTestIdentity(t))
+	block.Delta.SetData([]byte("Islam"))
+
+	require.ErrorIs(t, block.VerifySignature(), ErrInvalidBlockSignature)
+}
+
+func TestBlockVerifySignature_WithOtherSigner_Error(t *testing.T) {
+	block := newTestSignedBlock(t, newTestIdentity(t))
+	block.Signature.Identity = newTestIdentity(t).DID
+
+	require.ErrorIs(t, block.VerifySignature(), ErrInvalidBlockSignature)
+}
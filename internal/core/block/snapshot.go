@@ -0,0 +1,101 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package coreblock
+
+import (
+	"github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/codec/dagcbor"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/node/bindnode"
+)
+
+// Snapshot contains the state of a document resulting from the part of its DAG that has been
+// pruned by a compaction.
+//
+// The blocks that remain after the compaction are applied on top of this state to rebuild the
+// state of the document at a given version.
+type Snapshot struct {
+	// DocID is the ID of the document the snapshot belongs to.
+	DocID []byte
+	// Height is the height of the highest pruned composite block.
+	Height uint64
+	// Heads are the links to the newest pruned composite blocks.
+	Heads []cidlink.Link
+	// Values are the datastore entries holding the state of the document.
+	Values []SnapshotValue
+}
+
+// SnapshotValue is a datastore entry of a [Snapshot].
+type SnapshotValue struct {
+	// Key is the datastore key of the entry.
+	Key string
+	// Value is the datastore value of the entry.
+	Value []byte
+}
+
+// IPLDSchemaBytes returns the IPLD schema representation for the snapshot block.
+//
+// This needs to match the [Snapshot] struct or [mustSetSchema] will panic on init.
+func (snapshot *Snapshot) IPLDSchemaBytes() []byte {
+	return []byte(`
+		type Snapshot struct {
+			docID   Bytes
+			height  Int
+			heads   [Link]
+			values  [SnapshotValue]
+		}
+	`)
+}
+
+// IPLDSchemaBytes returns the IPLD schema representation for the snapshot value.
+//
+// This needs to match the [SnapshotValue] struct or [mustSetSchema] will panic on init.
+func (v *SnapshotValue) IPLDSchemaBytes() []byte {
+	return []byte(`
+		type SnapshotValue struct {
+			key    String
+			value  Bytes
+		}
+	`)
+}
+
+// GetSnapshotFromBytes returns a snapshot block from encoded bytes.
+func GetSnapshotFromBytes(b []byte) (*Snapshot, error) {
+	snapshot := &Snapshot{}
+	err := snapshot.Unmarshal(b)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// Marshal encodes the snapshot using CBOR encoding.
+func (snapshot *Snapshot) Marshal() ([]byte, error) {
+	b, err := ipld.Marshal(dagcbor.Encode, snapshot, SnapshotSchema)
+	if err != nil {
+		return nil, NewErrEncodingBlock(err)
+	}
+	return b, nil
+}
+
+// Unmarshal decodes the snapshot from CBOR encoding.
+func (snapshot *Snapshot) Unmarshal(b []byte) error {
+	_, err := ipld.Unmarshal(b, dagcbor.Decode, snapshot, SnapshotSchema)
+	if err != nil {
+		return NewErrUnmarshallingBlock(err)
+	}
+	return nil
+}
+
+// GenerateNode generates an IPLD node from the snapshot block in its representation form.
+func (snapshot *Snapshot) GenerateNode() ipld.Node {
+	return bindnode.Wrap(snapshot, SnapshotSchema).Representation()
+}
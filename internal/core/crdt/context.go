@@ -0,0 +1,33 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"context"
+)
+
+// resolvedHeadsContextKey is the key type for conflict resolution heads context values.
+type resolvedHeadsContextKey struct{}
+
+// GetContextResolvedHeads returns the IDs of the MVRegister values that a write in the
+// given context supersedes, and true if the context holds any.
+func GetContextResolvedHeads(ctx context.Context) ([][]byte, bool) {
+	heads, ok := ctx.Value(resolvedHeadsContextKey{}).([][]byte)
+	return heads, ok
+}
+
+// SetContextResolvedHeads returns a new context with the given MVRegister value IDs set.
+//
+// MVRegister writes made with the returned context supersede only the listed values
+// instead of all the values currently held by the register.
+func SetContextResolvedHeads(ctx context.Context, heads [][]byte) context.Context {
+	return context.WithValue(ctx, resolvedHeadsContextKey{}, heads)
+}
@@ -16,10 +16,19 @@ import (
 )
 
 const (
-	errFailedToGetPriority    string = "failed to get priority"
-	errFailedToStoreValue     string = "failed to store value"
-	errNegativeValue          string = "value cannot be negative"
-	errUnsupportedCounterType string = "unsupported counter type. Valid types are int64 and float64"
+	errFailedToGetPriority     string = "failed to get priority"
+	errFailedToStoreValue      string = "failed to store value"
+	errNegativeValue           string = "value cannot be negative"
+	errUnsupportedCounterType  string = "unsupported counter type. Valid types are int64 and float64"
+	errInvalidORSetValue       string = "invalid OR-Set value. Value must be an array"
+	errFailedToDecodeORSet     string = "failed to decode OR-Set delta"
+	errFailedToDecodeMVReg     string = "failed to decode multi-value register state"
+	errInvalidRGATextValue     string = "invalid RGA text value. Value must be a string"
+	errFailedToDecodeRGAText   string = "failed to decode RGA text delta"
+	errRGATextElementNotFound  string = "RGA text element not found"
+	errRGATextSpliceOutOfRange string = "RGA text splice is out of the range of the text"
+	errInvalidLWWMapValue      string = "invalid LWW map value"
+	errFailedToDecodeLWWMap    string = "failed to decode LWW map delta"
 )
 
 // Errors returnable from this package.
@@ -33,8 +42,17 @@ var (
 	ErrEncodingPriority    = errors.New("error encoding priority")
 	ErrDecodingPriority    = errors.New("error decoding priority")
 	// ErrMismatchedMergeType - Tying to merge two ReplicatedData of different types
-	ErrMismatchedMergeType    = errors.New("given type to merge does not match source")
-	ErrUnsupportedCounterType = errors.New(errUnsupportedCounterType)
+	ErrMismatchedMergeType     = errors.New("given type to merge does not match source")
+	ErrUnsupportedCounterType  = errors.New(errUnsupportedCounterType)
+	ErrInvalidORSetValue       = errors.New(errInvalidORSetValue)
+	ErrFailedToDecodeORSet     = errors.New(errFailedToDecodeORSet)
+	ErrFailedToDecodeMVReg     = errors.New(errFailedToDecodeMVReg)
+	ErrInvalidRGATextValue     = errors.New(errInvalidRGATextValue)
+	ErrFailedToDecodeRGAText   = errors.New(errFailedToDecodeRGAText)
+	ErrRGATextElementNotFound  = errors.New(errRGATextElementNotFound)
+	ErrRGATextSpliceOutOfRange = errors.New(errRGATextSpliceOutOfRange)
+	ErrInvalidLWWMapValue      = errors.New(errInvalidLWWMapValue)
+	ErrFailedToDecodeLWWMap    = errors.New(errFailedToDecodeLWWMap)
 )
 
 // NewErrFailedToGetPriority returns an error indicating that the priority could not be retrieved.
@@ -54,3 +72,53 @@ func NewErrNegativeValue[T Incrementable](value T) error {
 func NewErrUnsupportedCounterType(valueType client.ScalarKind) error {
 	return errors.New(errUnsupportedCounterType, errors.NewKV("Type", valueType))
 }
+
+// NewErrInvalidORSetValue returns an error indicating that the value given to an OR-Set is not an array.
+func NewErrInvalidORSetValue(inner error) error {
+	return errors.Wrap(errInvalidORSetValue, inner)
+}
+
+// NewErrFailedToDecodeORSetDelta returns an error indicating that the OR-Set delta data could not be decoded.
+func NewErrFailedToDecodeORSetDelta(inner error) error {
+	return errors.Wrap(errFailedToDecodeORSet, inner)
+}
+
+// NewErrFailedToDecodeMVRegState returns an error indicating that the stored multi-value
+// register state could not be decoded.
+func NewErrFailedToDecodeMVRegState(inner error) error {
+	return errors.Wrap(errFailedToDecodeMVReg, inner)
+}
+
+// NewErrInvalidRGATextValue returns an error indicating that the value given to an RGA text is not a string.
+func NewErrInvalidRGATextValue(inner error) error {
+	return errors.Wrap(errInvalidRGATextValue, inner)
+}
+
+// NewErrFailedToDecodeRGATextDelta returns an error indicating that the RGA text delta data could not be decoded.
+func NewErrFailedToDecodeRGATextDelta(inner error) error {
+	return errors.Wrap(errFailedToDecodeRGAText, inner)
+}
+
+// NewErrRGATextElementNotFound returns an error indicating that the element an insertion
+// is anchored to does not exist.
+//
+// As blocks are merged in causal order this should never happen.
+func NewErrRGATextElementNotFound(id []byte) error {
+	return errors.New(errRGATextElementNotFound, errors.NewKV("ID", id))
+}
+
+// NewErrRGATextSpliceOutOfRange returns an error indicating that the range of characters
+// of a splice is not within the text.
+func NewErrRGATextSpliceOutOfRange(index int, delete int) error {
+	return errors.New(errRGATextSpliceOutOfRange, errors.NewKV("Index", index), errors.NewKV("Delete", delete))
+}
+
+// NewErrInvalidLWWMapValue returns an error indicating that the value given to an LWW map is not valid JSON.
+func NewErrInvalidLWWMapValue(inner error) error {
+	return errors.Wrap(errInvalidLWWMapValue, inner)
+}
+
+// NewErrFailedToDecodeLWWMapDelta returns an error indicating that the LWW map delta data could not be decoded.
+func NewErrFailedToDecodeLWWMapDelta(inner error) error {
+	return errors.Wrap(errFailedToDecodeLWWMap, inner)
+}
@@ -17,6 +17,10 @@ type CRDT struct {
 	LWWRegDelta       *LWWRegDelta
 	CompositeDAGDelta *CompositeDAGDelta
 	CounterDelta      *CounterDelta
+	ORSetDelta        *ORSetDelta
+	MVRegDelta        *MVRegDelta
+	RGATextDelta      *RGATextDelta
+	LWWMapDelta       *LWWMapDelta
 }
 
 // NewCRDT returns a new CRDT.
@@ -28,6 +32,14 @@ func NewCRDT(delta core.Delta) CRDT {
 		return CRDT{CompositeDAGDelta: d}
 	case *CounterDelta:
 		return CRDT{CounterDelta: d}
+	case *ORSetDelta:
+		return CRDT{ORSetDelta: d}
+	case *MVRegDelta:
+		return CRDT{MVRegDelta: d}
+	case *RGATextDelta:
+		return CRDT{RGATextDelta: d}
+	case *LWWMapDelta:
+		return CRDT{LWWMapDelta: d}
 	}
 	return CRDT{}
 }
@@ -41,6 +53,10 @@ func (c CRDT) IPLDSchemaBytes() []byte {
 		| LWWRegDelta "lww"
 		| CompositeDAGDelta "composite"
 		| CounterDelta "counter"
+		| ORSetDelta "orset"
+		| MVRegDelta "mvreg"
+		| RGATextDelta "rga"
+		| LWWMapDelta "lwwmap"
 	} representation keyed`)
 }
 
@@ -53,6 +69,14 @@ func (c CRDT) GetDelta() core.Delta {
 		return c.CompositeDAGDelta
 	case c.CounterDelta != nil:
 		return c.CounterDelta
+	case c.ORSetDelta != nil:
+		return c.ORSetDelta
+	case c.MVRegDelta != nil:
+		return c.MVRegDelta
+	case c.RGATextDelta != nil:
+		return c.RGATextDelta
+	case c.LWWMapDelta != nil:
+		return c.LWWMapDelta
 	}
 	return nil
 }
@@ -66,6 +90,14 @@ func (c CRDT) GetPriority() uint64 {
 		return c.CompositeDAGDelta.GetPriority()
 	case c.CounterDelta != nil:
 		return c.CounterDelta.GetPriority()
+	case c.ORSetDelta != nil:
+		return c.ORSetDelta.GetPriority()
+	case c.MVRegDelta != nil:
+		return c.MVRegDelta.GetPriority()
+	case c.RGATextDelta != nil:
+		return c.RGATextDelta.GetPriority()
+	case c.LWWMapDelta != nil:
+		return c.LWWMapDelta.GetPriority()
 	}
 	return 0
 }
@@ -77,6 +109,14 @@ func (c CRDT) GetFieldName() string {
 		return c.LWWRegDelta.FieldName
 	case c.CounterDelta != nil:
 		return c.CounterDelta.FieldName
+	case c.ORSetDelta != nil:
+		return c.ORSetDelta.FieldName
+	case c.MVRegDelta != nil:
+		return c.MVRegDelta.FieldName
+	case c.RGATextDelta != nil:
+		return c.RGATextDelta.FieldName
+	case c.LWWMapDelta != nil:
+		return c.LWWMapDelta.FieldName
 	}
 	return ""
 }
@@ -90,6 +130,14 @@ func (c CRDT) GetDocID() []byte {
 		return c.CompositeDAGDelta.DocID
 	case c.CounterDelta != nil:
 		return c.CounterDelta.DocID
+	case c.ORSetDelta != nil:
+		return c.ORSetDelta.DocID
+	case c.MVRegDelta != nil:
+		return c.MVRegDelta.DocID
+	case c.RGATextDelta != nil:
+		return c.RGATextDelta.DocID
+	case c.LWWMapDelta != nil:
+		return c.LWWMapDelta.DocID
 	}
 	return nil
 }
@@ -103,6 +151,14 @@ func (c CRDT) GetSchemaVersionID() string {
 		return c.CompositeDAGDelta.SchemaVersionID
 	case c.CounterDelta != nil:
 		return c.CounterDelta.SchemaVersionID
+	case c.ORSetDelta != nil:
+		return c.ORSetDelta.SchemaVersionID
+	case c.MVRegDelta != nil:
+		return c.MVRegDelta.SchemaVersionID
+	case c.RGATextDelta != nil:
+		return c.RGATextDelta.SchemaVersionID
+	case c.LWWMapDelta != nil:
+		return c.LWWMapDelta.SchemaVersionID
 	}
 	return ""
 }
@@ -135,6 +191,40 @@ func (c CRDT) Clone() CRDT {
 			Nonce:           c.CounterDelta.Nonce,
 			Data:            c.CounterDelta.Data,
 		}
+	case c.ORSetDelta != nil:
+		cloned.ORSetDelta = &ORSetDelta{
+			DocID:           c.ORSetDelta.DocID,
+			FieldName:       c.ORSetDelta.FieldName,
+			Priority:        c.ORSetDelta.Priority,
+			SchemaVersionID: c.ORSetDelta.SchemaVersionID,
+			Data:            c.ORSetDelta.Data,
+		}
+	case c.MVRegDelta != nil:
+		cloned.MVRegDelta = &MVRegDelta{
+			DocID:           c.MVRegDelta.DocID,
+			FieldName:       c.MVRegDelta.FieldName,
+			Priority:        c.MVRegDelta.Priority,
+			SchemaVersionID: c.MVRegDelta.SchemaVersionID,
+			ValueID:         c.MVRegDelta.ValueID,
+			Supersedes:      c.MVRegDelta.Supersedes,
+			Data:            c.MVRegDelta.Data,
+		}
+	case c.RGATextDelta != nil:
+		cloned.RGATextDelta = &RGATextDelta{
+			DocID:           c.RGATextDelta.DocID,
+			FieldName:       c.RGATextDelta.FieldName,
+			Priority:        c.RGATextDelta.Priority,
+			SchemaVersionID: c.RGATextDelta.SchemaVersionID,
+			Data:            c.RGATextDelta.Data,
+		}
+	case c.LWWMapDelta != nil:
+		cloned.LWWMapDelta = &LWWMapDelta{
+			DocID:           c.LWWMapDelta.DocID,
+			FieldName:       c.LWWMapDelta.FieldName,
+			Priority:        c.LWWMapDelta.Priority,
+			SchemaVersionID: c.LWWMapDelta.SchemaVersionID,
+			Data:            c.LWWMapDelta.Data,
+		}
 	}
 	return cloned
 }
@@ -155,6 +245,14 @@ func (c CRDT) GetData() []byte {
 		return c.LWWRegDelta.Data
 	} else if c.CounterDelta != nil {
 		return c.CounterDelta.Data
+	} else if c.ORSetDelta != nil {
+		return c.ORSetDelta.Data
+	} else if c.MVRegDelta != nil {
+		return c.MVRegDelta.Data
+	} else if c.RGATextDelta != nil {
+		return c.RGATextDelta.Data
+	} else if c.LWWMapDelta != nil {
+		return c.LWWMapDelta.Data
 	}
 	return nil
 }
@@ -165,6 +263,14 @@ func (c CRDT) SetData(data []byte) {
 		c.LWWRegDelta.Data = data
 	} else if c.CounterDelta != nil {
 		c.CounterDelta.Data = data
+	} else if c.ORSetDelta != nil {
+		c.ORSetDelta.Data = data
+	} else if c.MVRegDelta != nil {
+		c.MVRegDelta.Data = data
+	} else if c.RGATextDelta != nil {
+		c.RGATextDelta.Data = data
+	} else if c.LWWMapDelta != nil {
+		c.LWWMapDelta.Data = data
 	}
 }
 
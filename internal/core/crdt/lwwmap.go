@@ -0,0 +1,451 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"reflect"
+	"slices"
+
+	"github.com/fxamacker/cbor/v2"
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/db/base"
+)
+
+// lwwMapObjectMarker is the value written to a path to mark it as holding an object.
+var lwwMapObjectMarker = []byte{0xa0} // CBOR encoded empty map
+
+// LWWMapOperation is a single write to a key path of an LWWMap.
+type LWWMapOperation struct {
+	// Path is the list of object keys leading to the written value, the root value
+	// has an empty path.
+	Path []string
+	// Value is the CBOR encoded value written at the path. Objects are written as an
+	// empty map and their keys are written as separate operations.
+	Value []byte
+	// Deleted is true if the operation removes the key at the path.
+	Deleted bool
+}
+
+// LWWMapDelta is a single delta operation for an LWWMap
+type LWWMapDelta struct {
+	DocID     []byte
+	FieldName string
+	Priority  uint64
+	// SchemaVersionID is the schema version datastore key at the time of commit.
+	//
+	// It can be used to identify the collection datastructure state at the time of commit.
+	SchemaVersionID string
+	// Data is the CBOR encoded list of [LWWMapOperation] of this delta.
+	Data []byte
+}
+
+var _ core.Delta = (*LWWMapDelta)(nil)
+
+// IPLDSchemaBytes returns the IPLD schema representation for the type.
+//
+// This needs to match the [LWWMapDelta] struct or [coreblock.mustSetSchema] will panic on init.
+func (delta *LWWMapDelta) IPLDSchemaBytes() []byte {
+	return []byte(`
+	type LWWMapDelta struct {
+		docID     		Bytes
+		fieldName 		String
+		priority  		Int
+		schemaVersionID String
+		data            Bytes
+	}`)
+}
+
+// GetPriority gets the current priority for this delta.
+func (delta *LWWMapDelta) GetPriority() uint64 {
+	return delta.Priority
+}
+
+// SetPriority will set the priority for this delta.
+func (delta *LWWMapDelta) SetPriority(prio uint64) {
+	delta.Priority = prio
+}
+
+// LWWMap, Last-Write-Wins Map, is a map CRDT for JSON fields.
+//
+// Every key path of the JSON value is tracked as its own Last-Write-Wins register, so that
+// concurrent writes to different keys of the same object are all kept. Nested objects are
+// merged recursively, values that are not objects, including arrays, are written as a whole.
+type LWWMap struct {
+	baseCRDT
+}
+
+var _ core.ReplicatedData = (*LWWMap)(nil)
+
+// NewLWWMap returns a new instance of the LWWMap with the given ID.
+func NewLWWMap(
+	store datastore.DSReaderWriter,
+	schemaVersionKey core.CollectionSchemaVersionKey,
+	key core.DataStoreKey,
+	fieldName string,
+) LWWMap {
+	return LWWMap{newBaseCRDT(store, key, schemaVersionKey, fieldName)}
+}
+
+// Value gets the current map value as a CBOR encoded JSON value.
+func (m LWWMap) Value(ctx context.Context) ([]byte, error) {
+	valueK := m.key.WithValueFlag()
+	buf, err := m.store.Get(ctx, valueK.ToDS())
+	if err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Set generates a new delta that will transform the current map into the given
+// CBOR encoded JSON value.
+//
+// Only the key paths whose value differs from the current map are written by the delta.
+// A nil value removes the whole map.
+func (m LWWMap) Set(ctx context.Context, value []byte) (*LWWMapDelta, error) {
+	state, err := m.getState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	current := state.materialize()
+
+	var ops []LWWMapOperation
+	if len(value) == 0 || bytes.Equal(value, client.CborNil) {
+		if current != nil {
+			ops = append(ops, LWWMapOperation{Deleted: true})
+		}
+	} else {
+		next, err := decodeLWWMapTree(value)
+		if err != nil {
+			return nil, err
+		}
+		ops = diffLWWMapTree(nil, current, next, ops)
+	}
+
+	data, err := cbor.Marshal(ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LWWMapDelta{
+		DocID:           []byte(m.key.DocID),
+		FieldName:       m.fieldName,
+		SchemaVersionID: m.schemaVersionKey.SchemaVersionID,
+		Data:            data,
+	}, nil
+}
+
+// Merge implements ReplicatedData interface.
+// Each key path written by the delta is merged like an LWWRegister.
+func (m LWWMap) Merge(ctx context.Context, delta core.Delta) error {
+	d, ok := delta.(*LWWMapDelta)
+	if !ok {
+		return ErrMismatchedMergeType
+	}
+
+	var ops []LWWMapOperation
+	err := cbor.Unmarshal(d.Data, &ops)
+	if err != nil {
+		return NewErrFailedToDecodeLWWMapDelta(err)
+	}
+
+	state, err := m.getState(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, op := range ops {
+		entry := lwwMapEntry{
+			Path:     op.Path,
+			Priority: d.GetPriority(),
+			Value:    op.Value,
+			Deleted:  op.Deleted,
+		}
+		key, err := lwwMapPathKey(op.Path)
+		if err != nil {
+			return err
+		}
+		if existing, ok := state[key]; !ok || compareLWWMapEntries(existing, entry) < 0 {
+			state[key] = entry
+		}
+	}
+
+	err = m.setState(ctx, state)
+	if err != nil {
+		return err
+	}
+
+	curPrio, err := m.getPriority(ctx, m.key)
+	if err != nil {
+		return NewErrFailedToGetPriority(err)
+	}
+	if d.GetPriority() < curPrio {
+		return nil
+	}
+	return m.setPriority(ctx, m.key, d.GetPriority())
+}
+
+// lwwMapEntry is the stored state of a single key path.
+type lwwMapEntry struct {
+	Path     []string
+	Priority uint64
+	Value    []byte
+	Deleted  bool
+}
+
+// lwwMapState is the state of an LWWMap indexed by the encoded key paths.
+type lwwMapState map[string]lwwMapEntry
+
+func (m LWWMap) getState(ctx context.Context) (lwwMapState, error) {
+	buf, err := m.store.Get(ctx, m.key.WithStateFlag().ToDS())
+	if err != nil {
+		if errors.Is(err, ds.ErrNotFound) {
+			return lwwMapState{}, nil
+		}
+		return nil, err
+	}
+
+	var entries []lwwMapEntry
+	err = cbor.Unmarshal(buf, &entries)
+	if err != nil {
+		return nil, err
+	}
+	state := make(lwwMapState, len(entries))
+	for _, entry := range entries {
+		key, err := lwwMapPathKey(entry.Path)
+		if err != nil {
+			return nil, err
+		}
+		state[key] = entry
+	}
+	return state, nil
+}
+
+func (m LWWMap) setState(ctx context.Context, state lwwMapState) error {
+	entries := state.sortedEntries()
+	stateBytes, err := cbor.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	err = m.store.Put(ctx, m.key.WithStateFlag().ToDS(), stateBytes)
+	if err != nil {
+		return NewErrFailedToStoreValue(err)
+	}
+
+	key := m.key.WithValueFlag()
+	marker, err := m.store.Get(ctx, m.key.ToPrimaryDataStoreKey().ToDS())
+	if err != nil && !errors.Is(err, ds.ErrNotFound) {
+		return err
+	}
+	if bytes.Equal(marker, []byte{base.DeletedObjectMarker}) {
+		key = key.WithDeletedFlag()
+	}
+
+	tree := state.materialize()
+	if tree == nil {
+		// Like the LWWRegister, a nil value is not stored.
+		return m.store.Delete(ctx, key.ToDS())
+	}
+
+	em, err := client.CborEncodingOptions().EncMode()
+	if err != nil {
+		return err
+	}
+	val, err := em.Marshal(tree)
+	if err != nil {
+		return err
+	}
+	err = m.store.Put(ctx, key.ToDS(), val)
+	if err != nil {
+		return NewErrFailedToStoreValue(err)
+	}
+	return nil
+}
+
+// sortedEntries returns the entries of the state in the order in which they must be applied,
+// from the oldest to the newest write.
+func (state lwwMapState) sortedEntries() []lwwMapEntry {
+	entries := make([]lwwMapEntry, 0, len(state))
+	for _, entry := range state {
+		entries = append(entries, entry)
+	}
+	slices.SortFunc(entries, func(a, b lwwMapEntry) int {
+		if c := cmp.Compare(a.Priority, b.Priority); c != 0 {
+			return c
+		}
+		if c := slices.Compare(a.Path, b.Path); c != 0 {
+			return c
+		}
+		return compareLWWMapEntries(a, b)
+	})
+	return entries
+}
+
+// materialize builds the JSON value of the map.
+//
+// The entries are applied from the oldest to the newest, so that a later write to a path
+// replaces any value previously written at or under that path. Objects are represented as
+// maps and all the other values as their CBOR encoding.
+func (state lwwMapState) materialize() any {
+	var root any
+	for _, entry := range state.sortedEntries() {
+		root = applyLWWMapEntry(root, entry.Path, entry)
+	}
+	return root
+}
+
+// applyLWWMapEntry applies the given entry to the given node, the path being relative to the node.
+func applyLWWMapEntry(node any, path []string, entry lwwMapEntry) any {
+	if len(path) == 0 {
+		if entry.Deleted {
+			return nil
+		}
+		if bytes.Equal(entry.Value, lwwMapObjectMarker) {
+			if _, ok := node.(map[string]any); ok {
+				// The keys of an existing object are kept so that concurrently created
+				// objects are merged.
+				return node
+			}
+			return map[string]any{}
+		}
+		return cbor.RawMessage(entry.Value)
+	}
+
+	obj, ok := node.(map[string]any)
+	if !ok {
+		if entry.Deleted {
+			// There is nothing to delete.
+			return node
+		}
+		obj = map[string]any{}
+	}
+	child := applyLWWMapEntry(obj[path[0]], path[1:], entry)
+	if child == nil {
+		delete(obj, path[0])
+	} else {
+		obj[path[0]] = child
+	}
+	return obj
+}
+
+// diffLWWMapTree appends the operations required to turn the current node into the next node.
+//
+// A nil current node means that no value exists at the path.
+func diffLWWMapTree(path []string, current, next any, ops []LWWMapOperation) []LWWMapOperation {
+	nextObj, ok := next.(map[string]any)
+	if !ok {
+		nextValue := next.(cbor.RawMessage)
+		if currentValue, ok := current.(cbor.RawMessage); !ok || !bytes.Equal(currentValue, nextValue) {
+			ops = append(ops, LWWMapOperation{Path: path, Value: nextValue})
+		}
+		return ops
+	}
+
+	currentObj, ok := current.(map[string]any)
+	if !ok {
+		ops = append(ops, LWWMapOperation{Path: path, Value: lwwMapObjectMarker})
+	}
+	for _, key := range sortedLWWMapKeys(nextObj) {
+		ops = diffLWWMapTree(appendLWWMapPath(path, key), currentObj[key], nextObj[key], ops)
+	}
+	for _, key := range sortedLWWMapKeys(currentObj) {
+		if _, ok := nextObj[key]; !ok {
+			ops = append(ops, LWWMapOperation{Path: appendLWWMapPath(path, key), Deleted: true})
+		}
+	}
+	return ops
+}
+
+// decodeLWWMapTree decodes the given CBOR encoded JSON value into maps, for objects, and the
+// canonical CBOR encoding of all the other values.
+func decodeLWWMapTree(value []byte) (any, error) {
+	dm, err := cbor.DecOptions{DefaultMapType: reflect.TypeOf(map[string]any(nil))}.DecMode()
+	if err != nil {
+		return nil, err
+	}
+	var decoded any
+	err = dm.Unmarshal(value, &decoded)
+	if err != nil {
+		return nil, NewErrInvalidLWWMapValue(err)
+	}
+	em, err := client.CborEncodingOptions().EncMode()
+	if err != nil {
+		return nil, err
+	}
+	return toLWWMapTree(em, decoded)
+}
+
+func toLWWMapTree(em cbor.EncMode, value any) (any, error) {
+	obj, ok := value.(map[string]any)
+	if !ok {
+		b, err := em.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		return cbor.RawMessage(b), nil
+	}
+	tree := make(map[string]any, len(obj))
+	for key, v := range obj {
+		node, err := toLWWMapTree(em, v)
+		if err != nil {
+			return nil, err
+		}
+		tree[key] = node
+	}
+	return tree, nil
+}
+
+// compareLWWMapEntries compares two writes to the same path. Like the LWWRegister, the
+// write with the highest priority wins and ties are broken by the greatest value.
+func compareLWWMapEntries(a, b lwwMapEntry) int {
+	if c := cmp.Compare(a.Priority, b.Priority); c != 0 {
+		return c
+	}
+	if a.Deleted != b.Deleted {
+		if a.Deleted {
+			return -1
+		}
+		return 1
+	}
+	return bytes.Compare(a.Value, b.Value)
+}
+
+// lwwMapPathKey returns the key of the given path within the state.
+func lwwMapPathKey(path []string) (string, error) {
+	if path == nil {
+		path = []string{}
+	}
+	b, err := cbor.Marshal(path)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func appendLWWMapPath(path []string, key string) []string {
+	return append(slices.Clip(path), key)
+}
+
+func sortedLWWMapKeys(obj map[string]any) []string {
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,180 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/internal/core"
+)
+
+func setupLWWMap() LWWMap {
+	store := newMockStore()
+	key := core.DataStoreKey{DocID: "AAAA-BBBB"}
+	return NewLWWMap(store, core.CollectionSchemaVersionKey{}, key, "settings")
+}
+
+func mustEncodeLWWMapValue(t *testing.T, value any) []byte {
+	b, err := cbor.Marshal(value)
+	require.NoError(t, err)
+	return b
+}
+
+func mustDecodeLWWMapValue(t *testing.T, m LWWMap, ctx context.Context) any {
+	b, err := m.Value(ctx)
+	require.NoError(t, err)
+	dm, err := cbor.DecOptions{DefaultMapType: reflect.TypeOf(map[string]any(nil))}.DecMode()
+	require.NoError(t, err)
+	var value any
+	err = dm.Unmarshal(b, &value)
+	require.NoError(t, err)
+	return value
+}
+
+// setLWWMap sets the value of the given map and merges the resulting delta with the given priority.
+func setLWWMap(t *testing.T, m LWWMap, ctx context.Context, value any, prio uint64) *LWWMapDelta {
+	delta, err := m.Set(ctx, mustEncodeLWWMapValue(t, value))
+	require.NoError(t, err)
+	delta.SetPriority(prio)
+	mergeLWWMapDeltas(t, m, ctx, delta)
+	return delta
+}
+
+func mergeLWWMapDeltas(t *testing.T, m LWWMap, ctx context.Context, deltas ...*LWWMapDelta) {
+	for _, delta := range deltas {
+		err := m.Merge(ctx, delta)
+		require.NoError(t, err)
+	}
+}
+
+func TestLWWMapMerge_SetAndRemoveKeys(t *testing.T) {
+	ctx := context.Background()
+	m := setupLWWMap()
+
+	setLWWMap(t, m, ctx, map[string]any{"theme": "dark", "font": map[string]any{"size": 12}}, 1)
+	require.Equal(
+		t,
+		map[string]any{"theme": "dark", "font": map[string]any{"size": uint64(12)}},
+		mustDecodeLWWMapValue(t, m, ctx),
+	)
+
+	setLWWMap(t, m, ctx, map[string]any{"font": map[string]any{"size": 14, "family": "mono"}}, 2)
+	require.Equal(
+		t,
+		map[string]any{"font": map[string]any{"size": uint64(14), "family": "mono"}},
+		mustDecodeLWWMapValue(t, m, ctx),
+	)
+
+	setLWWMap(t, m, ctx, map[string]any{"font": "mono"}, 3)
+	require.Equal(t, map[string]any{"font": "mono"}, mustDecodeLWWMapValue(t, m, ctx))
+}
+
+func TestLWWMapSet_OnlyRecordsChangedKeys(t *testing.T) {
+	ctx := context.Background()
+	m := setupLWWMap()
+
+	setLWWMap(t, m, ctx, map[string]any{"theme": "dark", "tags": []any{"a", "b"}}, 1)
+
+	delta, err := m.Set(ctx, mustEncodeLWWMapValue(t, map[string]any{"theme": "light", "tags": []any{"a", "b"}}))
+	require.NoError(t, err)
+
+	var ops []LWWMapOperation
+	err = cbor.Unmarshal(delta.Data, &ops)
+	require.NoError(t, err)
+	require.Len(t, ops, 1)
+	require.Equal(t, []string{"theme"}, ops[0].Path)
+}
+
+func TestLWWMapMerge_ConcurrentEditsToDifferentKeys_KeepsBoth(t *testing.T) {
+	ctx := context.Background()
+	mapA := setupLWWMap()
+	mapB := setupLWWMap()
+
+	initialDelta := setLWWMap(t, mapA, ctx, map[string]any{"theme": "dark", "font": map[string]any{"size": 12}}, 1)
+	mergeLWWMapDeltas(t, mapB, ctx, initialDelta)
+
+	deltaA := setLWWMap(t, mapA, ctx, map[string]any{"theme": "light", "font": map[string]any{"size": 12}}, 2)
+	deltaB := setLWWMap(t, mapB, ctx, map[string]any{"theme": "dark", "font": map[string]any{"size": 14}, "lang": "en"}, 2)
+
+	mergeLWWMapDeltas(t, mapA, ctx, deltaB)
+	mergeLWWMapDeltas(t, mapB, ctx, deltaA)
+
+	expected := map[string]any{"theme": "light", "font": map[string]any{"size": uint64(14)}, "lang": "en"}
+	require.Equal(t, expected, mustDecodeLWWMapValue(t, mapA, ctx))
+	require.Equal(t, expected, mustDecodeLWWMapValue(t, mapB, ctx))
+}
+
+func TestLWWMapMerge_ConcurrentlyCreatedObjects_AreMerged(t *testing.T) {
+	ctx := context.Background()
+	mapA := setupLWWMap()
+	mapB := setupLWWMap()
+
+	initialDelta := setLWWMap(t, mapA, ctx, map[string]any{}, 1)
+	mergeLWWMapDeltas(t, mapB, ctx, initialDelta)
+
+	deltaA := setLWWMap(t, mapA, ctx, map[string]any{"font": map[string]any{"size": 12}}, 2)
+	deltaB := setLWWMap(t, mapB, ctx, map[string]any{"font": map[string]any{"family": "mono"}}, 2)
+
+	mergeLWWMapDeltas(t, mapA, ctx, deltaB)
+	mergeLWWMapDeltas(t, mapB, ctx, deltaA)
+
+	expected := map[string]any{"font": map[string]any{"size": uint64(12), "family": "mono"}}
+	require.Equal(t, expected, mustDecodeLWWMapValue(t, mapA, ctx))
+	require.Equal(t, expected, mustDecodeLWWMapValue(t, mapB, ctx))
+}
+
+func TestLWWMapMerge_ConcurrentEditsToSameKey_HighestEncodedValueWins(t *testing.T) {
+	ctx := context.Background()
+	mapA := setupLWWMap()
+	mapB := setupLWWMap()
+
+	initialDelta := setLWWMap(t, mapA, ctx, map[string]any{"theme": "dark"}, 1)
+	mergeLWWMapDeltas(t, mapB, ctx, initialDelta)
+
+	deltaA := setLWWMap(t, mapA, ctx, map[string]any{"theme": "blue"}, 2)
+	deltaB := setLWWMap(t, mapB, ctx, map[string]any{"theme": "red"}, 2)
+
+	mergeLWWMapDeltas(t, mapA, ctx, deltaB)
+	mergeLWWMapDeltas(t, mapB, ctx, deltaA)
+
+	// The CBOR encoding of a string is prefixed by its length, so "blue" is greater than "red".
+	require.Equal(t, map[string]any{"theme": "blue"}, mustDecodeLWWMapValue(t, mapA, ctx))
+	require.Equal(t, map[string]any{"theme": "blue"}, mustDecodeLWWMapValue(t, mapB, ctx))
+}
+
+func TestLWWMapMerge_WithNil_RemovesValue(t *testing.T) {
+	ctx := context.Background()
+	m := setupLWWMap()
+
+	setLWWMap(t, m, ctx, map[string]any{"theme": "dark"}, 1)
+
+	delta, err := m.Set(ctx, nil)
+	require.NoError(t, err)
+	delta.SetPriority(2)
+	mergeLWWMapDeltas(t, m, ctx, delta)
+
+	_, err = m.Value(ctx)
+	require.ErrorIs(t, err, ds.ErrNotFound)
+}
+
+func TestLWWMapMerge_WithMismatchedDelta_Error(t *testing.T) {
+	ctx := context.Background()
+	m := setupLWWMap()
+
+	err := m.Merge(ctx, &LWWRegDelta{})
+	require.ErrorIs(t, err, ErrMismatchedMergeType)
+}
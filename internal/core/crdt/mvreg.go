@@ -0,0 +1,311 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"sort"
+
+	"github.com/fxamacker/cbor/v2"
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/db/base"
+)
+
+// mvRegIDLength is the number of bytes of the hash used to identify a register write.
+const mvRegIDLength = 16
+
+// MVRegDelta is a single delta operation for an MVRegister
+type MVRegDelta struct {
+	DocID     []byte
+	FieldName string
+	Priority  uint64
+	// SchemaVersionID is the schema version datastore key at the time of commit.
+	//
+	// It can be used to identify the collection datastructure state at the time of commit.
+	SchemaVersionID string
+	// ValueID uniquely identifies the value written by this delta.
+	//
+	// It is derived from the content of the delta so that the dag blocks of a document
+	// remain reproducible.
+	ValueID []byte
+	// Supersedes contains the IDs of the values that this delta replaces.
+	Supersedes [][]byte
+	Data       []byte
+}
+
+var _ core.Delta = (*MVRegDelta)(nil)
+
+// IPLDSchemaBytes returns the IPLD schema representation for the type.
+//
+// This needs to match the [MVRegDelta] struct or [coreblock.mustSetSchema] will panic on init.
+func (delta *MVRegDelta) IPLDSchemaBytes() []byte {
+	return []byte(`
+	type MVRegDelta struct {
+		docID     		Bytes
+		fieldName 		String
+		priority  		Int
+		schemaVersionID String
+		valueID         Bytes
+		supersedes      optional [Bytes]
+		data            Bytes
+	}`)
+}
+
+// GetPriority gets the current priority for this delta.
+func (delta *MVRegDelta) GetPriority() uint64 {
+	return delta.Priority
+}
+
+// SetPriority will set the priority for this delta.
+func (delta *MVRegDelta) SetPriority(prio uint64) {
+	delta.Priority = prio
+}
+
+// MVRegisterValue is a single concurrent value held by an MVRegister.
+type MVRegisterValue struct {
+	// ID uniquely identifies the write that produced this value.
+	ID []byte
+	// Priority is the priority of the delta that produced this value.
+	Priority uint64
+	// Value is the CBOR encoded value.
+	Value []byte
+}
+
+// MVRegister, Multi-Value Register, is a register CRDT that keeps every concurrently
+// written value instead of picking a winner.
+//
+// Each write supersedes the values observed at the time of writing. Values written
+// concurrently on different peers do not supersede each other and are all kept until a
+// later write supersedes them. The field value is that of the concurrent value with the
+// highest priority, ties are broken by the greatest value, matching [LWWRegister].
+type MVRegister struct {
+	baseCRDT
+}
+
+var _ core.ReplicatedData = (*MVRegister)(nil)
+
+// NewMVRegister returns a new instance of the MVRegister with the given ID.
+func NewMVRegister(
+	store datastore.DSReaderWriter,
+	schemaVersionKey core.CollectionSchemaVersionKey,
+	key core.DataStoreKey,
+	fieldName string,
+) MVRegister {
+	return MVRegister{newBaseCRDT(store, key, schemaVersionKey, fieldName)}
+}
+
+// Value gets the current register value.
+func (reg MVRegister) Value(ctx context.Context) ([]byte, error) {
+	valueK := reg.key.WithValueFlag()
+	buf, err := reg.store.Get(ctx, valueK.ToDS())
+	if err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Values returns all the concurrent values currently held by the register ordered by ID.
+func (reg MVRegister) Values(ctx context.Context) ([]MVRegisterValue, error) {
+	state, err := reg.getState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]MVRegisterValue, 0, len(state))
+	for _, id := range sortedMVRegIDs(state) {
+		values = append(values, MVRegisterValue{
+			ID:       []byte(id),
+			Priority: state[id].Priority,
+			Value:    state[id].Value,
+		})
+	}
+	return values, nil
+}
+
+// Set generates a new delta with the supplied value.
+//
+// The delta supersedes all the values currently held by the register, unless the given
+// context holds conflict resolution heads (see [SetContextResolvedHeads]) in which case
+// only the listed values are superseded.
+func (reg MVRegister) Set(ctx context.Context, value []byte) (*MVRegDelta, error) {
+	state, err := reg.getState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var supersedes [][]byte
+	if heads, ok := GetContextResolvedHeads(ctx); ok {
+		for _, head := range heads {
+			if _, ok := state[string(head)]; ok {
+				supersedes = append(supersedes, head)
+			}
+		}
+	} else {
+		for _, id := range sortedMVRegIDs(state) {
+			supersedes = append(supersedes, []byte(id))
+		}
+	}
+
+	delta := &MVRegDelta{
+		Data:            value,
+		DocID:           []byte(reg.key.DocID),
+		FieldName:       reg.fieldName,
+		SchemaVersionID: reg.schemaVersionKey.SchemaVersionID,
+		Supersedes:      supersedes,
+	}
+	delta.ValueID, err = newMVRegID(delta)
+	if err != nil {
+		return nil, err
+	}
+	return delta, nil
+}
+
+// newMVRegID returns the ID of the value written by the given delta.
+//
+// The ID is a hash of the written value and of the IDs it supersedes. As every write
+// supersedes the value it was made on top of, writes can only share an ID if they write
+// the same value on top of the same values, in which case they are treated as one.
+func newMVRegID(delta *MVRegDelta) ([]byte, error) {
+	b, err := cbor.Marshal([]any{delta.DocID, delta.FieldName, delta.Supersedes, delta.Data})
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(b)
+	return hash[:mvRegIDLength], nil
+}
+
+// Merge implements ReplicatedData interface.
+// It removes the values superseded by the delta and then adds the value of the delta.
+func (reg MVRegister) Merge(ctx context.Context, delta core.Delta) error {
+	d, ok := delta.(*MVRegDelta)
+	if !ok {
+		return ErrMismatchedMergeType
+	}
+
+	state, err := reg.getState(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range d.Supersedes {
+		delete(state, string(id))
+	}
+	state[string(d.ValueID)] = mvRegEntry{Priority: d.GetPriority(), Value: d.Data}
+
+	err = reg.setState(ctx, state)
+	if err != nil {
+		return err
+	}
+
+	curPrio, err := reg.getPriority(ctx, reg.key)
+	if err != nil {
+		return NewErrFailedToGetPriority(err)
+	}
+	if d.GetPriority() < curPrio {
+		return nil
+	}
+	return reg.setPriority(ctx, reg.key, d.GetPriority())
+}
+
+// mvRegEntry is the state of a single concurrent value.
+type mvRegEntry struct {
+	Priority uint64
+	Value    []byte
+}
+
+// mvRegStateEntry is the stored state of a single concurrent value.
+//
+// The state is stored as a list of entries instead of a map as the binary IDs
+// are not valid map keys.
+type mvRegStateEntry struct {
+	ID []byte
+	mvRegEntry
+}
+
+func (reg MVRegister) getState(ctx context.Context) (map[string]mvRegEntry, error) {
+	buf, err := reg.store.Get(ctx, reg.key.WithStateFlag().ToDS())
+	if err != nil {
+		if errors.Is(err, ds.ErrNotFound) {
+			return map[string]mvRegEntry{}, nil
+		}
+		return nil, err
+	}
+
+	var entries []mvRegStateEntry
+	err = cbor.Unmarshal(buf, &entries)
+	if err != nil {
+		return nil, NewErrFailedToDecodeMVRegState(err)
+	}
+	state := make(map[string]mvRegEntry, len(entries))
+	for _, entry := range entries {
+		state[string(entry.ID)] = entry.mvRegEntry
+	}
+	return state, nil
+}
+
+func (reg MVRegister) setState(ctx context.Context, state map[string]mvRegEntry) error {
+	ids := sortedMVRegIDs(state)
+	entries := make([]mvRegStateEntry, len(ids))
+	for i, id := range ids {
+		entries[i] = mvRegStateEntry{ID: []byte(id), mvRegEntry: state[id]}
+	}
+	stateBytes, err := cbor.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	err = reg.store.Put(ctx, reg.key.WithStateFlag().ToDS(), stateBytes)
+	if err != nil {
+		return NewErrFailedToStoreValue(err)
+	}
+
+	var winner *mvRegEntry
+	for _, id := range ids {
+		entry := state[id]
+		if winner == nil || entry.Priority > winner.Priority ||
+			(entry.Priority == winner.Priority && bytes.Compare(entry.Value, winner.Value) > 0) {
+			winner = &entry
+		}
+	}
+
+	key := reg.key.WithValueFlag()
+	marker, err := reg.store.Get(ctx, reg.key.ToPrimaryDataStoreKey().ToDS())
+	if err != nil && !errors.Is(err, ds.ErrNotFound) {
+		return err
+	}
+	if bytes.Equal(marker, []byte{base.DeletedObjectMarker}) {
+		key = key.WithDeletedFlag()
+	}
+
+	if winner == nil || bytes.Equal(winner.Value, client.CborNil) {
+		// Like the LWWRegister, a nil value is not stored.
+		return reg.store.Delete(ctx, key.ToDS())
+	}
+	err = reg.store.Put(ctx, key.ToDS(), winner.Value)
+	if err != nil {
+		return NewErrFailedToStoreValue(err)
+	}
+	return nil
+}
+
+func sortedMVRegIDs(state map[string]mvRegEntry) []string {
+	ids := make([]string, 0, len(state))
+	for id := range state {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
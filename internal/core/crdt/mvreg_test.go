@@ -0,0 +1,115 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/internal/core"
+)
+
+func setupMVRegister() MVRegister {
+	store := newMockStore()
+	key := core.DataStoreKey{DocID: "AAAA-BBBB"}
+	return NewMVRegister(store, core.CollectionSchemaVersionKey{}, key, "amount")
+}
+
+func mustSetMVRegister(t *testing.T, ctx context.Context, reg MVRegister, value []byte, prio uint64) *MVRegDelta {
+	delta, err := reg.Set(ctx, value)
+	require.NoError(t, err)
+	delta.SetPriority(prio)
+	err = reg.Merge(ctx, delta)
+	require.NoError(t, err)
+	return delta
+}
+
+func mustGetMVRegisterValues(t *testing.T, ctx context.Context, reg MVRegister) [][]byte {
+	values, err := reg.Values(ctx)
+	require.NoError(t, err)
+	result := make([][]byte, len(values))
+	for i, value := range values {
+		result[i] = value.Value
+	}
+	return result
+}
+
+func TestMVRegisterMerge_SequentialWrites_KeepsLatest(t *testing.T) {
+	ctx := context.Background()
+	reg := setupMVRegister()
+
+	mustSetMVRegister(t, ctx, reg, []byte("a"), 1)
+	mustSetMVRegister(t, ctx, reg, []byte("b"), 2)
+
+	require.Equal(t, [][]byte{[]byte("b")}, mustGetMVRegisterValues(t, ctx, reg))
+	value, err := reg.Value(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []byte("b"), value)
+}
+
+func TestMVRegisterMerge_ConcurrentWrites_KeepsBoth(t *testing.T) {
+	ctx := context.Background()
+	regA := setupMVRegister()
+	regB := setupMVRegister()
+
+	initial := mustSetMVRegister(t, ctx, regA, []byte("a"), 1)
+	err := regB.Merge(ctx, initial)
+	require.NoError(t, err)
+
+	deltaA := mustSetMVRegister(t, ctx, regA, []byte("b"), 2)
+	deltaB := mustSetMVRegister(t, ctx, regB, []byte("c"), 2)
+
+	err = regA.Merge(ctx, deltaB)
+	require.NoError(t, err)
+	err = regB.Merge(ctx, deltaA)
+	require.NoError(t, err)
+
+	require.ElementsMatch(t, [][]byte{[]byte("b"), []byte("c")}, mustGetMVRegisterValues(t, ctx, regA))
+	require.Equal(t, mustGetMVRegisterValues(t, ctx, regA), mustGetMVRegisterValues(t, ctx, regB))
+
+	// The field value is the same as the one a LWW register would pick.
+	valueA, err := regA.Value(ctx)
+	require.NoError(t, err)
+	valueB, err := regB.Value(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []byte("c"), valueA)
+	require.Equal(t, []byte("c"), valueB)
+}
+
+func TestMVRegisterSet_WithResolvedHeads_SupersedesOnlyListedValues(t *testing.T) {
+	ctx := context.Background()
+	reg := setupMVRegister()
+
+	initial := mustSetMVRegister(t, ctx, reg, []byte("a"), 1)
+	deltaB := &MVRegDelta{ValueID: []byte("b"), Supersedes: [][]byte{initial.ValueID}, Data: []byte("b"), Priority: 2}
+	deltaC := &MVRegDelta{ValueID: []byte("c"), Supersedes: [][]byte{initial.ValueID}, Data: []byte("c"), Priority: 2}
+	deltaD := &MVRegDelta{ValueID: []byte("d"), Supersedes: [][]byte{initial.ValueID}, Data: []byte("d"), Priority: 2}
+	for _, delta := range []*MVRegDelta{deltaB, deltaC, deltaD} {
+		err := reg.Merge(ctx, delta)
+		require.NoError(t, err)
+	}
+
+	resolveCtx := SetContextResolvedHeads(ctx, [][]byte{deltaB.ValueID, deltaC.ValueID, []byte("unknown")})
+	delta := mustSetMVRegister(t, resolveCtx, reg, []byte("e"), 3)
+
+	require.Equal(t, [][]byte{deltaB.ValueID, deltaC.ValueID}, delta.Supersedes)
+	require.ElementsMatch(t, [][]byte{[]byte("d"), []byte("e")}, mustGetMVRegisterValues(t, ctx, reg))
+}
+
+func TestMVRegisterMerge_WithMismatchedDelta_Error(t *testing.T) {
+	ctx := context.Background()
+	reg := setupMVRegister()
+
+	err := reg.Merge(ctx, &LWWRegDelta{})
+	require.ErrorIs(t, err, ErrMismatchedMergeType)
+}
@@ -0,0 +1,343 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"sort"
+
+	"github.com/fxamacker/cbor/v2"
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/db/base"
+)
+
+// orSetTagLength is the number of random bytes used to uniquely identify an add operation.
+const orSetTagLength = 16
+
+// ORSetElement is a single element of an ORSet along with the tag that uniquely
+// identifies the add operation that introduced it.
+type ORSetElement struct {
+	// Value is the CBOR encoded element value.
+	Value []byte
+	// Tag uniquely identifies the add operation of this element.
+	Tag []byte
+}
+
+// ORSetOperations holds the element add and remove operations of a single ORSetDelta.
+//
+// It is stored CBOR encoded in [ORSetDelta.Data] so that it can be encrypted like
+// the data of any other delta.
+type ORSetOperations struct {
+	// Added contains the elements added by the delta, each with a new unique tag.
+	Added []ORSetElement
+	// Removed contains the element tags observed, and thus removed, by the delta.
+	Removed []ORSetElement
+}
+
+// ORSetDelta is a single delta operation for an ORSet
+type ORSetDelta struct {
+	DocID     []byte
+	FieldName string
+	Priority  uint64
+	// SchemaVersionID is the schema version datastore key at the time of commit.
+	//
+	// It can be used to identify the collection datastructure state at the time of commit.
+	SchemaVersionID string
+	// Data is the CBOR encoded [ORSetOperations] of this delta.
+	Data []byte
+}
+
+var _ core.Delta = (*ORSetDelta)(nil)
+
+// IPLDSchemaBytes returns the IPLD schema representation for the type.
+//
+// This needs to match the [ORSetDelta] struct or [coreblock.mustSetSchema] will panic on init.
+func (delta *ORSetDelta) IPLDSchemaBytes() []byte {
+	return []byte(`
+	type ORSetDelta struct {
+		docID     		Bytes
+		fieldName 		String
+		priority  		Int
+		schemaVersionID String
+		data            Bytes
+	}`)
+}
+
+// GetPriority gets the current priority for this delta.
+func (delta *ORSetDelta) GetPriority() uint64 {
+	return delta.Priority
+}
+
+// SetPriority will set the priority for this delta.
+func (delta *ORSetDelta) SetPriority(prio uint64) {
+	delta.Priority = prio
+}
+
+// ORSet, Observed-Remove Set, is an add-wins set CRDT for array fields.
+//
+// Each added element is tagged with a unique identifier and removing an element only removes
+// the tags that were observed at the time of removal. As such an element that is concurrently
+// added and removed on different peers will remain in the set once merged.
+//
+// The set does not preserve the order in which the elements were added. The elements of the
+// resulting array are ordered by their encoded value so that all peers converge to the same array.
+type ORSet struct {
+	baseCRDT
+}
+
+var _ core.ReplicatedData = (*ORSet)(nil)
+
+// NewORSet returns a new instance of the ORSet with the given ID.
+func NewORSet(
+	store datastore.DSReaderWriter,
+	schemaVersionKey core.CollectionSchemaVersionKey,
+	key core.DataStoreKey,
+	fieldName string,
+) ORSet {
+	return ORSet{newBaseCRDT(store, key, schemaVersionKey, fieldName)}
+}
+
+// Value gets the current set value as a CBOR encoded array.
+func (s ORSet) Value(ctx context.Context) ([]byte, error) {
+	valueK := s.key.WithValueFlag()
+	buf, err := s.store.Get(ctx, valueK.ToDS())
+	if err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Set generates a new delta that will transform the current set into the given
+// CBOR encoded array.
+//
+// Elements that are not yet in the set are added with a new unique tag and elements
+// that are no longer in the given array have all their observed tags removed.
+// A nil value is treated as an empty array.
+func (s ORSet) Set(ctx context.Context, value []byte) (*ORSetDelta, error) {
+	elements, err := decodeORSetElements(value)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := s.getState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// To ensure that the dag block is unique, we add a random tag to each added element.
+	// This is done only on update (if the doc already exists) to ensure that the
+	// initial dag block of a document can be reproducible.
+	exists, err := s.store.Has(ctx, s.key.ToPrimaryDataStoreKey().ToDS())
+	if err != nil {
+		return nil, err
+	}
+
+	ops := ORSetOperations{}
+	newElements := make(map[string]struct{}, len(elements))
+	for _, element := range elements {
+		if _, ok := newElements[string(element)]; ok {
+			continue
+		}
+		newElements[string(element)] = struct{}{}
+
+		if len(state[string(element)]) > 0 {
+			continue
+		}
+
+		var tag []byte
+		if exists {
+			tag = make([]byte, orSetTagLength)
+			_, err := rand.Read(tag)
+			if err != nil {
+				return nil, err
+			}
+		}
+		ops.Added = append(ops.Added, ORSetElement{Value: element, Tag: tag})
+	}
+
+	for _, element := range sortedORSetKeys(state) {
+		if _, ok := newElements[element]; ok {
+			continue
+		}
+		for _, tag := range state[element] {
+			ops.Removed = append(ops.Removed, ORSetElement{Value: []byte(element), Tag: tag})
+		}
+	}
+
+	data, err := cbor.Marshal(ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ORSetDelta{
+		DocID:           []byte(s.key.DocID),
+		FieldName:       s.fieldName,
+		SchemaVersionID: s.schemaVersionKey.SchemaVersionID,
+		Data:            data,
+	}, nil
+}
+
+// Merge implements ReplicatedData interface.
+// It applies the removed tags of the delta and then its added elements.
+func (s ORSet) Merge(ctx context.Context, delta core.Delta) error {
+	d, ok := delta.(*ORSetDelta)
+	if !ok {
+		return ErrMismatchedMergeType
+	}
+
+	var ops ORSetOperations
+	err := cbor.Unmarshal(d.Data, &ops)
+	if err != nil {
+		return NewErrFailedToDecodeORSetDelta(err)
+	}
+
+	state, err := s.getState(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, removed := range ops.Removed {
+		tags := state[string(removed.Value)]
+		for i, tag := range tags {
+			if bytes.Equal(tag, removed.Tag) {
+				tags = append(tags[:i], tags[i+1:]...)
+				break
+			}
+		}
+		if len(tags) == 0 {
+			delete(state, string(removed.Value))
+		} else {
+			state[string(removed.Value)] = tags
+		}
+	}
+
+	for _, added := range ops.Added {
+		tags := state[string(added.Value)]
+		if !containsTag(tags, added.Tag) {
+			state[string(added.Value)] = append(tags, added.Tag)
+		}
+	}
+
+	err = s.setState(ctx, state)
+	if err != nil {
+		return err
+	}
+
+	return s.setPriority(ctx, s.key, d.GetPriority())
+}
+
+// orSetStateEntry is the stored state of a single element and its tags.
+//
+// The state is stored as a list of entries instead of a map as the CBOR encoded
+// element values are not valid map keys.
+type orSetStateEntry struct {
+	Value []byte
+	Tags  [][]byte
+}
+
+func (s ORSet) getState(ctx context.Context) (map[string][][]byte, error) {
+	buf, err := s.store.Get(ctx, s.key.WithStateFlag().ToDS())
+	if err != nil {
+		if errors.Is(err, ds.ErrNotFound) {
+			return map[string][][]byte{}, nil
+		}
+		return nil, err
+	}
+
+	var entries []orSetStateEntry
+	err = cbor.Unmarshal(buf, &entries)
+	if err != nil {
+		return nil, err
+	}
+	state := make(map[string][][]byte, len(entries))
+	for _, entry := range entries {
+		state[string(entry.Value)] = entry.Tags
+	}
+	return state, nil
+}
+
+func (s ORSet) setState(ctx context.Context, state map[string][][]byte) error {
+	keys := sortedORSetKeys(state)
+	entries := make([]orSetStateEntry, len(keys))
+	for i, key := range keys {
+		entries[i] = orSetStateEntry{Value: []byte(key), Tags: state[key]}
+	}
+	stateBytes, err := cbor.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	err = s.store.Put(ctx, s.key.WithStateFlag().ToDS(), stateBytes)
+	if err != nil {
+		return NewErrFailedToStoreValue(err)
+	}
+
+	elements := make([]cbor.RawMessage, len(keys))
+	for i, key := range keys {
+		elements[i] = cbor.RawMessage(key)
+	}
+	val, err := cbor.Marshal(elements)
+	if err != nil {
+		return err
+	}
+
+	key := s.key.WithValueFlag()
+	marker, err := s.store.Get(ctx, s.key.ToPrimaryDataStoreKey().ToDS())
+	if err != nil && !errors.Is(err, ds.ErrNotFound) {
+		return err
+	}
+	if bytes.Equal(marker, []byte{base.DeletedObjectMarker}) {
+		key = key.WithDeletedFlag()
+	}
+
+	err = s.store.Put(ctx, key.ToDS(), val)
+	if err != nil {
+		return NewErrFailedToStoreValue(err)
+	}
+	return nil
+}
+
+// decodeORSetElements splits the given CBOR encoded array into its CBOR encoded elements.
+func decodeORSetElements(value []byte) ([]cbor.RawMessage, error) {
+	if len(value) == 0 || bytes.Equal(value, client.CborNil) {
+		return nil, nil
+	}
+	var elements []cbor.RawMessage
+	err := cbor.Unmarshal(value, &elements)
+	if err != nil {
+		return nil, NewErrInvalidORSetValue(err)
+	}
+	return elements, nil
+}
+
+func sortedORSetKeys(state map[string][][]byte) []string {
+	keys := make([]string, 0, len(state))
+	for key := range state {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func containsTag(tags [][]byte, tag []byte) bool {
+	for _, t := range tags {
+		if bytes.Equal(t, tag) {
+			return true
+		}
+	}
+	return false
+}
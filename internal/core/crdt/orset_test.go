@@ -0,0 +1,138 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/db/base"
+)
+
+func setupORSet(t *testing.T, ctx context.Context) ORSet {
+	store := newMockStore()
+	key := core.DataStoreKey{DocID: "AAAA-BBBB"}
+	// The document must exist for added elements to get unique tags.
+	err := store.Put(ctx, key.ToPrimaryDataStoreKey().ToDS(), []byte{base.ObjectMarker})
+	require.NoError(t, err)
+	return NewORSet(store, core.CollectionSchemaVersionKey{}, key, "tags")
+}
+
+func mustEncodeORSetValue(t *testing.T, value []string) []byte {
+	b, err := cbor.Marshal(value)
+	require.NoError(t, err)
+	return b
+}
+
+func mustDecodeORSetValue(t *testing.T, set ORSet, ctx context.Context) []string {
+	b, err := set.Value(ctx)
+	require.NoError(t, err)
+	var value []string
+	err = cbor.Unmarshal(b, &value)
+	require.NoError(t, err)
+	return value
+}
+
+func mergeORSetDeltas(t *testing.T, set ORSet, ctx context.Context, deltas ...*ORSetDelta) {
+	for _, delta := range deltas {
+		err := set.Merge(ctx, delta)
+		require.NoError(t, err)
+	}
+}
+
+func TestORSetMerge_AddAndRemove(t *testing.T) {
+	ctx := context.Background()
+	set := setupORSet(t, ctx)
+
+	delta, err := set.Set(ctx, mustEncodeORSetValue(t, []string{"b", "a", "a"}))
+	require.NoError(t, err)
+	mergeORSetDeltas(t, set, ctx, delta)
+	require.Equal(t, []string{"a", "b"}, mustDecodeORSetValue(t, set, ctx))
+
+	delta, err = set.Set(ctx, mustEncodeORSetValue(t, []string{"c", "b"}))
+	require.NoError(t, err)
+	mergeORSetDeltas(t, set, ctx, delta)
+	require.Equal(t, []string{"b", "c"}, mustDecodeORSetValue(t, set, ctx))
+}
+
+func TestORSetMerge_ConcurrentAdds_KeepsBoth(t *testing.T) {
+	ctx := context.Background()
+	setA := setupORSet(t, ctx)
+	setB := setupORSet(t, ctx)
+
+	deltaA, err := setA.Set(ctx, mustEncodeORSetValue(t, []string{"a"}))
+	require.NoError(t, err)
+	deltaB, err := setB.Set(ctx, mustEncodeORSetValue(t, []string{"b"}))
+	require.NoError(t, err)
+
+	mergeORSetDeltas(t, setA, ctx, deltaA, deltaB)
+	mergeORSetDeltas(t, setB, ctx, deltaB, deltaA)
+
+	require.Equal(t, []string{"a", "b"}, mustDecodeORSetValue(t, setA, ctx))
+	require.Equal(t, []string{"a", "b"}, mustDecodeORSetValue(t, setB, ctx))
+}
+
+func TestORSetMerge_ConcurrentAddAndRemove_AddWins(t *testing.T) {
+	ctx := context.Background()
+	setA := setupORSet(t, ctx)
+	setB := setupORSet(t, ctx)
+
+	initialDelta, err := setA.Set(ctx, mustEncodeORSetValue(t, []string{"a"}))
+	require.NoError(t, err)
+	mergeORSetDeltas(t, setA, ctx, initialDelta)
+	mergeORSetDeltas(t, setB, ctx, initialDelta)
+
+	// Peer A removes the element whilst peer B removes and then re-adds it.
+	removeA, err := setA.Set(ctx, mustEncodeORSetValue(t, nil))
+	require.NoError(t, err)
+	mergeORSetDeltas(t, setA, ctx, removeA)
+
+	removeB, err := setB.Set(ctx, mustEncodeORSetValue(t, []string{}))
+	require.NoError(t, err)
+	mergeORSetDeltas(t, setB, ctx, removeB)
+	addB, err := setB.Set(ctx, mustEncodeORSetValue(t, []string{"a"}))
+	require.NoError(t, err)
+	mergeORSetDeltas(t, setB, ctx, addB)
+
+	mergeORSetDeltas(t, setA, ctx, removeB, addB)
+	mergeORSetDeltas(t, setB, ctx, removeA)
+
+	require.Equal(t, []string{"a"}, mustDecodeORSetValue(t, setA, ctx))
+	require.Equal(t, []string{"a"}, mustDecodeORSetValue(t, setB, ctx))
+}
+
+func TestORSetMerge_WithMismatchedDelta_Error(t *testing.T) {
+	ctx := context.Background()
+	set := setupORSet(t, ctx)
+
+	err := set.Merge(ctx, &LWWRegDelta{})
+	require.ErrorIs(t, err, ErrMismatchedMergeType)
+}
+
+func TestORSetMerge_WithNonUTF8EncodedElements(t *testing.T) {
+	ctx := context.Background()
+	set := setupORSet(t, ctx)
+
+	// The CBOR encoding of these elements is not valid UTF-8.
+	value := []string{"a long string with more than 128 bytes" + string(make([]byte, 100)), "b"}
+	delta, err := set.Set(ctx, mustEncodeORSetValue(t, value))
+	require.NoError(t, err)
+	mergeORSetDeltas(t, set, ctx, delta)
+
+	delta, err = set.Set(ctx, mustEncodeORSetValue(t, []string{"b"}))
+	require.NoError(t, err)
+	mergeORSetDeltas(t, set, ctx, delta)
+	require.Equal(t, []string{"b"}, mustDecodeORSetValue(t, set, ctx))
+}
@@ -0,0 +1,596 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/fxamacker/cbor/v2"
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/db/base"
+)
+
+// rgaTextTagLength is the number of random bytes used to uniquely identify the elements
+// inserted by a delta.
+const rgaTextTagLength = 16
+
+// rgaTextMaxDiff is the maximum number of edits looked for when diffing the current and
+// the new text. Beyond it, the differing part of the text is replaced as a whole.
+const rgaTextMaxDiff = 1024
+
+// RGATextInsert is a run of characters inserted after a single existing element.
+type RGATextInsert struct {
+	// After is the ID of the element the run is inserted after, or nil if the run
+	// is inserted at the start of the text.
+	After []byte
+	// Value is the inserted text.
+	Value string
+}
+
+// RGATextOperations holds the insertions and deletions of a single RGATextDelta.
+//
+// It is stored CBOR encoded in [RGATextDelta.Data] so that it can be encrypted like
+// the data of any other delta.
+type RGATextOperations struct {
+	// Tag, along with the delta priority, uniquely identifies the elements inserted by the delta.
+	Tag []byte
+	// Inserted contains the runs of characters inserted by the delta.
+	Inserted []RGATextInsert
+	// Deleted contains the IDs of the elements deleted by the delta.
+	Deleted [][]byte
+}
+
+// RGATextDelta is a single delta operation for an RGAText
+type RGATextDelta struct {
+	DocID     []byte
+	FieldName string
+	Priority  uint64
+	// SchemaVersionID is the schema version datastore key at the time of commit.
+	//
+	// It can be used to identify the collection datastructure state at the time of commit.
+	SchemaVersionID string
+	// Data is the CBOR encoded [RGATextOperations] of this delta.
+	Data []byte
+}
+
+var _ core.Delta = (*RGATextDelta)(nil)
+
+// IPLDSchemaBytes returns the IPLD schema representation for the type.
+//
+// This needs to match the [RGATextDelta] struct or [coreblock.mustSetSchema] will panic on init.
+func (delta *RGATextDelta) IPLDSchemaBytes() []byte {
+	return []byte(`
+	type RGATextDelta struct {
+		docID     		Bytes
+		fieldName 		String
+		priority  		Int
+		schemaVersionID String
+		data            Bytes
+	}`)
+}
+
+// GetPriority gets the current priority for this delta.
+func (delta *RGATextDelta) GetPriority() uint64 {
+	return delta.Priority
+}
+
+// SetPriority will set the priority for this delta.
+func (delta *RGATextDelta) SetPriority(prio uint64) {
+	delta.Priority = prio
+}
+
+// RGAText, Replicated Growable Array, is a sequence CRDT for collaborative text editing
+// of string fields.
+//
+// Each character is an element of the sequence with a unique ID made of the priority of the
+// delta that inserted it, its offset within the delta and the random tag of the delta. An
+// insertion references the element it was made after, concurrent insertions after the same
+// element are ordered by descending ID. Deleted elements are kept as tombstones so that
+// concurrent insertions can still reference them. As such concurrent edits made on different
+// peers are interleaved instead of overwriting each other.
+type RGAText struct {
+	baseCRDT
+}
+
+var _ core.ReplicatedData = (*RGAText)(nil)
+
+// NewRGAText returns a new instance of the RGAText with the given ID.
+func NewRGAText(
+	store datastore.DSReaderWriter,
+	schemaVersionKey core.CollectionSchemaVersionKey,
+	key core.DataStoreKey,
+	fieldName string,
+) RGAText {
+	return RGAText{newBaseCRDT(store, key, schemaVersionKey, fieldName)}
+}
+
+// Value gets the current text value as a CBOR encoded string.
+func (t RGAText) Value(ctx context.Context) ([]byte, error) {
+	valueK := t.key.WithValueFlag()
+	buf, err := t.store.Get(ctx, valueK.ToDS())
+	if err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Set generates a new delta that will transform the current text into the given
+// CBOR encoded string.
+//
+// The current and new text are diffed so that only the inserted and deleted characters
+// are recorded in the delta. A nil value removes all the text.
+func (t RGAText) Set(ctx context.Context, value []byte) (*RGATextDelta, error) {
+	text, err := decodeRGATextValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	elements, err := t.getState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	visible := visibleRGATextElements(elements)
+
+	ops, err := t.newOperations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	oldRunes := make([]rune, len(visible))
+	for i, element := range visible {
+		oldRunes[i], _ = utf8.DecodeRuneInString(element.Value)
+	}
+	newRunes := []rune(text)
+
+	matched := make([]bool, len(visible))
+	prevOld, prevNew := -1, -1
+	insertBefore := func(newIndex int) {
+		if newIndex <= prevNew+1 {
+			return
+		}
+		var after []byte
+		if prevOld >= 0 {
+			after = visible[prevOld].ID
+		}
+		ops.Inserted = append(ops.Inserted, RGATextInsert{
+			After: after,
+			Value: string(newRunes[prevNew+1 : newIndex]),
+		})
+	}
+	for _, match := range matchRunes(oldRunes, newRunes) {
+		insertBefore(match[1])
+		matched[match[0]] = true
+		prevOld, prevNew = match[0], match[1]
+	}
+	insertBefore(len(newRunes))
+
+	for i, element := range visible {
+		if !matched[i] {
+			ops.Deleted = append(ops.Deleted, element.ID)
+		}
+	}
+
+	return t.newDelta(ops)
+}
+
+// Splice generates a new delta that applies the given splices, in order, to the current text.
+//
+// The characters are inserted and deleted at the spliced positions, so that the edits keep
+// their place when they are merged with concurrent edits.
+func (t RGAText) Splice(ctx context.Context, splices []request.TextSplice) (*RGATextDelta, error) {
+	elements, err := t.getState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// The characters inserted by the splices have no ID until the delta is merged, and the
+	// elements deleted by the splices are kept as tombstones until the end.
+	text := visibleRGATextElements(elements)
+
+	ops, err := t.newOperations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, splice := range splices {
+		// pos is the position in text of the character at the splice index.
+		pos := indexOfVisibleRGATextElement(text, splice.Index)
+		if splice.Index < 0 || splice.Delete < 0 || pos < 0 {
+			return nil, NewErrRGATextSpliceOutOfRange(splice.Index, splice.Delete)
+		}
+
+		for deleted, i := 0, pos; deleted < splice.Delete; {
+			if i >= len(text) {
+				return nil, NewErrRGATextSpliceOutOfRange(splice.Index, splice.Delete)
+			}
+			switch {
+			case text[i].Deleted:
+				i++
+			case text[i].ID == nil:
+				text = slices.Delete(text, i, i+1)
+				deleted++
+			default:
+				text[i].Deleted = true
+				ops.Deleted = append(ops.Deleted, text[i].ID)
+				deleted++
+				i++
+			}
+		}
+
+		// The characters are inserted right after the character preceding the splice index.
+		insertPos := 0
+		if splice.Index > 0 {
+			insertPos = indexOfVisibleRGATextElement(text, splice.Index-1) + 1
+		}
+		inserted := make([]rgaTextElement, 0, len(splice.Insert))
+		for _, r := range splice.Insert {
+			inserted = append(inserted, rgaTextElement{Value: string(r)})
+		}
+		text = slices.Insert(text, insertPos, inserted...)
+	}
+
+	// Each run of inserted characters is inserted after the existing element preceding it.
+	var after []byte
+	var run strings.Builder
+	for _, element := range text {
+		if element.ID == nil {
+			run.WriteString(element.Value)
+			continue
+		}
+		if run.Len() > 0 {
+			ops.Inserted = append(ops.Inserted, RGATextInsert{After: after, Value: run.String()})
+			run.Reset()
+		}
+		after = element.ID
+	}
+	if run.Len() > 0 {
+		ops.Inserted = append(ops.Inserted, RGATextInsert{After: after, Value: run.String()})
+	}
+
+	return t.newDelta(ops)
+}
+
+// newOperations returns the operations of a new delta, without any insertion or deletion.
+func (t RGAText) newOperations(ctx context.Context) (RGATextOperations, error) {
+	ops := RGATextOperations{}
+
+	// To ensure that the dag block is unique, we add a random tag to the inserted elements.
+	// This is done only on update (if the doc already exists) to ensure that the
+	// initial dag block of a document can be reproducible.
+	exists, err := t.store.Has(ctx, t.key.ToPrimaryDataStoreKey().ToDS())
+	if err != nil {
+		return RGATextOperations{}, err
+	}
+	if exists {
+		ops.Tag = make([]byte, rgaTextTagLength)
+		_, err := rand.Read(ops.Tag)
+		if err != nil {
+			return RGATextOperations{}, err
+		}
+	}
+	return ops, nil
+}
+
+// newDelta returns a new delta holding the given operations.
+func (t RGAText) newDelta(ops RGATextOperations) (*RGATextDelta, error) {
+	data, err := cbor.Marshal(ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RGATextDelta{
+		DocID:           []byte(t.key.DocID),
+		FieldName:       t.fieldName,
+		SchemaVersionID: t.schemaVersionKey.SchemaVersionID,
+		Data:            data,
+	}, nil
+}
+
+// Merge implements ReplicatedData interface.
+// It inserts the characters of the delta and then marks its deleted elements as tombstones.
+func (t RGAText) Merge(ctx context.Context, delta core.Delta) error {
+	d, ok := delta.(*RGATextDelta)
+	if !ok {
+		return ErrMismatchedMergeType
+	}
+
+	var ops RGATextOperations
+	err := cbor.Unmarshal(d.Data, &ops)
+	if err != nil {
+		return NewErrFailedToDecodeRGATextDelta(err)
+	}
+
+	elements, err := t.getState(ctx)
+	if err != nil {
+		return err
+	}
+
+	ids := make(map[string]struct{}, len(elements))
+	for _, element := range elements {
+		ids[string(element.ID)] = struct{}{}
+	}
+
+	var offset uint32
+	for _, insert := range ops.Inserted {
+		pos := 0
+		if insert.After != nil {
+			pos = indexOfRGATextElement(elements, insert.After)
+			if pos < 0 {
+				return NewErrRGATextElementNotFound(insert.After)
+			}
+			pos++
+		}
+
+		for _, r := range insert.Value {
+			id := newRGATextID(d.GetPriority(), offset, ops.Tag)
+			offset++
+
+			if _, ok := ids[string(id)]; ok {
+				// The delta has already been merged.
+				pos = indexOfRGATextElement(elements, id) + 1
+				continue
+			}
+
+			// Elements inserted concurrently after the same element are ordered by descending ID.
+			// As elements always have a greater ID than the element they were inserted after,
+			// this also skips over the elements inserted after those concurrent elements.
+			for pos < len(elements) && bytes.Compare(elements[pos].ID, id) > 0 {
+				pos++
+			}
+			elements = slices.Insert(elements, pos, rgaTextElement{ID: id, Value: string(r)})
+			ids[string(id)] = struct{}{}
+			pos++
+		}
+	}
+
+	if len(ops.Deleted) > 0 {
+		deleted := make(map[string]struct{}, len(ops.Deleted))
+		for _, id := range ops.Deleted {
+			deleted[string(id)] = struct{}{}
+		}
+		for i := range elements {
+			if _, ok := deleted[string(elements[i].ID)]; ok {
+				elements[i].Deleted = true
+			}
+		}
+	}
+
+	err = t.setState(ctx, elements)
+	if err != nil {
+		return err
+	}
+
+	return t.setPriority(ctx, t.key, d.GetPriority())
+}
+
+// rgaTextElement is the stored state of a single character of the text.
+type rgaTextElement struct {
+	ID      []byte
+	Value   string
+	Deleted bool
+}
+
+func (t RGAText) getState(ctx context.Context) ([]rgaTextElement, error) {
+	buf, err := t.store.Get(ctx, t.key.WithStateFlag().ToDS())
+	if err != nil {
+		if errors.Is(err, ds.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var elements []rgaTextElement
+	err = cbor.Unmarshal(buf, &elements)
+	if err != nil {
+		return nil, err
+	}
+	return elements, nil
+}
+
+func (t RGAText) setState(ctx context.Context, elements []rgaTextElement) error {
+	stateBytes, err := cbor.Marshal(elements)
+	if err != nil {
+		return err
+	}
+	err = t.store.Put(ctx, t.key.WithStateFlag().ToDS(), stateBytes)
+	if err != nil {
+		return NewErrFailedToStoreValue(err)
+	}
+
+	var text strings.Builder
+	for _, element := range elements {
+		if !element.Deleted {
+			text.WriteString(element.Value)
+		}
+	}
+	val, err := cbor.Marshal(text.String())
+	if err != nil {
+		return err
+	}
+
+	key := t.key.WithValueFlag()
+	marker, err := t.store.Get(ctx, t.key.ToPrimaryDataStoreKey().ToDS())
+	if err != nil && !errors.Is(err, ds.ErrNotFound) {
+		return err
+	}
+	if bytes.Equal(marker, []byte{base.DeletedObjectMarker}) {
+		key = key.WithDeletedFlag()
+	}
+
+	err = t.store.Put(ctx, key.ToDS(), val)
+	if err != nil {
+		return NewErrFailedToStoreValue(err)
+	}
+	return nil
+}
+
+// newRGATextID returns the ID of the element inserted at the given offset of a delta.
+//
+// The priority comes first so that elements inserted by causally later deltas are
+// always greater than the elements they were inserted after.
+func newRGATextID(priority uint64, offset uint32, tag []byte) []byte {
+	id := make([]byte, 12, 12+len(tag))
+	binary.BigEndian.PutUint64(id, priority)
+	binary.BigEndian.PutUint32(id[8:], offset)
+	return append(id, tag...)
+}
+
+// visibleRGATextElements returns the elements that have not been deleted.
+func visibleRGATextElements(elements []rgaTextElement) []rgaTextElement {
+	visible := make([]rgaTextElement, 0, len(elements))
+	for _, element := range elements {
+		if !element.Deleted {
+			visible = append(visible, element)
+		}
+	}
+	return visible
+}
+
+// indexOfVisibleRGATextElement returns the position of the element at the given index of the
+// text, the deleted elements being skipped. The number of elements is returned if the index is
+// the length of the text, and -1 if the index is out of range.
+func indexOfVisibleRGATextElement(elements []rgaTextElement, index int) int {
+	for i, element := range elements {
+		if element.Deleted {
+			continue
+		}
+		if index == 0 {
+			return i
+		}
+		index--
+	}
+	if index == 0 {
+		return len(elements)
+	}
+	return -1
+}
+
+func indexOfRGATextElement(elements []rgaTextElement, id []byte) int {
+	return slices.IndexFunc(elements, func(element rgaTextElement) bool {
+		return bytes.Equal(element.ID, id)
+	})
+}
+
+// decodeRGATextValue decodes the given CBOR encoded string.
+func decodeRGATextValue(value []byte) (string, error) {
+	if len(value) == 0 || bytes.Equal(value, client.CborNil) {
+		return "", nil
+	}
+	var text string
+	err := cbor.Unmarshal(value, &text)
+	if err != nil {
+		return "", NewErrInvalidRGATextValue(err)
+	}
+	return text, nil
+}
+
+// matchRunes returns the index pairs of the characters that are kept when
+// transforming a into b, in ascending order.
+func matchRunes(a, b []rune) [][2]int {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	matches := make([][2]int, 0, prefix+suffix)
+	for i := 0; i < prefix; i++ {
+		matches = append(matches, [2]int{i, i})
+	}
+	for _, match := range myersMatches(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]) {
+		matches = append(matches, [2]int{match[0] + prefix, match[1] + prefix})
+	}
+	for i := suffix; i > 0; i-- {
+		matches = append(matches, [2]int{len(a) - i, len(b) - i})
+	}
+	return matches
+}
+
+// myersMatches returns the index pairs of the characters of the longest common
+// subsequence of a and b using the Myers diff algorithm.
+//
+// Nothing is matched if more than [rgaTextMaxDiff] edits are required.
+func myersMatches(a, b []rune) [][2]int {
+	n, m := len(a), len(b)
+	if n == 0 || m == 0 {
+		return nil
+	}
+
+	maxD := min(n+m, rgaTextMaxDiff)
+	offset := maxD + 1
+	v := make([]int, 2*offset+1)
+	// trace holds, for each edit count d, the furthest reaching x of the diagonals -d-1 to d+1
+	// before the d'th edit.
+	var trace [][]int
+	for d := 0; d <= maxD; d++ {
+		trace = append(trace, slices.Clone(v[offset-d-1:offset+d+2]))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrackMyersMatches(trace, n, m)
+			}
+		}
+	}
+	return nil
+}
+
+func backtrackMyersMatches(trace [][]int, n, m int) [][2]int {
+	var matches [][2]int
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		get := func(k int) int { return v[k+d+1] }
+
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && get(k-1) < get(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := get(prevK)
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			matches = append(matches, [2]int{x, y})
+		}
+		x, y = prevX, prevY
+	}
+	slices.Reverse(matches)
+	return matches
+}
@@ -0,0 +1,280 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/db/base"
+)
+
+func setupRGAText(t *testing.T, ctx context.Context) RGAText {
+	store := newMockStore()
+	key := core.DataStoreKey{DocID: "AAAA-BBBB"}
+	// The document must exist for inserted elements to get unique tags.
+	err := store.Put(ctx, key.ToPrimaryDataStoreKey().ToDS(), []byte{base.ObjectMarker})
+	require.NoError(t, err)
+	return NewRGAText(store, core.CollectionSchemaVersionKey{}, key, "notes")
+}
+
+func mustEncodeRGATextValue(t *testing.T, value string) []byte {
+	b, err := cbor.Marshal(value)
+	require.NoError(t, err)
+	return b
+}
+
+func mustDecodeRGATextValue(t *testing.T, text RGAText, ctx context.Context) string {
+	b, err := text.Value(ctx)
+	require.NoError(t, err)
+	var value string
+	err = cbor.Unmarshal(b, &value)
+	require.NoError(t, err)
+	return value
+}
+
+// setRGAText sets the value of the given text and merges the resulting delta with the given priority.
+func setRGAText(t *testing.T, text RGAText, ctx context.Context, value string, prio uint64) *RGATextDelta {
+	delta, err := text.Set(ctx, mustEncodeRGATextValue(t, value))
+	require.NoError(t, err)
+	delta.SetPriority(prio)
+	mergeRGATextDeltas(t, text, ctx, delta)
+	return delta
+}
+
+func mergeRGATextDeltas(t *testing.T, text RGAText, ctx context.Context, deltas ...*RGATextDelta) {
+	for _, delta := range deltas {
+		err := text.Merge(ctx, delta)
+		require.NoError(t, err)
+	}
+}
+
+func TestRGATextMerge_InsertAndDelete(t *testing.T) {
+	ctx := context.Background()
+	text := setupRGAText(t, ctx)
+
+	setRGAText(t, text, ctx, "hello world", 1)
+	require.Equal(t, "hello world", mustDecodeRGATextValue(t, text, ctx))
+
+	setRGAText(t, text, ctx, "hello brave new world!", 2)
+	require.Equal(t, "hello brave new world!", mustDecodeRGATextValue(t, text, ctx))
+
+	setRGAText(t, text, ctx, "héllo wörld", 3)
+	require.Equal(t, "héllo wörld", mustDecodeRGATextValue(t, text, ctx))
+
+	setRGAText(t, text, ctx, "", 4)
+	require.Equal(t, "", mustDecodeRGATextValue(t, text, ctx))
+}
+
+func TestRGATextSet_OnlyRecordsEditedCharacters(t *testing.T) {
+	ctx := context.Background()
+	text := setupRGAText(t, ctx)
+
+	setRGAText(t, text, ctx, "abcdef", 1)
+
+	delta, err := text.Set(ctx, mustEncodeRGATextValue(t, "aXcdeYf"))
+	require.NoError(t, err)
+
+	var ops RGATextOperations
+	err = cbor.Unmarshal(delta.Data, &ops)
+	require.NoError(t, err)
+
+	require.Len(t, ops.Inserted, 2)
+	require.Equal(t, "X", ops.Inserted[0].Value)
+	require.Equal(t, "Y", ops.Inserted[1].Value)
+	require.Len(t, ops.Deleted, 1)
+}
+
+func TestRGATextMerge_ConcurrentEdits_Interleave(t *testing.T) {
+	ctx := context.Background()
+	textA := setupRGAText(t, ctx)
+	textB := setupRGAText(t, ctx)
+
+	initialDelta := setRGAText(t, textA, ctx, "The fox jumps.", 1)
+	mergeRGATextDeltas(t, textB, ctx, initialDelta)
+
+	deltaA := setRGAText(t, textA, ctx, "The quick fox jumps.", 2)
+	deltaB := setRGAText(t, textB, ctx, "The fox jumps over the dog.", 2)
+
+	mergeRGATextDeltas(t, textA, ctx, deltaB)
+	mergeRGATextDeltas(t, textB, ctx, deltaA)
+
+	require.Equal(t, "The quick fox jumps over the dog.", mustDecodeRGATextValue(t, textA, ctx))
+	require.Equal(t, "The quick fox jumps over the dog.", mustDecodeRGATextValue(t, textB, ctx))
+}
+
+func TestRGATextMerge_ConcurrentInsertsAtSamePosition_Converge(t *testing.T) {
+	ctx := context.Background()
+	textA := setupRGAText(t, ctx)
+	textB := setupRGAText(t, ctx)
+
+	initialDelta := setRGAText(t, textA, ctx, "ac", 1)
+	mergeRGATextDeltas(t, textB, ctx, initialDelta)
+
+	deltaA := setRGAText(t, textA, ctx, "abbc", 2)
+	deltaB := setRGAText(t, textB, ctx, "axxc", 2)
+
+	mergeRGATextDeltas(t, textA, ctx, deltaB)
+	mergeRGATextDeltas(t, textB, ctx, deltaA)
+
+	valueA := mustDecodeRGATextValue(t, textA, ctx)
+	require.Equal(t, valueA, mustDecodeRGATextValue(t, textB, ctx))
+	// The concurrently inserted runs are not interleaved with each other.
+	require.Contains(t, []string{"abbxxc", "axxbbc"}, valueA)
+}
+
+func TestRGATextMerge_ConcurrentDeleteAndInsert(t *testing.T) {
+	ctx := context.Background()
+	textA := setupRGAText(t, ctx)
+	textB := setupRGAText(t, ctx)
+
+	initialDelta := setRGAText(t, textA, ctx, "abc", 1)
+	mergeRGATextDeltas(t, textB, ctx, initialDelta)
+
+	// Peer A deletes the character that peer B inserts after.
+	deltaA := setRGAText(t, textA, ctx, "ac", 2)
+	deltaB := setRGAText(t, textB, ctx, "abXc", 2)
+
+	mergeRGATextDeltas(t, textA, ctx, deltaB)
+	mergeRGATextDeltas(t, textB, ctx, deltaA)
+
+	require.Equal(t, "aXc", mustDecodeRGATextValue(t, textA, ctx))
+	require.Equal(t, "aXc", mustDecodeRGATextValue(t, textB, ctx))
+}
+
+func TestRGATextMerge_SameDeltaTwice_IsIdempotent(t *testing.T) {
+	ctx := context.Background()
+	text := setupRGAText(t, ctx)
+
+	delta := setRGAText(t, text, ctx, "abc", 1)
+	mergeRGATextDeltas(t, text, ctx, delta)
+
+	require.Equal(t, "abc", mustDecodeRGATextValue(t, text, ctx))
+}
+
+func TestRGATextMerge_WithMismatchedDelta_Error(t *testing.T) {
+	ctx := context.Background()
+	text := setupRGAText(t, ctx)
+
+	err := text.Merge(ctx, &LWWRegDelta{})
+	require.ErrorIs(t, err, ErrMismatchedMergeType)
+}
+
+// spliceRGAText splices the given text and merges the resulting delta with the given priority.
+func spliceRGAText(
+	t *testing.T,
+	text RGAText,
+	ctx context.Context,
+	prio uint64,
+	splices ...request.TextSplice,
+) *RGATextDelta {
+	delta, err := text.Splice(ctx, splices)
+	require.NoError(t, err)
+	delta.SetPriority(prio)
+	mergeRGATextDeltas(t, text, ctx, delta)
+	return delta
+}
+
+func TestRGATextSplice_AppliesSplicesInOrder(t *testing.T) {
+	ctx := context.Background()
+	text := setupRGAText(t, ctx)
+
+	setRGAText(t, text, ctx, "abc", 1)
+
+	delta := spliceRGAText(
+		t, text, ctx, 2,
+		request.TextSplice{Index: 1, Insert: "XYZ"},
+		request.TextSplice{Index: 2, Delete: 1},
+		request.TextSplice{Index: 4, Delete: 1, Insert: "é"},
+	)
+	require.Equal(t, "aXZbé", mustDecodeRGATextValue(t, text, ctx))
+
+	var ops RGATextOperations
+	err := cbor.Unmarshal(delta.Data, &ops)
+	require.NoError(t, err)
+
+	// The character inserted and then deleted by the splices is not recorded.
+	require.Len(t, ops.Inserted, 2)
+	require.Equal(t, "XZ", ops.Inserted[0].Value)
+	require.Equal(t, "é", ops.Inserted[1].Value)
+	require.Len(t, ops.Deleted, 1)
+}
+
+func TestRGATextSplice_WithRepeatedCharacters_KeepsSplicedPosition(t *testing.T) {
+	ctx := context.Background()
+	textA := setupRGAText(t, ctx)
+	textB := setupRGAText(t, ctx)
+
+	initialDelta := setRGAText(t, textA, ctx, "aaa", 1)
+	mergeRGATextDeltas(t, textB, ctx, initialDelta)
+
+	// Diffing the values would delete the last character instead of the first one.
+	deltaA := spliceRGAText(t, textA, ctx, 2, request.TextSplice{Index: 0, Delete: 1})
+	deltaB := spliceRGAText(t, textB, ctx, 2, request.TextSplice{Index: 1, Insert: "X"})
+
+	mergeRGATextDeltas(t, textA, ctx, deltaB)
+	mergeRGATextDeltas(t, textB, ctx, deltaA)
+
+	require.Equal(t, "Xaa", mustDecodeRGATextValue(t, textA, ctx))
+	require.Equal(t, "Xaa", mustDecodeRGATextValue(t, textB, ctx))
+}
+
+func TestRGATextSplice_WithMoreEditsThanMaxDiff_OnlyRecordsSplicedCharacters(t *testing.T) {
+	ctx := context.Background()
+	text := setupRGAText(t, ctx)
+
+	setRGAText(t, text, ctx, strings.Repeat("ab", rgaTextMaxDiff), 1)
+
+	delta, err := text.Splice(ctx, []request.TextSplice{
+		{Index: 0, Delete: rgaTextMaxDiff, Insert: strings.Repeat("c", rgaTextMaxDiff)},
+	})
+	require.NoError(t, err)
+
+	var ops RGATextOperations
+	err = cbor.Unmarshal(delta.Data, &ops)
+	require.NoError(t, err)
+
+	require.Len(t, ops.Inserted, 1)
+	require.Nil(t, ops.Inserted[0].After)
+	require.Len(t, ops.Deleted, rgaTextMaxDiff)
+}
+
+func TestRGATextSplice_OutOfRange_Error(t *testing.T) {
+	ctx := context.Background()
+	text := setupRGAText(t, ctx)
+
+	setRGAText(t, text, ctx, "abc", 1)
+
+	_, err := text.Splice(ctx, []request.TextSplice{{Index: 2, Delete: 2}})
+	require.ErrorIs(t, err, ErrRGATextSpliceOutOfRange)
+
+	_, err = text.Splice(ctx, []request.TextSplice{{Index: 4, Insert: "d"}})
+	require.ErrorIs(t, err, ErrRGATextSpliceOutOfRange)
+}
+
+func TestMatchRunes(t *testing.T) {
+	matches := matchRunes([]rune("abcabba"), []rune("cbabac"))
+	// The Myers diff of these strings has 5 edits and so keeps 4 characters.
+	require.Len(t, matches, 4)
+	for i := 1; i < len(matches); i++ {
+		require.Greater(t, matches[i][0], matches[i-1][0])
+		require.Greater(t, matches[i][1], matches[i-1][1])
+	}
+	for _, match := range matches {
+		require.Equal(t, []rune("abcabba")[match[0]], []rune("cbabac")[match[1]])
+	}
+}
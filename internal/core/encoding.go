@@ -17,6 +17,7 @@ import (
 	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/connor/numbers"
 	"github.com/sourcenetwork/defradb/internal/encoding"
 )
 
@@ -27,10 +28,16 @@ func NormalizeFieldValue(fieldDesc client.FieldDefinition, val any) (any, error)
 		return nil, nil
 	}
 
+	kind := fieldDesc.Kind
+	if _, isVector := kind.(*client.VectorKind); isVector {
+		// vectors are held as float arrays
+		kind = client.FieldKind_FLOAT_ARRAY
+	}
+
 	var err error
 	if array, isArray := val.([]any); isArray {
 		var ok bool
-		switch fieldDesc.Kind {
+		switch kind {
 		case client.FieldKind_BOOL_ARRAY:
 			boolArray := make([]bool, len(array))
 			for i, untypedValue := range array {
@@ -141,6 +148,8 @@ func NormalizeFieldValue(fieldDesc client.FieldDefinition, val any) (any, error)
 			}
 		case client.FieldKind_NILLABLE_JSON:
 			return convertToJSON(fieldDesc.Name, val)
+		case client.FieldKind_NILLABLE_GEOPOINT:
+			return convertToGeoPoint(fieldDesc.Name, val)
 		}
 	}
 
@@ -196,6 +205,24 @@ func convertToInt(propertyName string, untypedValue any) (int64, error) {
 	}
 }
 
+// NormalizeJSONIndexValue converts a value found at a path inside a JSON field, or a filter
+// value compared with it, to the value stored in the keys of an index on that path.
+//
+// Numbers are converted to float64 so that equal numbers have the same key however they
+// were decoded, and values that are not scalars are converted to nil.
+func NormalizeJSONIndexValue(val any) any {
+	switch v := numbers.TryUpcast(val).(type) {
+	case int64:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	case float64, string, bool:
+		return v
+	default:
+		return nil
+	}
+}
+
 // convertToJSON converts the given value to a valid JSON value.
 //
 // When maps are decoded, they are of type map[any]any, and need to
@@ -233,6 +260,43 @@ func convertToJSON(propertyName string, untypedValue any) (any, error) {
 	}
 }
 
+// convertToGeoPoint converts the given value to a [client.GeoPoint].
+//
+// Geo points are decoded as maps of their `lat` and `lon` properties, which may have been
+// encoded as ints if they had no fractional part.
+func convertToGeoPoint(propertyName string, untypedValue any) (client.GeoPoint, error) {
+	switch t := untypedValue.(type) {
+	case client.GeoPoint:
+		return t, nil
+	case map[any]any:
+		var point client.GeoPoint
+		for k, v := range t {
+			var coordinate float64
+			switch n := v.(type) {
+			case float64:
+				coordinate = n
+			case int64:
+				coordinate = float64(n)
+			case uint64:
+				coordinate = float64(n)
+			default:
+				return client.GeoPoint{}, client.NewErrUnexpectedType[float64](propertyName, v)
+			}
+			switch k {
+			case client.GeoPointLatFieldName:
+				point.Lat = coordinate
+			case client.GeoPointLonFieldName:
+				point.Lon = coordinate
+			default:
+				return client.GeoPoint{}, client.NewErrUnexpectedType[client.GeoPoint](propertyName, untypedValue)
+			}
+		}
+		return point, nil
+	default:
+		return client.GeoPoint{}, client.NewErrUnexpectedType[client.GeoPoint](propertyName, untypedValue)
+	}
+}
+
 // DecodeIndexDataStoreKey decodes a IndexDataStoreKey from bytes.
 // It expects the input bytes is in the following format:
 //
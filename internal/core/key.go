@@ -41,6 +41,9 @@ const (
 	PriorityKey = InstanceType("p")
 	// DeletedKey is a type that represents a deleted document.
 	DeletedKey = InstanceType("d")
+	// StateKey is a type that represents the internal state of a CRDT that is not
+	// part of the field value, such as the unique tags of an ORSet.
+	StateKey = InstanceType("s")
 )
 
 const (
@@ -50,6 +53,7 @@ const (
 	COLLECTION_SCHEMA_VERSION      = "/collection/version"
 	COLLECTION_ROOT                = "/collection/root"
 	COLLECTION_INDEX               = "/collection/index"
+	COLLECTION_INDEX_BUILD         = "/collection/build"
 	COLLECTION_VIEW_ITEMS          = "/collection/vi"
 	SCHEMA_VERSION                 = "/schema/version/v"
 	SCHEMA_VERSION_ROOT            = "/schema/version/r"
@@ -62,6 +66,7 @@ const (
 	REPLICATOR                     = "/rep/id"
 	REPLICATOR_RETRY_ID            = "/rep/retry/id"
 	REPLICATOR_RETRY_DOC           = "/rep/retry/doc"
+	PRUNED_BLOCK                   = "/pruned"
 )
 
 // Key is an interface that represents a key in the database.
@@ -135,6 +140,16 @@ type HeadStoreKey struct {
 
 var _ Key = (*HeadStoreKey)(nil)
 
+// PrunedBlockKey points to the marker of a block that has been removed from the blockstore
+// by a DAG compaction.
+//
+// It is stored in the headstore, under a namespace that does not overlap with the heads.
+type PrunedBlockKey struct {
+	Cid cid.Cid
+}
+
+var _ Key = (*PrunedBlockKey)(nil)
+
 // CollectionKey points to the json serialized description of the
 // the collection of the given ID.
 type CollectionKey struct {
@@ -186,6 +201,22 @@ type CollectionIndexKey struct {
 
 var _ Key = (*CollectionIndexKey)(nil)
 
+// IndexBuildKey points to the state of the background build of an index.
+//
+// The checkpoint of the build is stored in the format `/collection/build/[CollectionID]/[IndexName]`,
+// and the documents written past the checkpoint are recorded in buckets of document IDs in the format
+// `/collection/build/[CollectionID]/[IndexName]/[Bucket]`.
+type IndexBuildKey struct {
+	// CollectionID is the id of the collection that the index is on
+	CollectionID uint32
+	// IndexName is the name of the index
+	IndexName string
+	// Bucket is the bucket of document IDs, it is empty for the checkpoint of the build
+	Bucket string
+}
+
+var _ Key = (*IndexBuildKey)(nil)
+
 // SchemaVersionKey points to the json serialized schema at the specified version.
 //
 // It's corresponding value is immutable.
@@ -409,6 +440,45 @@ func (k CollectionIndexKey) ToDS() ds.Key {
 	return ds.NewKey(k.ToString())
 }
 
+// NewIndexBuildKey creates a new IndexBuildKey pointing to the checkpoint of the build
+// of the given index.
+func NewIndexBuildKey(colID uint32, indexName string) IndexBuildKey {
+	return IndexBuildKey{CollectionID: colID, IndexName: indexName}
+}
+
+// WithBucket returns a copy of the key pointing to the given bucket of document IDs.
+func (k IndexBuildKey) WithBucket(bucket string) IndexBuildKey {
+	k.Bucket = bucket
+	return k
+}
+
+// ToString returns the string representation of the key
+// It is in the following format:
+// /collection/build/[CollectionID]/[IndexName]/[Bucket]
+// if [IndexName] is empty, the rest is ignored
+func (k IndexBuildKey) ToString() string {
+	result := COLLECTION_INDEX_BUILD + "/" + fmt.Sprint(k.CollectionID)
+
+	if k.IndexName != "" {
+		result = result + "/" + k.IndexName
+		if k.Bucket != "" {
+			result = result + "/" + k.Bucket
+		}
+	}
+
+	return result
+}
+
+// Bytes returns the byte representation of the key
+func (k IndexBuildKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+// ToDS returns the datastore key
+func (k IndexBuildKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
 func NewSchemaVersionKey(schemaVersionID string) SchemaVersionKey {
 	return SchemaVersionKey{SchemaVersionID: schemaVersionID}
 }
@@ -459,6 +529,12 @@ func (k DataStoreKey) WithDeletedFlag() DataStoreKey {
 	return newKey
 }
 
+func (k DataStoreKey) WithStateFlag() DataStoreKey {
+	newKey := k
+	newKey.InstanceType = StateKey
+	return newKey
+}
+
 func (k DataStoreKey) WithDocID(docID string) DataStoreKey {
 	newKey := k
 	newKey.DocID = docID
@@ -897,6 +973,26 @@ func (k HeadStoreKey) ToDS() ds.Key {
 	return ds.NewKey(k.ToString())
 }
 
+func NewPrunedBlockKey(c cid.Cid) PrunedBlockKey {
+	return PrunedBlockKey{Cid: c}
+}
+
+func (k PrunedBlockKey) ToString() string {
+	result := PRUNED_BLOCK
+	if k.Cid.Defined() {
+		result = result + "/" + k.Cid.String()
+	}
+	return result
+}
+
+func (k PrunedBlockKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k PrunedBlockKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
 // PrefixEnd determines the end key given key as a prefix, that is the key that sorts precisely
 // behind all keys starting with prefix: "1" is added to the final byte and the carry propagated.
 // The special cases of nil and KeyMin always returns KeyMax.
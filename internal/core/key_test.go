@@ -192,6 +192,13 @@ func TestCollectionIndexKey_Bytes(t *testing.T) {
 	assert.Equal(t, []byte(COLLECTION_INDEX+"/1/idx"), key.Bytes())
 }
 
+func TestIndexBuildKey_ToString(t *testing.T) {
+	key := NewIndexBuildKey(1, "idx")
+	assert.Equal(t, COLLECTION_INDEX_BUILD+"/1/idx", key.ToString())
+	assert.Equal(t, COLLECTION_INDEX_BUILD+"/1/idx/0a", key.WithBucket("0a").ToString())
+	assert.Equal(t, COLLECTION_INDEX_BUILD+"/1", NewIndexBuildKey(1, "").ToString())
+}
+
 func TestDecodeIndexDataStoreKey(t *testing.T) {
 	const colID, indexID = 1, 2
 	cases := []struct {
@@ -14,6 +14,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -35,6 +36,7 @@ import (
 	"github.com/sourcenetwork/defradb/internal/db/fetcher"
 	"github.com/sourcenetwork/defradb/internal/encryption"
 	"github.com/sourcenetwork/defradb/internal/lens"
+	"github.com/sourcenetwork/defradb/internal/merkle/clock"
 	merklecrdt "github.com/sourcenetwork/defradb/internal/merkle/crdt"
 )
 
@@ -616,6 +618,8 @@ func (c *collection) save(
 		}
 	}
 	txn := mustGetContextTxn(ctx)
+	ctx = clock.SetContextHLC(ctx, c.db.hlc)
+	ctx = clock.SetContextSigner(ctx, GetContextIdentity(ctx))
 
 	// NOTE: We delay the final Clean() call until we know
 	// the commit on the transaction is successful. If we didn't
@@ -633,8 +637,18 @@ func (c *collection) save(
 	//	=> 		Set/Publish new CRDT values
 	primaryKey := c.getPrimaryKeyFromDocID(doc.ID())
 	links := make([]coreblock.DAGLink, 0)
-	for k, v := range doc.Fields() {
-		val, err := doc.GetValueWithField(v)
+
+	// The fields are saved in a fixed order, so that the order in which their blocks read the
+	// hybrid logical clock does not depend on the iteration order of the fields map.
+	fields := doc.Fields()
+	fieldNames := make([]string, 0, len(fields))
+	for k := range fields {
+		fieldNames = append(fieldNames, k)
+	}
+	slices.Sort(fieldNames)
+
+	for _, k := range fieldNames {
+		val, err := doc.GetValueWithField(fields[k])
 		if err != nil {
 			return cid.Undef, err
 		}
@@ -18,6 +18,7 @@ import (
 	"github.com/sourcenetwork/defradb/event"
 	"github.com/sourcenetwork/defradb/internal/core"
 	coreblock "github.com/sourcenetwork/defradb/internal/core/block"
+	"github.com/sourcenetwork/defradb/internal/merkle/clock"
 )
 
 // DeleteWithFilter deletes using a filter to target documents for delete.
@@ -140,6 +141,8 @@ func (c *collection) applyDelete(
 	txn := mustGetContextTxn(ctx)
 	dsKey := primaryKey.ToDataStoreKey()
 
+	ctx = clock.SetContextHLC(ctx, c.db.hlc)
+	ctx = clock.SetContextSigner(ctx, GetContextIdentity(ctx))
 	link, b, err := c.saveCompositeToMerkleCRDT(
 		ctx,
 		dsKey,
@@ -15,6 +15,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -82,10 +83,11 @@ func (db *db) getAllIndexDescriptions(
 			return nil, err
 		}
 
-		indexes[col.Name.Value()] = append(
-			indexes[col.Name.Value()],
-			indexDescriptions[i],
-		)
+		desc, err := withIndexBuildProgress(ctx, txn, indexKey.CollectionID.Value(), indexDescriptions[i])
+		if err != nil {
+			return nil, err
+		}
+		indexes[col.Name.Value()] = append(indexes[col.Name.Value()], desc)
 	}
 
 	return indexes, nil
@@ -215,6 +217,10 @@ func (c *collection) deleteIndexedDocWithID(
 //
 // Once finished, if there are existing documents in the collection,
 // the documents will be indexed by the new index.
+//
+// If the description has `Background` set, the existing documents are instead indexed
+// in batches after the transaction is committed, and the index is not used by queries
+// until the build is ready. The build resumes after a restart.
 func (c *collection) CreateIndex(
 	ctx context.Context,
 	desc client.IndexDescription,
@@ -266,6 +272,10 @@ func (c *collection) createIndex(
 		return nil, err
 	}
 	desc.ID = uint32(colID)
+	desc.Build = nil
+	if desc.Background {
+		desc.Build = &client.IndexBuildStatus{State: client.IndexBuildStateBuilding}
+	}
 
 	buf, err := json.Marshal(desc)
 	if err != nil {
@@ -283,6 +293,10 @@ func (c *collection) createIndex(
 	}
 	c.def.Description.Indexes = append(c.def.Description.Indexes, colIndex.Description())
 	c.indexes = append(c.indexes, colIndex)
+	if desc.Background {
+		txn.OnSuccess(c.db.signalIndexBuilds)
+		return colIndex, nil
+	}
 	err = c.indexExistingDocs(ctx, colIndex)
 	if err != nil {
 		removeErr := colIndex.RemoveAll(ctx, txn)
@@ -295,6 +309,20 @@ func (c *collection) iterateAllDocs(
 	ctx context.Context,
 	fields []client.FieldDefinition,
 	exec func(doc *client.Document) error,
+) error {
+	start := base.MakeDataStoreKeyWithCollectionDescription(c.Description())
+	return c.iterateDocs(ctx, fields, core.NewSpan(start, start.PrefixEnd()), 0, exec)
+}
+
+// iterateDocs calls exec for each document within the given span.
+//
+// If limit is greater than zero, it stops after that many documents.
+func (c *collection) iterateDocs(
+	ctx context.Context,
+	fields []client.FieldDefinition,
+	span core.Span,
+	limit int,
+	exec func(doc *client.Document) error,
 ) error {
 	txn := mustGetContextTxn(ctx)
 	identity := GetContextIdentity(ctx)
@@ -315,15 +343,12 @@ func (c *collection) iterateAllDocs(
 	if err != nil {
 		return errors.Join(err, df.Close())
 	}
-	start := base.MakeDataStoreKeyWithCollectionDescription(c.Description())
-	spans := core.NewSpans(core.NewSpan(start, start.PrefixEnd()))
-
-	err = df.Start(ctx, spans)
+	err = df.Start(ctx, core.NewSpans(span))
 	if err != nil {
 		return errors.Join(err, df.Close())
 	}
 
-	for {
+	for count := 0; limit <= 0 || count < limit; count++ {
 		encodedDoc, _, err := df.FetchNext(ctx)
 		if err != nil {
 			return errors.Join(err, df.Close())
@@ -350,17 +375,33 @@ func (c *collection) indexExistingDocs(
 	ctx context.Context,
 	index CollectionIndex,
 ) error {
-	fields := make([]client.FieldDefinition, 0, 1)
-	for _, field := range index.Description().Fields {
-		colField, ok := c.Definition().GetFieldByName(field.Name)
+	txn := mustGetContextTxn(ctx)
+	return c.iterateAllDocs(ctx, c.getIndexedFields(index.Description()), func(doc *client.Document) error {
+		return index.Save(ctx, txn, doc)
+	})
+}
+
+// getIndexedFields returns the fields of the documents needed by the given index.
+func (c *collection) getIndexedFields(desc client.IndexDescription) []client.FieldDefinition {
+	names := make([]string, 0, len(desc.Fields))
+	for _, field := range desc.Fields {
+		if !slices.Contains(names, field.FieldName()) {
+			names = append(names, field.FieldName())
+		}
+	}
+	for _, name := range desc.FilterFields() {
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	fields := make([]client.FieldDefinition, 0, len(names))
+	for _, name := range names {
+		colField, ok := c.Definition().GetFieldByName(name)
 		if ok {
 			fields = append(fields, colField)
 		}
 	}
-	txn := mustGetContextTxn(ctx)
-	return c.iterateAllDocs(ctx, fields, func(doc *client.Document) error {
-		return index.Save(ctx, txn, doc)
-	})
+	return fields
 }
 
 // DropIndex removes an index from the collection.
@@ -417,7 +458,7 @@ func (c *collection) dropIndex(ctx context.Context, indexName string) error {
 		return err
 	}
 
-	return nil
+	return deleteIndexBuildKeys(ctx, txn, core.NewIndexBuildKey(c.ID(), indexName))
 }
 
 func (c *collection) dropAllIndexes(ctx context.Context) error {
@@ -437,7 +478,7 @@ func (c *collection) dropAllIndexes(ctx context.Context) error {
 		}
 	}
 
-	return err
+	return deleteIndexBuildKeys(ctx, txn, core.NewIndexBuildKey(c.ID(), ""))
 }
 
 func (c *collection) loadIndexes(ctx context.Context) error {
@@ -470,7 +511,14 @@ func (c *collection) GetIndexes(ctx context.Context) ([]client.IndexDescription,
 	if err != nil {
 		return nil, err
 	}
-	return c.Description().Indexes, nil
+	indexes := make([]client.IndexDescription, len(c.Description().Indexes))
+	for i, desc := range c.Description().Indexes {
+		indexes[i], err = withIndexBuildProgress(ctx, txn, c.ID(), desc)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return indexes, nil
 }
 
 // checkExistingFieldsAndAdjustRelFieldNames checks if the fields in the index description
@@ -480,11 +528,11 @@ func (c *collection) checkExistingFieldsAndAdjustRelFieldNames(
 	fields []client.IndexedFieldDescription,
 ) error {
 	for i := range fields {
-		field, found := c.Schema().GetFieldByName(fields[i].Name)
+		field, found := c.Schema().GetFieldByName(fields[i].FieldName())
 		if !found {
 			return NewErrNonExistingFieldForIndex(fields[i].Name)
 		}
-		if field.Kind.IsObject() {
+		if field.Kind.IsObject() && fields[i].JSONPath() == nil {
 			fields[i].Name = fields[i].Name + request.RelatedObjectID
 		}
 	}
@@ -538,6 +586,35 @@ func validateIndexDescription(desc client.IndexDescription) error {
 			return ErrIndexFieldMissingName
 		}
 	}
+	switch desc.Type {
+	case client.IndexTypeValue:
+	case client.IndexTypeFullText:
+		if len(desc.Fields) > 1 {
+			return ErrFullTextIndexMultipleFields
+		}
+		if desc.Unique {
+			return ErrFullTextIndexUnique
+		}
+	case client.IndexTypeVector:
+		if len(desc.Fields) > 1 {
+			return ErrVectorIndexMultipleFields
+		}
+		if desc.Unique {
+			return ErrVectorIndexUnique
+		}
+	case client.IndexTypeGeoHash:
+		if len(desc.Fields) > 1 {
+			return ErrGeoHashIndexMultipleFields
+		}
+		if desc.Unique {
+			return ErrGeoHashIndexUnique
+		}
+	default:
+		return NewErrUnknownIndexType(desc.Type)
+	}
+	if len(desc.Filter) > 0 && desc.Type != client.IndexTypeValue {
+		return ErrFilteredIndexNotValueType
+	}
 	return nil
 }
 
@@ -0,0 +1,153 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/ipfs/go-cid"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/db/fetcher"
+)
+
+// Revert writes new deltas to the document with the given DocID so that its state becomes
+// equal to the state it had at the given version.
+func (c *collection) Revert(
+	ctx context.Context,
+	docID client.DocID,
+	version cid.Cid,
+) error {
+	ctx, txn, err := ensureContextTxn(ctx, c.db, false)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard(ctx)
+
+	primaryKey := c.getPrimaryKeyFromDocID(docID)
+	exists, isDeleted, err := c.exists(ctx, primaryKey)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return client.ErrDocumentNotFoundOrNotAuthorized
+	}
+	if isDeleted {
+		return NewErrDocumentDeleted(primaryKey.DocID)
+	}
+
+	err = c.revert(ctx, primaryKey, version)
+	if err != nil {
+		return err
+	}
+
+	return txn.Commit(ctx)
+}
+
+// Contract: DB Exists check is already performed, and a doc with the given ID exists.
+func (c *collection) revert(
+	ctx context.Context,
+	primaryKey core.PrimaryDataStoreKey,
+	version cid.Cid,
+) error {
+	historicDoc, err := fetcher.FetchVersion(
+		ctx,
+		GetContextIdentity(ctx),
+		mustGetContextTxn(ctx),
+		c.db.acp,
+		c,
+		primaryKey.DocID,
+		version,
+		false,
+	)
+	if err != nil {
+		return err
+	}
+	if historicDoc == nil {
+		return NewErrInvalidRevertVersion(primaryKey.DocID, version)
+	}
+
+	doc, err := c.get(ctx, primaryKey, nil, false)
+	if err != nil {
+		return err
+	}
+	if doc == nil {
+		return client.ErrDocumentNotFoundOrNotAuthorized
+	}
+
+	for _, field := range c.Definition().GetFields() {
+		if field.Name == request.DocIDFieldName || field.Kind.IsObject() {
+			continue
+		}
+
+		currentValue, err := fetcher.GetFieldValue(doc, field.Name)
+		if err != nil {
+			return err
+		}
+		historicValue, err := fetcher.GetFieldValue(historicDoc, field.Name)
+		if err != nil {
+			return err
+		}
+		if reflect.DeepEqual(currentValue, historicValue) {
+			continue
+		}
+
+		switch field.Typ {
+		case client.PN_COUNTER, client.P_COUNTER:
+			// Counters are saved as increments, so the difference between the values
+			// is written instead of the historic value.
+			increment, err := getCounterRevertIncrement(field, currentValue, historicValue)
+			if err != nil {
+				return err
+			}
+			err = doc.Set(field.Name, increment)
+			if err != nil {
+				return err
+			}
+
+		default:
+			err = doc.Set(field.Name, historicValue)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return c.update(ctx, doc)
+}
+
+// getCounterRevertIncrement returns the increment that brings the counter field from the
+// current value to the historic value.
+func getCounterRevertIncrement(field client.FieldDefinition, current, historic any) (any, error) {
+	var increment any
+	var isNegative bool
+	switch field.Kind {
+	case client.FieldKind_NILLABLE_FLOAT:
+		currentFloat, _ := current.(float64)
+		historicFloat, _ := historic.(float64)
+		increment = historicFloat - currentFloat
+		isNegative = historicFloat < currentFloat
+
+	default:
+		currentInt, _ := current.(int64)
+		historicInt, _ := historic.(int64)
+		increment = historicInt - currentInt
+		isNegative = historicInt < currentInt
+	}
+
+	if isNegative && field.Typ == client.P_COUNTER {
+		return nil, NewErrCanNotDecrementPCounter(field.Name)
+	}
+	return increment, nil
+}
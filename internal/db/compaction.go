@@ -0,0 +1,172 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/core"
+	coreblock "github.com/sourcenetwork/defradb/internal/core/block"
+	"github.com/sourcenetwork/defradb/internal/db/fetcher"
+	"github.com/sourcenetwork/defradb/internal/merkle/clock"
+)
+
+func (db *db) compactDAG(ctx context.Context, config client.CompactionConfig) (client.CompactionResult, error) {
+	if config.KeepHeight == 0 && config.MaxAge == 0 {
+		return client.CompactionResult{}, ErrMissingCompactionPolicy
+	}
+	// Blocks only have timestamps if the hybrid logical clock is enabled, so their age
+	// cannot be known without it.
+	if config.MaxAge > 0 && db.hlc == nil {
+		return client.CompactionResult{}, ErrCompactionMaxAgeWithoutTimestamps
+	}
+
+	var cols []client.Collection
+	if len(config.Collections) == 0 {
+		var err error
+		cols, err = db.getCollections(ctx, client.CollectionFetchOptions{})
+		if err != nil {
+			return client.CompactionResult{}, NewErrFailedToGetAllCollections(err)
+		}
+	} else {
+		for _, colName := range config.Collections {
+			col, err := db.getCollectionByName(ctx, colName)
+			if err != nil {
+				return client.CompactionResult{}, NewErrFailedToGetCollection(colName, err)
+			}
+			cols = append(cols, col)
+		}
+	}
+
+	// The age of the blocks is measured with the clock that timestamped them.
+	var cutoff time.Time
+	if config.MaxAge > 0 {
+		cutoff = clock.HLCTimestampToTime(db.hlc.Now()).Add(-config.MaxAge)
+	}
+
+	var result client.CompactionResult
+	for _, col := range cols {
+		docIDs, err := col.(*collection).getAllDocIDsWithDeleted(ctx)
+		if err != nil {
+			return client.CompactionResult{}, err
+		}
+		for _, docID := range docIDs {
+			pruned, err := col.(*collection).compactDoc(ctx, docID, config.KeepHeight, config.MaxAge > 0, cutoff)
+			if err != nil {
+				return client.CompactionResult{}, err
+			}
+			if pruned > 0 {
+				result.Documents++
+				result.PrunedBlocks += pruned
+			}
+		}
+	}
+	return result, nil
+}
+
+// getAllDocIDsWithDeleted returns the DocIDs of all the documents of the collection, including
+// the deleted ones.
+func (c *collection) getAllDocIDsWithDeleted(ctx context.Context) ([]string, error) {
+	txn := mustGetContextTxn(ctx)
+	prefix := core.PrimaryDataStoreKey{
+		CollectionRootID: c.Description().RootID,
+	}
+	results, err := txn.Datastore().Query(ctx, query.Query{
+		Prefix:   prefix.ToString(),
+		KeysOnly: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = results.Close()
+	}()
+
+	var docIDs []string
+	for res := range results.Next() {
+		if res.Error != nil {
+			return nil, res.Error
+		}
+		docIDs = append(docIDs, ds.NewKey(res.Key).BaseNamespace())
+	}
+	return docIDs, nil
+}
+
+// compactDoc squashes the history of the document with the given DocID that is older than the
+// given height and time into a snapshot, and returns the number of pruned blocks.
+//
+// A block is compacted if it is more than keepHeight below the current heads, and if
+// checkAge is true, if it has been created before the cutoff time.
+func (c *collection) compactDoc(
+	ctx context.Context,
+	docID string,
+	keepHeight uint64,
+	checkAge bool,
+	cutoff time.Time,
+) (int, error) {
+	txn := mustGetContextTxn(ctx)
+
+	_, maxHeight, err := clock.NewHeadSet(
+		txn.Headstore(),
+		core.HeadStoreKey{DocID: docID, FieldID: core.COMPOSITE_NAMESPACE},
+	).List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	isCompactable := func(block *coreblock.Block) bool {
+		if block.Delta.GetPriority()+keepHeight > maxHeight {
+			return false
+		}
+		if checkAge {
+			if block.Timestamp == nil || clock.HLCTimestampToTime(*block.Timestamp).After(cutoff) {
+				return false
+			}
+		}
+		return true
+	}
+
+	compaction, err := clock.NewCompaction(ctx, txn.Headstore(), txn.Blockstore(), docID, isCompactable)
+	if err != nil {
+		return 0, err
+	}
+	if len(compaction.Heads) == 0 {
+		return 0, nil
+	}
+
+	values, err := fetcher.GetSnapshotValues(ctx, txn, c, docID, compaction.Heads)
+	if err != nil {
+		return 0, err
+	}
+
+	heads := make([]cidlink.Link, len(compaction.Heads))
+	for i, head := range compaction.Heads {
+		heads[i] = cidlink.Link{Cid: head}
+	}
+	snapshot := &coreblock.Snapshot{
+		DocID:  []byte(docID),
+		Height: compaction.Height,
+		Heads:  heads,
+		Values: values,
+	}
+
+	_, err = compaction.Apply(ctx, txn.Headstore(), txn.Blockstore(), snapshot)
+	if err != nil {
+		return 0, err
+	}
+	return len(compaction.Pruned), nil
+}
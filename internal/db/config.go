@@ -22,8 +22,11 @@ const (
 )
 
 type dbOptions struct {
-	maxTxnRetries  immutable.Option[int]
-	RetryIntervals []time.Duration
+	maxTxnRetries       immutable.Option[int]
+	RetryIntervals      []time.Duration
+	enableTimestamps    bool
+	physicalClock       func() time.Time
+	requireSignedBlocks bool
 }
 
 // defaultOptions returns the default db options.
@@ -59,3 +62,36 @@ func WithRetryInterval(interval []time.Duration) Option {
 		}
 	}
 }
+
+// WithEnableTimestamps enables the hybrid logical clock timestamps of the blocks created
+// by the db. They are required to query documents as of a point in time and to compact the
+// DAGs by age, both of which return an error while timestamps are disabled.
+//
+// Timestamps are disabled by default. The timestamp is part of the block and therefore of its
+// CID, so documents created with the same values on different nodes, or at different times,
+// no longer have the same CIDs once enabled.
+func WithEnableTimestamps(enable bool) Option {
+	return func(opts *dbOptions) {
+		opts.enableTimestamps = enable
+	}
+}
+
+// WithPhysicalClock sets the function used to read the wall clock time of the hybrid
+// logical clock that timestamps the blocks created by the db.
+//
+// Defaults to [time.Now].
+func WithPhysicalClock(physicalTime func() time.Time) Option {
+	return func(opts *dbOptions) {
+		opts.physicalClock = physicalTime
+	}
+}
+
+// WithRequireSignedBlocks makes the db reject blocks received from other peers that are
+// not signed by the identity that created them.
+//
+// Blocks with an invalid signature are always rejected.
+func WithRequireSignedBlocks(require bool) Option {
+	return func(opts *dbOptions) {
+		opts.requireSignedBlocks = require
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/sourcenetwork/defradb/event"
 	"github.com/sourcenetwork/defradb/internal/core"
 	"github.com/sourcenetwork/defradb/internal/db/permission"
+	"github.com/sourcenetwork/defradb/internal/merkle/clock"
 	"github.com/sourcenetwork/defradb/internal/request/graphql"
 )
 
@@ -90,6 +91,20 @@ type db struct {
 	// The intervals at which to retry replicator failures.
 	// For example, this can define an exponential backoff strategy.
 	retryIntervals []time.Duration
+
+	// The hybrid logical clock used to timestamp the blocks created by the db.
+	//
+	// It is nil if timestamps are disabled.
+	hlc *clock.HLC
+
+	// If true, blocks received from other peers must be signed to be merged.
+	requireSignedBlocks bool
+
+	// Signals the background index builder that an index build has been created.
+	indexBuildSignal chan struct{}
+
+	// Tracks the background index builder so that the db can wait for it on close.
+	indexBuilds sync.WaitGroup
 }
 
 // NewDB creates a new instance of the DB using the given options.
@@ -125,21 +140,27 @@ func newDB(
 	ctx, cancel := context.WithCancel(ctx)
 
 	db := &db{
-		rootstore:      rootstore,
-		multistore:     multistore,
-		acp:            acp,
-		lensRegistry:   lens,
-		parser:         parser,
-		options:        options,
-		events:         event.NewBus(commandBufferSize, eventBufferSize),
-		ctxCancel:      cancel,
-		retryIntervals: opts.RetryIntervals,
+		rootstore:           rootstore,
+		multistore:          multistore,
+		acp:                 acp,
+		lensRegistry:        lens,
+		parser:              parser,
+		options:             options,
+		events:              event.NewBus(commandBufferSize, eventBufferSize),
+		ctxCancel:           cancel,
+		retryIntervals:      opts.RetryIntervals,
+		requireSignedBlocks: opts.requireSignedBlocks,
+		indexBuildSignal:    make(chan struct{}, 1),
 	}
 
 	if opts.maxTxnRetries.HasValue() {
 		db.maxTxnRetries = opts.maxTxnRetries
 	}
 
+	if opts.enableTimestamps {
+		db.hlc = clock.NewHLC(opts.physicalClock)
+	}
+
 	if lens != nil {
 		lens.Init(db)
 	}
@@ -156,6 +177,9 @@ func newDB(
 	go db.handleMessages(ctx, sub)
 	go db.handleReplicatorRetries(ctx)
 
+	db.indexBuilds.Add(1)
+	go db.handleIndexBuilds(ctx)
+
 	return db, nil
 }
 
@@ -386,6 +410,9 @@ func (db *db) Close() {
 
 	db.ctxCancel()
 
+	// the index builder must finish its current batch before the rootstore is closed
+	db.indexBuilds.Wait()
+
 	db.events.Close()
 
 	err := db.rootstore.Close()
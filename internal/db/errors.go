@@ -11,6 +11,7 @@
 package db
 
 import (
+	"github.com/ipfs/go-cid"
 	"github.com/libp2p/go-libp2p/core/peer"
 
 	"github.com/sourcenetwork/defradb/client"
@@ -106,6 +107,25 @@ const (
 	errColNotMaterialized                       string = "non-materialized collections are not supported"
 	errMaterializedViewAndACPNotSupported       string = "materialized views do not support ACP"
 	errInvalidDefaultFieldValue                 string = "default field value is invalid"
+	errInvalidRevertVersion                     string = "the given version is not a version of the document"
+	errCanNotDecrementPCounter                  string = "can not revert a p counter field to a lower value"
+	errMissingCompactionPolicy                  string = "a keep height or a max age is required to compact the DAG"
+	errCompactionMaxAgeWithoutTimestamps        string = "compacting the DAG by age requires the block timestamps to be enabled"
+	errUnknownIndexType                         string = "unknown index type"
+	errFullTextIndexMultipleFields              string = "a full-text index can only be created on a single field"
+	errFullTextIndexUnique                      string = "a full-text index can not be unique"
+	errUnsupportedFullTextIndexFieldType        string = "unsupported full-text index field type"
+	errVectorIndexMultipleFields                string = "a vector index can only be created on a single field"
+	errVectorIndexUnique                        string = "a vector index can not be unique"
+	errUnsupportedVectorIndexFieldType          string = "unsupported vector index field type"
+	errGeoHashIndexMultipleFields               string = "a geohash index can only be created on a single field"
+	errGeoHashIndexUnique                       string = "a geohash index can not be unique"
+	errUnsupportedGeoHashIndexFieldType         string = "unsupported geohash index field type"
+	errFilteredIndexNotValueType                string = "only a value index can have a filter"
+	errIndexPathOnNonJSONField                  string = "only paths inside JSON fields can be indexed"
+	errIndexPathNotValueType                    string = "only a value index can index a path inside a JSON field"
+	errCanNotVerifyIndexType                    string = "indexes of this type can not be verified"
+	errCanNotVerifyBuildingIndex                string = "an index that is not built can not be verified"
 )
 
 var (
@@ -152,6 +172,23 @@ var (
 	ErrContextDone                              = errors.New("context done")
 	ErrFailedToRetryDoc                         = errors.New("failed to retry doc")
 	ErrTimeoutDocRetry                          = errors.New("timeout while retrying doc")
+	ErrInvalidRevertVersion                     = errors.New(errInvalidRevertVersion)
+	ErrCanNotDecrementPCounter                  = errors.New(errCanNotDecrementPCounter)
+	ErrMissingCompactionPolicy                  = errors.New(errMissingCompactionPolicy)
+	ErrCompactionMaxAgeWithoutTimestamps        = errors.New(errCompactionMaxAgeWithoutTimestamps)
+	ErrUnknownIndexType                         = errors.New(errUnknownIndexType)
+	ErrFullTextIndexMultipleFields              = errors.New(errFullTextIndexMultipleFields)
+	ErrFullTextIndexUnique                      = errors.New(errFullTextIndexUnique)
+	ErrUnsupportedFullTextIndexFieldType        = errors.New(errUnsupportedFullTextIndexFieldType)
+	ErrVectorIndexMultipleFields                = errors.New(errVectorIndexMultipleFields)
+	ErrVectorIndexUnique                        = errors.New(errVectorIndexUnique)
+	ErrUnsupportedVectorIndexFieldType          = errors.New(errUnsupportedVectorIndexFieldType)
+	ErrGeoHashIndexMultipleFields               = errors.New(errGeoHashIndexMultipleFields)
+	ErrGeoHashIndexUnique                       = errors.New(errGeoHashIndexUnique)
+	ErrUnsupportedGeoHashIndexFieldType         = errors.New(errUnsupportedGeoHashIndexFieldType)
+	ErrFilteredIndexNotValueType                = errors.New(errFilteredIndexNotValueType)
+	ErrIndexPathOnNonJSONField                  = errors.New(errIndexPathOnNonJSONField)
+	ErrIndexPathNotValueType                    = errors.New(errIndexPathNotValueType)
 )
 
 // NewErrFailedToGetHeads returns a new error indicating that the heads of a document
@@ -431,6 +468,51 @@ func NewErrUnsupportedIndexFieldType(kind client.FieldKind) error {
 	)
 }
 
+// NewErrUnknownIndexType returns a new error indicating that the given index type is not known.
+func NewErrUnknownIndexType(indexType client.IndexType) error {
+	return errors.New(
+		errUnknownIndexType,
+		errors.NewKV("Type", indexType),
+	)
+}
+
+// NewErrUnsupportedFullTextIndexFieldType returns a new error indicating that the given field kind
+// is not supported by full-text indexes.
+func NewErrUnsupportedFullTextIndexFieldType(kind client.FieldKind) error {
+	return errors.New(
+		errUnsupportedFullTextIndexFieldType,
+		errors.NewKV("Kind", kind),
+	)
+}
+
+// NewErrUnsupportedVectorIndexFieldType returns a new error indicating that the given field kind
+// is not supported by vector indexes.
+func NewErrUnsupportedVectorIndexFieldType(kind client.FieldKind) error {
+	return errors.New(
+		errUnsupportedVectorIndexFieldType,
+		errors.NewKV("Kind", kind),
+	)
+}
+
+// NewErrUnsupportedGeoHashIndexFieldType returns a new error indicating that the given field kind
+// is not supported by geohash indexes.
+func NewErrUnsupportedGeoHashIndexFieldType(kind client.FieldKind) error {
+	return errors.New(
+		errUnsupportedGeoHashIndexFieldType,
+		errors.NewKV("Kind", kind),
+	)
+}
+
+// NewErrIndexPathOnNonJSONField returns a new error indicating that the given indexed path
+// is inside a field that is not a JSON field.
+func NewErrIndexPathOnNonJSONField(path string, kind client.FieldKind) error {
+	return errors.New(
+		errIndexPathOnNonJSONField,
+		errors.NewKV("Path", path),
+		errors.NewKV("Kind", kind),
+	)
+}
+
 // NewErrIndexDescHasNoFields returns a new error indicating that the given index
 // description has no fields.
 func NewErrIndexDescHasNoFields(desc client.IndexDescription) error {
@@ -690,3 +772,37 @@ func NewErrDefaultFieldValueInvalid(collection string, inner error) error {
 		errors.NewKV("Inner", inner),
 	)
 }
+
+func NewErrInvalidRevertVersion(docID string, version cid.Cid) error {
+	return errors.New(
+		errInvalidRevertVersion,
+		errors.NewKV("DocID", docID),
+		errors.NewKV("Version", version),
+	)
+}
+
+func NewErrCanNotDecrementPCounter(fieldName string) error {
+	return errors.New(
+		errCanNotDecrementPCounter,
+		errors.NewKV("Field", fieldName),
+	)
+}
+
+// NewErrCanNotVerifyIndexType returns a new error indicating that the entries of indexes of the
+// given type can not be verified.
+func NewErrCanNotVerifyIndexType(indexName string, indexType client.IndexType) error {
+	return errors.New(
+		errCanNotVerifyIndexType,
+		errors.NewKV("Name", indexName),
+		errors.NewKV("Type", indexType),
+	)
+}
+
+// NewErrCanNotVerifyBuildingIndex returns a new error indicating that the index with the given
+// name can not be verified because its background build is not ready.
+func NewErrCanNotVerifyBuildingIndex(indexName string) error {
+	return errors.New(
+		errCanNotVerifyBuildingIndex,
+		errors.NewKV("Name", indexName),
+	)
+}
@@ -87,6 +87,11 @@ func (hf *HeadFetcher) FetchNext() (*cid.Cid, error) {
 		return nil, nil
 	}
 
+	if strings.HasPrefix(res.Key, core.PRUNED_BLOCK+"/") {
+		// The markers of the blocks removed by DAG compactions are not heads
+		return hf.FetchNext()
+	}
+
 	headStoreKey, err := core.NewHeadStoreKey(res.Key)
 	if err != nil {
 		return nil, err
@@ -13,6 +13,7 @@ package fetcher
 import (
 	"github.com/bits-and-blooms/bitset"
 	"github.com/fxamacker/cbor/v2"
+	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/internal/core"
@@ -37,6 +38,16 @@ type EncodedDocument interface {
 	Reset()
 }
 
+// ScoredDocument is an [EncodedDocument] that may have been fetched by a full-text search.
+type ScoredDocument interface {
+	EncodedDocument
+
+	// Score returns the relevance of the document to the full-text search that fetched it.
+	//
+	// It has no value if the document has not been fetched by a full-text search.
+	Score() immutable.Option[float64]
+}
+
 type EPTuple []encProperty
 
 // EncProperty is an encoded property of a EncodedDocument
@@ -68,6 +79,7 @@ type encodedDocument struct {
 	id                   []byte
 	schemaVersionID      string
 	status               client.DocumentStatus
+	score                immutable.Option[float64]
 	properties           map[client.FieldDefinition]*encProperty
 	decodedPropertyCache map[client.FieldDefinition]any
 
@@ -80,7 +92,7 @@ type encodedDocument struct {
 	selectSet *bitset.BitSet // select fields
 }
 
-var _ EncodedDocument = (*encodedDocument)(nil)
+var _ ScoredDocument = (*encodedDocument)(nil)
 
 func (encdoc *encodedDocument) ID() []byte {
 	return encdoc.id
@@ -94,6 +106,10 @@ func (encdoc *encodedDocument) Status() client.DocumentStatus {
 	return encdoc.status
 }
 
+func (encdoc *encodedDocument) Score() immutable.Option[float64] {
+	return encdoc.score
+}
+
 // Reset re-initializes the EncodedDocument object.
 func (encdoc *encodedDocument) Reset() {
 	encdoc.properties = make(map[client.FieldDefinition]*encProperty, 0)
@@ -102,6 +118,7 @@ func (encdoc *encodedDocument) Reset() {
 	encdoc.selectSet = nil
 	encdoc.schemaVersionID = ""
 	encdoc.status = 0
+	encdoc.score = immutable.None[float64]()
 	encdoc.decodedPropertyCache = nil
 }
 
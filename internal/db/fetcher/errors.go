@@ -13,6 +13,8 @@ package fetcher
 import (
 	"fmt"
 
+	"github.com/ipfs/go-cid"
+
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/errors"
 )
@@ -33,6 +35,9 @@ const (
 	errInvalidFilterOperator        string = "invalid filter operator is provided"
 	errNotSupportedKindByIndex      string = "kind is not supported by index"
 	errUnexpectedTypeValue          string = "unexpected type value"
+	errVersionPruned                string = "the version has been pruned by a DAG compaction"
+	errAsOfWithoutTimestamps        string = "asOf requires the block timestamps to be enabled"
+	errAsOfBlockWithoutTimestamp    string = "asOf reached a block without a timestamp"
 )
 
 var (
@@ -51,6 +56,9 @@ var (
 	ErrInvalidInOperatorValue       = errors.New(errInvalidInOperatorValue)
 	ErrInvalidFilterOperator        = errors.New(errInvalidFilterOperator)
 	ErrUnexpectedTypeValue          = errors.New(errUnexpectedTypeValue)
+	ErrVersionPruned                = errors.New(errVersionPruned)
+	ErrAsOfWithoutTimestamps        = errors.New(errAsOfWithoutTimestamps)
+	ErrAsOfBlockWithoutTimestamp    = errors.New(errAsOfBlockWithoutTimestamp)
 )
 
 // NewErrFieldIdNotFound returns an error indicating that the given FieldId was not found.
@@ -119,3 +127,15 @@ func NewErrUnexpectedTypeValue[T any](value any) error {
 	var t T
 	return errors.New(errUnexpectedTypeValue, errors.NewKV("Value", value), errors.NewKV("Type", fmt.Sprintf("%T", t)))
 }
+
+// NewErrVersionPruned returns an error indicating that the given version has been removed
+// by a DAG compaction.
+func NewErrVersionPruned(version cid.Cid) error {
+	return errors.New(errVersionPruned, errors.NewKV("Version", version))
+}
+
+// NewErrAsOfBlockWithoutTimestamp returns an error indicating that the time at which the given
+// block was created, and therefore the state of its document at a point in time, is unknown.
+func NewErrAsOfBlockWithoutTimestamp(block cid.Cid) error {
+	return errors.New(errAsOfBlockWithoutTimestamp, errors.NewKV("Block", block))
+}
@@ -38,11 +38,24 @@ type IndexFetcher struct {
 	indexDesc     client.IndexDescription
 	indexIter     indexIterator
 	execInfo      ExecInfo
+
+	// scanRange bounds the keys iterated when there is no index filter.
+	scanRange IndexScanRange
+}
+
+// IndexScanRange is a range of values of the first field of a value index, in the order of
+// the index keys. Both bounds are inclusive, and the range is unbounded on the side of a
+// bound that has no value.
+type IndexScanRange struct {
+	Start immutable.Option[any]
+	End   immutable.Option[any]
 }
 
 var _ Fetcher = (*IndexFetcher)(nil)
 
 // NewIndexFetcher creates a new IndexFetcher.
+//
+// If the index filter is nil, every key of the value index is iterated.
 func NewIndexFetcher(
 	docFetcher Fetcher,
 	indexDesc client.IndexDescription,
@@ -55,6 +68,21 @@ func NewIndexFetcher(
 	}
 }
 
+// NewIndexScanFetcher creates a new IndexFetcher iterating, in the order of the index, every
+// key of the given value index in which the value of the first indexed field lies within the
+// given range.
+func NewIndexScanFetcher(
+	docFetcher Fetcher,
+	indexDesc client.IndexDescription,
+	scanRange IndexScanRange,
+) *IndexFetcher {
+	return &IndexFetcher{
+		docFetcher: docFetcher,
+		indexDesc:  indexDesc,
+		scanRange:  scanRange,
+	}
+}
+
 func (f *IndexFetcher) Init(
 	ctx context.Context,
 	identity immutable.Option[acpIdentity.Identity],
@@ -75,7 +103,7 @@ func (f *IndexFetcher) Init(
 	f.txn = txn
 
 	for _, indexedField := range f.indexDesc.Fields {
-		field, ok := f.col.Definition().GetFieldByName(indexedField.Name)
+		field, ok := f.col.Definition().GetFieldByName(indexedField.FieldName())
 		if ok {
 			f.indexedFields = append(f.indexedFields, field)
 		}
@@ -88,7 +116,11 @@ outer:
 			// If the field is array, we want to keep it also for the document fetcher
 			// because the index only contains one array elements, not the whole array.
 			// The doc fetcher will fetch the whole array for us.
-			if fields[i].Name == f.indexedFields[j].Name && !fields[i].Kind.IsArray() {
+			// Only value indexes hold the exact value of the field, so for other index types
+			// the doc fetcher has to fetch the field value too. The same goes for JSON fields,
+			// of which the index only holds the value at the indexed path.
+			if fields[i].Name == f.indexedFields[j].Name && !fields[i].Kind.IsArray() &&
+				f.indexDesc.Type == client.IndexTypeValue && f.indexDesc.Fields[j].JSONPath() == nil {
 				continue outer
 			}
 		}
@@ -152,6 +184,13 @@ func (f *IndexFetcher) FetchNext(ctx context.Context) (EncodedDocument, ExecInfo
 
 		hasNilField := false
 		for i, indexedField := range f.indexedFields {
+			if f.indexDesc.Type != client.IndexTypeValue {
+				// Full-text keys hold a term of the field, vector keys hold the docID and
+				// geohash keys hold an approximation of the point, none holds the value
+				// of the field.
+				break
+			}
+
 			property := &encProperty{Desc: indexedField}
 
 			field := res.key.Fields[i]
@@ -160,8 +199,8 @@ func (f *IndexFetcher) FetchNext(ctx context.Context) (EncodedDocument, ExecInfo
 			}
 
 			// Index will fetch only 1 array element. So we skip it here and let doc fetcher
-			// fetch the whole array.
-			if indexedField.Kind.IsArray() {
+			// fetch the whole array. Likewise for the value at a path inside a JSON field.
+			if indexedField.Kind.IsArray() || f.indexDesc.Fields[i].JSONPath() != nil {
 				continue
 			}
 
@@ -190,6 +229,10 @@ func (f *IndexFetcher) FetchNext(ctx context.Context) (EncodedDocument, ExecInfo
 			}
 		}
 
+		if f.indexDesc.Type == client.IndexTypeFullText {
+			f.doc.score = immutable.Some(res.score)
+		}
+
 		if len(f.docFields) > 0 {
 			targetKey := base.MakeDataStoreKeyWithCollectionAndDocID(f.col.Description(), string(f.doc.id))
 			spans := core.NewSpans(core.NewSpan(targetKey, targetKey.PrefixEnd()))
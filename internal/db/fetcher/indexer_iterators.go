@@ -11,39 +11,54 @@
 package fetcher
 
 import (
+	"bytes"
 	"cmp"
 	"context"
 	"errors"
+	"regexp/syntax"
+	"slices"
 	"strings"
 	"time"
 
 	ds "github.com/ipfs/go-datastore"
+	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/datastore/iterable"
 	"github.com/sourcenetwork/defradb/internal/connor"
 	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/encoding"
+	"github.com/sourcenetwork/defradb/internal/fulltext"
+	"github.com/sourcenetwork/defradb/internal/geo"
 	"github.com/sourcenetwork/defradb/internal/planner/mapper"
+	"github.com/sourcenetwork/defradb/internal/vector"
 
 	"github.com/ipfs/go-datastore/query"
 )
 
 const (
-	opEq       = "_eq"
-	opGt       = "_gt"
-	opGe       = "_ge"
-	opLt       = "_lt"
-	opLe       = "_le"
-	opNe       = "_ne"
-	opIn       = "_in"
-	opNin      = "_nin"
-	opLike     = "_like"
-	opNlike    = "_nlike"
-	opILike    = "_ilike"
-	opNILike   = "_nilike"
-	compOpAny  = "_any"
-	compOpAll  = "_all"
-	compOpNone = "_none"
+	opEq           = "_eq"
+	opGt           = "_gt"
+	opGe           = "_ge"
+	opLt           = "_lt"
+	opLe           = "_le"
+	opNe           = "_ne"
+	opIn           = "_in"
+	opNin          = "_nin"
+	opLike         = "_like"
+	opNlike        = "_nlike"
+	opILike        = "_ilike"
+	opNILike       = "_nilike"
+	opRegex        = "_regex"
+	opNRegex       = "_nregex"
+	opSearch       = "_search"
+	opSimilar      = "_similar"
+	opWithinRadius = "_withinRadius"
+	opWithinBox    = "_withinBox"
+	compOpAny      = "_any"
+	compOpAll      = "_all"
+	compOpNone     = "_none"
 	// it's just there for composite indexes. We construct a slice of value matchers with
 	// every matcher being responsible for a corresponding field in the index to match.
 	// For some fields there might not be any criteria to match. For examples if you have
@@ -52,6 +67,10 @@ const (
 	opAny = "__any"
 )
 
+// maxGeoHashCells is the maximum number of geohash cells scanned to find the points within
+// an area.
+const maxGeoHashCells = 16
+
 // indexIterator is an iterator over index keys.
 // It is used to iterate over the index keys that match a specific condition.
 // For example, iteration over condition _eq and _gt will have completely different logic.
@@ -65,6 +84,8 @@ type indexIterResult struct {
 	key      core.IndexDataStoreKey
 	foundKey bool
 	value    []byte
+	// score is the relevance of the document, it is only set by full-text searches.
+	score float64
 }
 
 // indexPrefixIterator is an iterator over index keys with a specific prefix.
@@ -77,6 +98,12 @@ type indexPrefixIterator struct {
 	resultIter    query.Results
 	ctx           context.Context
 	store         datastore.DSReaderWriter
+
+	// rangeStart and rangeEnd, if set, bound the iterated keys. Only the keys from rangeStart
+	// (inclusive) up to rangeEnd (exclusive) are read, instead of every key of the prefix.
+	rangeStart []byte
+	rangeEnd   []byte
+	rangeIter  iterable.Iterator
 }
 
 var _ indexIterator = (*indexPrefixIterator)(nil)
@@ -84,16 +111,31 @@ var _ indexIterator = (*indexPrefixIterator)(nil)
 func (iter *indexPrefixIterator) Init(ctx context.Context, store datastore.DSReaderWriter) error {
 	iter.ctx = ctx
 	iter.store = store
-	if iter.resultIter != nil {
-		if err := iter.resultIter.Close(); err != nil {
-			return err
-		}
+	if err := iter.Close(); err != nil {
+		return err
 	}
 	iter.resultIter = nil
+	iter.rangeIter = nil
 	return nil
 }
 
 func (iter *indexPrefixIterator) checkResultIterator() error {
+	if iter.resultIter == nil && iter.rangeEnd != nil {
+		rangeIter, err := iter.store.GetIterator(query.Query{})
+		if err != nil {
+			return err
+		}
+		resultIter, err := rangeIter.IteratePrefix(
+			iter.ctx,
+			ds.NewKey(string(iter.rangeStart)),
+			ds.NewKey(string(iter.rangeEnd)),
+		)
+		if err != nil {
+			return errors.Join(err, rangeIter.Close())
+		}
+		iter.rangeIter = rangeIter
+		iter.resultIter = resultIter
+	}
 	if iter.resultIter == nil {
 		resultIter, err := iter.store.Query(iter.ctx, query.Query{
 			Prefix: iter.indexKey.ToString(),
@@ -114,6 +156,11 @@ func (iter *indexPrefixIterator) nextResult() (indexIterResult, error) {
 	if !hasVal {
 		return indexIterResult{}, nil
 	}
+	// the datastore includes the keys equal to the end of the range, which might be the key of
+	// a unique index, so the end is checked here.
+	if iter.rangeEnd != nil && bytes.Compare([]byte(res.Key), iter.rangeEnd) >= 0 {
+		return indexIterResult{}, nil
+	}
 	key, err := core.DecodeIndexDataStoreKey([]byte(res.Key), &iter.indexDesc, iter.indexedFields)
 	if err != nil {
 		return indexIterResult{}, err
@@ -144,10 +191,14 @@ func (iter *indexPrefixIterator) Next() (indexIterResult, error) {
 }
 
 func (iter *indexPrefixIterator) Close() error {
-	if iter.resultIter == nil {
-		return nil
+	var err error
+	if iter.resultIter != nil {
+		err = iter.resultIter.Close()
+	}
+	if iter.rangeIter != nil {
+		err = errors.Join(err, iter.rangeIter.Close())
 	}
-	return iter.resultIter.Close()
+	return err
 }
 
 type eqSingleIndexIterator struct {
@@ -305,6 +356,219 @@ func (iter *arrayIndexIterator) Close() error {
 	return iter.inner.Close()
 }
 
+// vectorIndexIterator is an iterator over the approximate nearest neighbours of a vector,
+// from the nearest one.
+//
+// The index is first searched for the k nearest neighbours. If they are all consumed, as it
+// happens when some of them are filtered out, the search is repeated for twice as many
+// neighbours and those not yet returned are returned next.
+type vectorIndexIterator struct {
+	indexDesc    client.IndexDescription
+	collectionID uint32
+	vector       []float64
+	k            int
+	execInfo     *ExecInfo
+
+	// searchSize is the number of neighbours of the last search, it is 0 before the first one.
+	searchSize int
+	exhausted  bool
+	results    []vector.Result
+	returned   map[string]struct{}
+
+	ctx   context.Context
+	store datastore.DSReaderWriter
+}
+
+var _ indexIterator = (*vectorIndexIterator)(nil)
+
+func (iter *vectorIndexIterator) Init(ctx context.Context, store datastore.DSReaderWriter) error {
+	iter.ctx = ctx
+	iter.store = store
+	iter.searchSize = 0
+	iter.exhausted = false
+	iter.results = nil
+	iter.returned = make(map[string]struct{})
+	return nil
+}
+
+// search searches the index for more neighbours than the last search did.
+func (iter *vectorIndexIterator) search() error {
+	if iter.searchSize == 0 {
+		iter.searchSize = iter.k
+	} else {
+		iter.searchSize *= 2
+	}
+	graph := vector.NewStoreGraph(iter.store, iter.collectionID, iter.indexDesc.ID)
+	results, err := vector.NewIndex(graph).Search(iter.ctx, iter.vector, iter.searchSize)
+	if err != nil {
+		return err
+	}
+	// if fewer neighbours than asked for are found, there are no more to search for.
+	iter.exhausted = len(results) < iter.searchSize
+	for _, result := range results {
+		if _, ok := iter.returned[result.ID]; !ok {
+			iter.results = append(iter.results, result)
+		}
+	}
+	return nil
+}
+
+func (iter *vectorIndexIterator) Next() (indexIterResult, error) {
+	for len(iter.results) == 0 {
+		if iter.exhausted {
+			return indexIterResult{}, nil
+		}
+		err := iter.search()
+		if err != nil {
+			return indexIterResult{}, err
+		}
+	}
+	result := iter.results[0]
+	iter.results = iter.results[1:]
+	iter.returned[result.ID] = struct{}{}
+	iter.execInfo.IndexesFetched++
+
+	key := core.NewIndexDataStoreKey(
+		iter.collectionID,
+		iter.indexDesc.ID,
+		[]core.IndexedField{{Value: client.NewNormalString(result.ID)}},
+	)
+	return indexIterResult{key: key, foundKey: true}, nil
+}
+
+func (iter *vectorIndexIterator) Close() error {
+	iter.results = nil
+	iter.returned = nil
+	return nil
+}
+
+// fullTextIndexIterator is an iterator over the documents matching a full-text search.
+//
+// As documents are returned from the most relevant one, all the postings of the query terms
+// are read on the first call to Next.
+type fullTextIndexIterator struct {
+	indexDesc     client.IndexDescription
+	indexedFields []client.FieldDefinition
+	collectionID  uint32
+	terms         []string
+	execInfo      *ExecInfo
+
+	results []indexIterResult
+	loaded  bool
+
+	ctx   context.Context
+	store datastore.DSReaderWriter
+}
+
+var _ indexIterator = (*fullTextIndexIterator)(nil)
+
+func (iter *fullTextIndexIterator) Init(ctx context.Context, store datastore.DSReaderWriter) error {
+	iter.ctx = ctx
+	iter.store = store
+	iter.results = nil
+	iter.loaded = false
+	return nil
+}
+
+// loadTermPostings returns the postings of the given term mapped by docID, along with the
+// index keys they are stored at.
+func (iter *fullTextIndexIterator) loadTermPostings(
+	term string,
+	keys map[string]core.IndexDataStoreKey,
+) (map[string]fulltext.Posting, error) {
+	prefix := core.NewIndexDataStoreKey(
+		iter.collectionID,
+		iter.indexDesc.ID,
+		[]core.IndexedField{{Value: client.NewNormalString(term)}},
+	)
+	resultIter, err := iter.store.Query(iter.ctx, query.Query{
+		Prefix: prefix.ToString(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	postings := make(map[string]fulltext.Posting)
+	for {
+		res, hasVal := resultIter.NextSync()
+		if res.Error != nil {
+			return nil, errors.Join(res.Error, resultIter.Close())
+		}
+		if !hasVal {
+			break
+		}
+		iter.execInfo.IndexesFetched++
+
+		key, err := core.DecodeIndexDataStoreKey([]byte(res.Key), &iter.indexDesc, iter.indexedFields)
+		if err != nil {
+			return nil, errors.Join(err, resultIter.Close())
+		}
+		lastField := key.Fields[len(key.Fields)-1]
+		docID, ok := lastField.Value.String()
+		if !ok {
+			return nil, errors.Join(NewErrUnexpectedTypeValue[string](lastField.Value), resultIter.Close())
+		}
+		posting, err := fulltext.DecodePosting(res.Value)
+		if err != nil {
+			return nil, errors.Join(err, resultIter.Close())
+		}
+		postings[docID] = posting
+		keys[docID] = key
+	}
+	return postings, resultIter.Close()
+}
+
+func (iter *fullTextIndexIterator) load() error {
+	keys := make(map[string]core.IndexDataStoreKey)
+	postings := make(map[string]map[string]fulltext.Posting, len(iter.terms))
+	for _, term := range iter.terms {
+		termPostings, err := iter.loadTermPostings(term, keys)
+		if err != nil {
+			return err
+		}
+		postings[term] = termPostings
+	}
+
+	scores := fulltext.Score(postings)
+	docIDs := make([]string, 0, len(scores))
+	for docID := range scores {
+		docIDs = append(docIDs, docID)
+	}
+	// documents with the same score are returned in docID order so that results are stable
+	slices.SortFunc(docIDs, func(a, b string) int {
+		if c := cmp.Compare(scores[b], scores[a]); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	})
+
+	iter.results = make([]indexIterResult, len(docIDs))
+	for i, docID := range docIDs {
+		iter.results[i] = indexIterResult{key: keys[docID], foundKey: true, score: scores[docID]}
+	}
+	iter.loaded = true
+	return nil
+}
+
+func (iter *fullTextIndexIterator) Next() (indexIterResult, error) {
+	if !iter.loaded {
+		if err := iter.load(); err != nil {
+			return indexIterResult{}, err
+		}
+	}
+	if len(iter.results) == 0 {
+		return indexIterResult{}, nil
+	}
+	res := iter.results[0]
+	iter.results = iter.results[1:]
+	return res, nil
+}
+
+func (iter *fullTextIndexIterator) Close() error {
+	iter.results = nil
+	return nil
+}
+
 func executeValueMatchers(matchers []valueMatcher, fields []core.IndexedField) (bool, error) {
 	for i := range matchers {
 		res, err := matchers[i].Match(fields[i].Value)
@@ -529,6 +793,68 @@ func (m *indexLikeMatcher) doesMatch(currentVal string) bool {
 	}
 }
 
+// checks if the index value matches a regular expression
+type indexRegexMatcher struct {
+	regex   *connor.Regex
+	isMatch bool
+}
+
+func (m *indexRegexMatcher) Match(value client.NormalValue) (bool, error) {
+	strVal, ok := value.String()
+	if !ok {
+		strOptVal, ok := value.NillableString()
+		if !ok {
+			return false, NewErrUnexpectedTypeValue[string](value)
+		}
+		if !strOptVal.HasValue() {
+			return !m.isMatch, nil
+		}
+		strVal = strOptVal.Value()
+	}
+	return m.regex.MatchString(strVal) == m.isMatch, nil
+}
+
+// regexLiteralPrefix returns the literal prefix of every string matching the given pattern.
+//
+// It returns an empty string if the pattern is not anchored at the start of the text or
+// starts with a case insensitive literal, as the matching strings then have no common prefix.
+func regexLiteralPrefix(pattern string) string {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return ""
+	}
+	if re.Op != syntax.OpConcat || len(re.Sub) < 2 || re.Sub[0].Op != syntax.OpBeginText {
+		return ""
+	}
+	var prefix []rune
+	for _, sub := range re.Sub[1:] {
+		if sub.Op != syntax.OpLiteral || sub.Flags&syntax.FoldCase != 0 {
+			break
+		}
+		prefix = append(prefix, sub.Rune...)
+	}
+	return string(prefix)
+}
+
+// encodedValuePrefix returns the encoded prefix of every indexed string value starting with the
+// given prefix.
+func encodedValuePrefix(prefix string, descending bool) []byte {
+	encoded := encoding.EncodeFieldValue(nil, client.NewNormalString(prefix), descending)
+	// the encoded value ends with a 2 bytes terminator that is not part of the prefix
+	return encoded[:len(encoded)-2]
+}
+
+// encodedValuePrefixEnd returns a key that sorts after every key starting with the given
+// encoded string prefix.
+//
+// Encoded UTF-8 strings never contain two consecutive 0xff bytes, in either ascending or descending
+// order, so the prefix followed by two of them is a bound for all keys sharing the prefix.
+func encodedValuePrefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix), len(prefix)+2)
+	copy(end, prefix)
+	return append(end, 0xff, 0xff)
+}
+
 type anyMatcher struct{}
 
 func (m *anyMatcher) Match(client.NormalValue) (bool, error) { return true, nil }
@@ -546,6 +872,25 @@ func (m *invertedMatcher) Match(val client.NormalValue) (bool, error) {
 	return !res, nil
 }
 
+// jsonPathMatcher matches the values at a path inside a JSON field, which can be of any type.
+//
+// The conditions on such values are checked again once the document is fetched, so nil values
+// and values of another type than the filter value are matched and left for that check.
+type jsonPathMatcher struct {
+	matcher valueMatcher
+}
+
+func (m *jsonPathMatcher) Match(val client.NormalValue) (bool, error) {
+	if _, isNilMatcher := m.matcher.(*nilMatcher); val.IsNil() && !isNilMatcher {
+		return true, nil
+	}
+	res, err := m.matcher.Match(val)
+	if err != nil {
+		return true, nil
+	}
+	return res, nil
+}
+
 // newPrefixIteratorFromConditions creates a new eqPrefixIndexIterator for fetching indexed data.
 // It can modify the input matchers slice.
 func (f *IndexFetcher) newPrefixIteratorFromConditions(
@@ -572,7 +917,251 @@ func (f *IndexFetcher) newPrefixIteratorFromConditions(
 
 	key := f.newIndexDataStoreKeyWithValues(keyFieldValues)
 
-	return f.newPrefixIterator(key, matchers, &f.execInfo), nil
+	iter := f.newPrefixIterator(key, matchers, &f.execInfo)
+	f.narrowToValueRange(iter, fieldConditions)
+	return iter, nil
+}
+
+// valueBound is a bound of the range of values an indexed field is restricted to.
+type valueBound struct {
+	val       client.NormalValue
+	inclusive bool
+	// encoded is the ascending encoding of the value, used to compare bounds.
+	encoded []byte
+}
+
+// narrowToValueRange restricts the given iterator to the keys in which the value of the first
+// indexed field following the key prefix of the iterator lies within the range of the `_gt`,
+// `_ge`, `_lt` and `_le` conditions of that field.
+//
+// The keys of the index are ordered by the values of their fields, so the iterator can seek
+// to the lower bound of the range and stop at its upper bound instead of reading every key of
+// the prefix. Keys with a nil value never match a range, so they are left out.
+func (f *IndexFetcher) narrowToValueRange(iter *indexPrefixIterator, fieldConditions []fieldFilterCond) {
+	i := len(iter.indexKey.Fields)
+	if i >= len(fieldConditions) || fieldConditions[i].arrOp != "" || f.indexedFields[i].Kind.IsArray() {
+		return
+	}
+	lower, upper := f.determineFieldValueRange(i)
+	if lower == nil && upper == nil {
+		return
+	}
+
+	prefix := append(iter.indexKey.Bytes(), '/')
+	descending := f.indexDesc.Fields[i].Descending
+	boundKey := func(bound *valueBound, isEnd bool) []byte {
+		key := encoding.EncodeFieldValue(slices.Clone(prefix), bound.val, descending)
+		// the keys holding the bound value itself are either the first keys included
+		// or the first keys excluded
+		if bound.inclusive == isEnd {
+			return bytesPrefixEnd(key)
+		}
+		return key
+	}
+
+	if lower == nil {
+		// nil values are the lowest, so they are left out with an exclusive nil lower bound
+		nilVal, err := client.NewNormalNil(f.indexedFields[i].Kind)
+		if err != nil {
+			return
+		}
+		lower = &valueBound{val: nilVal}
+	}
+	if descending {
+		lower, upper = upper, lower
+	}
+
+	if lower != nil {
+		iter.rangeStart = boundKey(lower, false)
+	} else {
+		iter.rangeStart = prefix
+	}
+	if upper != nil {
+		iter.rangeEnd = boundKey(upper, true)
+	} else {
+		iter.rangeEnd = bytesPrefixEnd(prefix)
+	}
+}
+
+// narrowToScanRange restricts the given iterator to the keys in which the value of the first
+// indexed field lies within the scan range of the fetcher.
+//
+// A bound of which the value is not of the kind of the field is ignored, the documents
+// outside of the range are then left to be skipped by the caller.
+func (f *IndexFetcher) narrowToScanRange(iter *indexPrefixIterator) {
+	if f.indexedFields[0].Kind.IsArray() || f.indexDesc.Fields[0].JSONPath() != nil {
+		return
+	}
+	start, hasStart := f.scanRangeBound(f.scanRange.Start)
+	end, hasEnd := f.scanRangeBound(f.scanRange.End)
+	if !hasStart && !hasEnd {
+		return
+	}
+
+	prefix := append(iter.indexKey.Bytes(), '/')
+	descending := f.indexDesc.Fields[0].Descending
+	iter.rangeStart = prefix
+	iter.rangeEnd = bytesPrefixEnd(prefix)
+	if hasStart {
+		iter.rangeStart = encoding.EncodeFieldValue(slices.Clone(prefix), start, descending)
+	}
+	if hasEnd {
+		// the keys holding the end value are included
+		iter.rangeEnd = bytesPrefixEnd(encoding.EncodeFieldValue(slices.Clone(prefix), end, descending))
+	}
+	if bytes.Compare(iter.rangeStart, iter.rangeEnd) > 0 {
+		iter.rangeEnd = iter.rangeStart
+	}
+}
+
+// scanRangeBound returns the normal value of the given scan range bound. The second return
+// value is false if the bound has no value or if its value is not of the kind of the first
+// indexed field.
+func (f *IndexFetcher) scanRangeBound(bound immutable.Option[any]) (client.NormalValue, bool) {
+	if !bound.HasValue() {
+		return nil, false
+	}
+	kind := f.indexedFields[0].Kind
+	if bound.Value() == nil {
+		val, err := client.NewNormalNil(kind)
+		return val, err == nil
+	}
+	val, err := client.NewNormalValue(bound.Value())
+	if err != nil || !isRangeValueOfKind(val, kind) {
+		return nil, false
+	}
+	return val, true
+}
+
+// determineFieldValueRange returns the tightest lower and upper bounds of the values of the
+// indexed field at the given position set by the `_gt`, `_ge`, `_lt` and `_le` conditions of
+// the filter. A nil bound means the range is not bounded on that side.
+func (f *IndexFetcher) determineFieldValueRange(i int) (lower, upper *valueBound) {
+	condMap, ok := f.indexedFieldConditions(i)
+	if !ok {
+		return nil, nil
+	}
+	for key, filterVal := range condMap {
+		opKey, ok := key.(*mapper.Operator)
+		if !ok {
+			continue
+		}
+		op := opKey.Operation
+		if op != opGt && op != opGe && op != opLt && op != opLe {
+			continue
+		}
+		if filterVal == nil {
+			continue
+		}
+		if _, isRef := filterVal.(connor.ValueReference); isRef {
+			continue
+		}
+		if f.indexDesc.Fields[i].JSONPath() != nil {
+			filterVal = core.NormalizeJSONIndexValue(filterVal)
+		}
+		val, err := client.NewNormalValue(filterVal)
+		if err != nil || !isRangeValueOfKind(val, f.indexedFields[i].Kind) {
+			continue
+		}
+		bound := &valueBound{
+			val:       val,
+			inclusive: op == opGe || op == opLe,
+			encoded:   encoding.EncodeFieldValue(nil, val, false),
+		}
+		if op == opGt || op == opGe {
+			if lower == nil || compareBounds(bound, lower, true) > 0 {
+				lower = bound
+			}
+		} else if upper == nil || compareBounds(bound, upper, false) < 0 {
+			upper = bound
+		}
+	}
+	return lower, upper
+}
+
+// indexedFieldConditions returns the conditions of the index filter on the indexed field at
+// the given position. For a path inside a JSON field, the conditions on the value at that
+// path are returned.
+func (f *IndexFetcher) indexedFieldConditions(i int) (map[connor.FilterKey]any, bool) {
+	fieldInd := f.mapping.FirstIndexOfName(f.indexedFields[i].Name)
+	for filterKey, indexFilterCond := range f.indexFilter.Conditions {
+		propKey, ok := filterKey.(*mapper.PropertyIndex)
+		if !ok || fieldInd != propKey.Index {
+			continue
+		}
+		condMap, ok := indexFilterCond.(map[connor.FilterKey]any)
+		if !ok {
+			return nil, false
+		}
+		for _, name := range f.indexDesc.Fields[i].JSONPath() {
+			condMap, ok = objectPropertyConditions(condMap, name)
+			if !ok {
+				return nil, false
+			}
+		}
+		return condMap, true
+	}
+	return nil, false
+}
+
+// objectPropertyConditions returns the conditions on the object property with the given name.
+func objectPropertyConditions(conditions map[connor.FilterKey]any, name string) (map[connor.FilterKey]any, bool) {
+	for key, cond := range conditions {
+		if propKey, ok := key.(*mapper.ObjectProperty); ok && propKey.Name == name {
+			condMap, ok := cond.(map[connor.FilterKey]any)
+			return condMap, ok
+		}
+	}
+	return nil, false
+}
+
+// compareBounds compares the values of the given bounds. If the values are equal, the exclusive
+// bound is the tighter one, so it is the greater lower bound or the lesser upper bound.
+func compareBounds(a, b *valueBound, isLower bool) int {
+	if c := bytes.Compare(a.encoded, b.encoded); c != 0 || a.inclusive == b.inclusive {
+		return c
+	}
+	if a.inclusive == isLower {
+		return -1
+	}
+	return 1
+}
+
+// isRangeValueOfKind returns true if the given filter value is encoded in the index keys the
+// same way as the values of fields of the given kind, so that it can bound them.
+func isRangeValueOfKind(val client.NormalValue, kind client.FieldKind) bool {
+	var ok bool
+	switch kind {
+	case client.FieldKind_NILLABLE_INT:
+		_, ok = val.Int()
+	case client.FieldKind_NILLABLE_FLOAT:
+		_, ok = val.Float()
+	case client.FieldKind_NILLABLE_DATETIME:
+		_, ok = val.Time()
+	case client.FieldKind_NILLABLE_STRING, client.FieldKind_NILLABLE_BLOB, client.FieldKind_DocID:
+		_, ok = val.String()
+	case client.FieldKind_NILLABLE_JSON:
+		// numbers at JSON paths are indexed as floats
+		if _, ok = val.Float(); !ok {
+			_, ok = val.String()
+		}
+	}
+	return ok
+}
+
+// bytesPrefixEnd returns the smallest key that sorts after every key starting with the given
+// prefix.
+func bytesPrefixEnd(b []byte) []byte {
+	end := slices.Clone(b)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	// This statement will only be reached if the prefix is made of 0xff bytes only, which
+	// the keys of an index, starting with a '/', never are.
+	return b
 }
 
 func (f *IndexFetcher) newPrefixIterator(
@@ -648,7 +1237,152 @@ func (f *IndexFetcher) newIndexDataStoreKeyWithValues(values []client.NormalValu
 	return core.NewIndexDataStoreKey(f.col.ID(), f.indexDesc.ID, fields)
 }
 
+// newFullTextIndexIterator creates a new fullTextIndexIterator for the `_search` condition of
+// the indexed field. It returns nil if the filter has no such condition.
+func (f *IndexFetcher) newFullTextIndexIterator() (indexIterator, error) {
+	fieldInd := f.mapping.FirstIndexOfName(f.indexedFields[0].Name)
+	for filterKey, indexFilterCond := range f.indexFilter.Conditions {
+		propKey, ok := filterKey.(*mapper.PropertyIndex)
+		if !ok || fieldInd != propKey.Index {
+			continue
+		}
+		condMap, ok := indexFilterCond.(map[connor.FilterKey]any)
+		if !ok {
+			continue
+		}
+		for key, filterVal := range condMap {
+			if key.(*mapper.Operator).Operation != opSearch {
+				continue
+			}
+			var query string
+			if filterVal != nil {
+				query, ok = filterVal.(string)
+				if !ok {
+					return nil, NewErrUnexpectedTypeValue[string](filterVal)
+				}
+			}
+			return &fullTextIndexIterator{
+				indexDesc:     f.indexDesc,
+				indexedFields: f.indexedFields,
+				collectionID:  f.col.ID(),
+				terms:         fulltext.QueryTerms(query),
+				execInfo:      &f.execInfo,
+			}, nil
+		}
+	}
+	return nil, nil
+}
+
+// newGeoHashIndexIterator creates a new iterator over the geohash cells covering the area of
+// the `_withinRadius`, `_withinBox` or `_eq` condition of the indexed field. It returns nil if
+// the filter has no such condition.
+//
+// The cells may hold points outside of the area, so the filter still has to be applied to the
+// fetched documents.
+func (f *IndexFetcher) newGeoHashIndexIterator() (indexIterator, error) {
+	fieldInd := f.mapping.FirstIndexOfName(f.indexedFields[0].Name)
+	for filterKey, indexFilterCond := range f.indexFilter.Conditions {
+		propKey, ok := filterKey.(*mapper.PropertyIndex)
+		if !ok || fieldInd != propKey.Index {
+			continue
+		}
+		condMap, ok := indexFilterCond.(map[connor.FilterKey]any)
+		if !ok {
+			continue
+		}
+		for key, filterVal := range condMap {
+			var area geo.Area
+			var err error
+			switch key.(*mapper.Operator).Operation {
+			case opWithinRadius:
+				area, err = geo.NewCircle(filterVal)
+			case opWithinBox:
+				area, err = geo.NewBox(filterVal)
+			case opEq:
+				point, ok := filterVal.(client.GeoPoint)
+				if !ok {
+					continue
+				}
+				area = geo.Box{MinLat: point.Lat, MinLon: point.Lon, MaxLat: point.Lat, MaxLon: point.Lon}
+			default:
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			cells := geo.Cover(area, maxGeoHashCells)
+			if len(cells) == 0 {
+				return nil, nil
+			}
+			inValues := make([]client.NormalValue, len(cells))
+			for i, cell := range cells {
+				inValues[i] = client.NewNormalString(cell)
+			}
+			indexKey := f.newIndexDataStoreKeyWithValues(inValues[:1])
+			return &inIndexIterator{
+				indexIterator: f.newPrefixIterator(indexKey, nil, &f.execInfo),
+				inValues:      inValues,
+			}, nil
+		}
+	}
+	return nil, nil
+}
+
+// newVectorIndexIterator creates a new vectorIndexIterator for the `_similar` condition of
+// the indexed field. It returns nil if the filter has no such condition.
+func (f *IndexFetcher) newVectorIndexIterator() (indexIterator, error) {
+	fieldInd := f.mapping.FirstIndexOfName(f.indexedFields[0].Name)
+	for filterKey, indexFilterCond := range f.indexFilter.Conditions {
+		propKey, ok := filterKey.(*mapper.PropertyIndex)
+		if !ok || fieldInd != propKey.Index {
+			continue
+		}
+		condMap, ok := indexFilterCond.(map[connor.FilterKey]any)
+		if !ok {
+			continue
+		}
+		for key, filterVal := range condMap {
+			if key.(*mapper.Operator).Operation != opSimilar {
+				continue
+			}
+			similar, ok := filterVal.(mapper.Similar)
+			if !ok {
+				return nil, NewErrUnexpectedTypeValue[mapper.Similar](filterVal)
+			}
+			return &vectorIndexIterator{
+				indexDesc:    f.indexDesc,
+				collectionID: f.col.ID(),
+				vector:       similar.Vector,
+				k:            int(similar.K),
+				execInfo:     &f.execInfo,
+			}, nil
+		}
+	}
+	return nil, nil
+}
+
 func (f *IndexFetcher) createIndexIterator() (indexIterator, error) {
+	switch f.indexDesc.Type {
+	case client.IndexTypeFullText:
+		return f.newFullTextIndexIterator()
+	case client.IndexTypeVector:
+		return f.newVectorIndexIterator()
+	case client.IndexTypeGeoHash:
+		return f.newGeoHashIndexIterator()
+	}
+
+	// without a filter every key of the index is iterated
+	if f.indexFilter == nil {
+		matchers := make([]valueMatcher, len(f.indexedFields))
+		for i := range matchers {
+			matchers[i] = &anyMatcher{}
+		}
+		iter := f.newPrefixIterator(f.newIndexDataStoreKey(), matchers, &f.execInfo)
+		f.narrowToScanRange(iter)
+		return iter, nil
+	}
+
 	fieldConditions, err := f.determineFieldFilterConditions()
 	if err != nil {
 		return nil, err
@@ -691,7 +1425,20 @@ func (f *IndexFetcher) createIndexIterator() (indexIterator, error) {
 	} else if fieldConditions[0].op == opIn && fieldConditions[0].arrOp != compOpNone {
 		iter, err = f.newInIndexIterator(fieldConditions, matchers)
 	} else {
-		iter, err = f.newPrefixIterator(f.newIndexDataStoreKey(), matchers, &f.execInfo), nil
+		prefixIter := f.newPrefixIterator(f.newIndexDataStoreKey(), matchers, &f.execInfo)
+		if fieldConditions[0].op == opRegex && fieldConditions[0].arrOp == "" {
+			// only the index keys starting with the literal prefix of the pattern can match
+			if prefix := regexLiteralPrefix(fieldConditions[0].regex.String()); prefix != "" {
+				start := append(prefixIter.indexKey.Bytes(), '/')
+				start = append(start, encodedValuePrefix(prefix, f.indexDesc.Fields[0].Descending)...)
+				prefixIter.rangeStart = start
+				prefixIter.rangeEnd = encodedValuePrefixEnd(start)
+			}
+		}
+		if prefixIter.rangeEnd == nil {
+			f.narrowToValueRange(prefixIter, fieldConditions)
+		}
+		iter = prefixIter
 	}
 
 	if err != nil {
@@ -753,6 +1500,8 @@ func createValueMatcher(condition *fieldFilterCond) (valueMatcher, error) {
 		isLike := condition.op == opLike || condition.op == opILike
 		isCaseInsensitive := condition.op == opILike || condition.op == opNILike
 		return newLikeIndexCmp(strVal, isLike, isCaseInsensitive)
+	case opRegex, opNRegex:
+		return &indexRegexMatcher{regex: condition.regex, isMatch: condition.op == opRegex}, nil
 	case opAny:
 		return &anyMatcher{}, nil
 	}
@@ -767,6 +1516,9 @@ func createValueMatchers(conditions []fieldFilterCond) ([]valueMatcher, error) {
 		if err != nil {
 			return nil, err
 		}
+		if conditions[i].isJSONPath {
+			m = &jsonPathMatcher{matcher: m}
+		}
 		matchers = append(matchers, m)
 	}
 	return matchers, nil
@@ -777,6 +1529,57 @@ type fieldFilterCond struct {
 	arrOp string
 	val   client.NormalValue
 	kind  client.FieldKind
+	// regex is the regular expression of the regex operators, val then holds its pattern.
+	regex *connor.Regex
+	// isJSONPath is true if the condition is on the value at a path inside a JSON field.
+	isJSONPath bool
+}
+
+func isRegexOp(op string) bool {
+	return op == opRegex || op == opNRegex
+}
+
+// setRegex sets the regular expression of the regex operator condition from the given filter
+// value, which is compiled when the filter is mapped unless the pattern is invalid.
+func (cond *fieldFilterCond) setRegex(filterVal any) error {
+	regex, ok := filterVal.(*connor.Regex)
+	if !ok {
+		var err error
+		regex, err = connor.NewRegex(filterVal)
+		if err != nil {
+			return err
+		}
+	}
+	cond.regex = regex
+	cond.val = client.NewNormalString(regex.Pattern)
+	return nil
+}
+
+// newJSONPathFilterValue converts the given filter value compared with the value at a path
+// inside a JSON field to the value stored in the index keys. It returns false if no value in
+// the index can be equal to the filter value.
+func newJSONPathFilterValue(filterVal any) (client.NormalValue, bool) {
+	if list, ok := filterVal.([]any); ok {
+		converted := make([]any, len(list))
+		for i, item := range list {
+			converted[i] = core.NormalizeJSONIndexValue(item)
+			if converted[i] == nil && item != nil {
+				return nil, false
+			}
+		}
+		val, err := client.NewNormalValue(converted)
+		return val, err == nil
+	}
+	if filterVal == nil {
+		val, err := client.NewNormalNil(client.FieldKind_NILLABLE_JSON)
+		return val, err == nil
+	}
+	filterVal = core.NormalizeJSONIndexValue(filterVal)
+	if filterVal == nil {
+		return nil, false
+	}
+	val, err := client.NewNormalValue(filterVal)
+	return val, err == nil
 }
 
 // determineFieldFilterConditions determines the conditions and their corresponding operation
@@ -785,26 +1588,38 @@ type fieldFilterCond struct {
 func (f *IndexFetcher) determineFieldFilterConditions() ([]fieldFilterCond, error) {
 	result := make([]fieldFilterCond, 0, len(f.indexedFields))
 	for i := range f.indexedFields {
-		fieldInd := f.mapping.FirstIndexOfName(f.indexedFields[i].Name)
+		isJSONPath := f.indexDesc.Fields[i].JSONPath() != nil
 		found := false
-		// iterate through conditions and find the one that matches the current field
-		for filterKey, indexFilterCond := range f.indexFilter.Conditions {
-			propKey, ok := filterKey.(*mapper.PropertyIndex)
-			if !ok || fieldInd != propKey.Index {
-				continue
-			}
-
-			found = true
-
-			condMap := indexFilterCond.(map[connor.FilterKey]any)
+		// find the condition that matches the current field
+		if condMap, ok := f.indexedFieldConditions(i); ok {
 			for key, filterVal := range condMap {
+				if _, isRef := filterVal.(connor.ValueReference); isRef {
+					// the referenced value is only known once the document is fetched
+					continue
+				}
+				opKey, ok := key.(*mapper.Operator)
+				if !ok {
+					continue
+				}
+
 				cond := fieldFilterCond{
-					op:   key.(*mapper.Operator).Operation,
-					kind: f.indexedFields[i].Kind,
+					op:         opKey.Operation,
+					kind:       f.indexedFields[i].Kind,
+					isJSONPath: isJSONPath,
 				}
 
 				var err error
-				if filterVal == nil {
+				if isJSONPath {
+					var ok bool
+					cond.val, ok = newJSONPathFilterValue(filterVal)
+					if !ok {
+						// the condition can not be checked against the index keys, so it is
+						// only checked once the document is fetched
+						continue
+					}
+				} else if isRegexOp(cond.op) {
+					err = cond.setRegex(filterVal)
+				} else if filterVal == nil {
 					cond.val, err = client.NewNormalNil(cond.kind)
 				} else if !f.indexedFields[i].Kind.IsArray() {
 					cond.val, err = client.NewNormalValue(filterVal)
@@ -812,13 +1627,15 @@ func (f *IndexFetcher) determineFieldFilterConditions() ([]fieldFilterCond, erro
 					subCondMap := filterVal.(map[connor.FilterKey]any)
 					for subKey, subVal := range subCondMap {
 						arrKind := cond.kind.(client.ScalarArrayKind)
-						if subVal == nil {
+						cond.arrOp = cond.op
+						cond.op = subKey.(*mapper.Operator).Operation
+						if isRegexOp(cond.op) {
+							err = cond.setRegex(subVal)
+						} else if subVal == nil {
 							cond.val, err = client.NewNormalNil(arrKind.SubKind())
 						} else {
 							cond.val, err = client.NewNormalValue(subVal)
 						}
-						cond.arrOp = cond.op
-						cond.op = subKey.(*mapper.Operator).Operation
 						// the sub condition is supposed to have only 1 record
 						break
 					}
@@ -828,9 +1645,9 @@ func (f *IndexFetcher) determineFieldFilterConditions() ([]fieldFilterCond, erro
 					return nil, err
 				}
 				result = append(result, cond)
+				found = true
 				break
 			}
-			break
 		}
 		if !found {
 			result = append(result, fieldFilterCond{
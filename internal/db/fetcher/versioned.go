@@ -13,9 +13,12 @@ package fetcher
 import (
 	"container/list"
 	"context"
+	"time"
 
 	"github.com/ipfs/go-cid"
 	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	ipld "github.com/ipfs/go-ipld-format"
 	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
 
 	"github.com/sourcenetwork/immutable"
@@ -25,9 +28,11 @@ import (
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/datastore"
 	"github.com/sourcenetwork/defradb/datastore/memory"
+	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/internal/core"
 	coreblock "github.com/sourcenetwork/defradb/internal/core/block"
 	"github.com/sourcenetwork/defradb/internal/db/base"
+	"github.com/sourcenetwork/defradb/internal/merkle/clock"
 	merklecrdt "github.com/sourcenetwork/defradb/internal/merkle/crdt"
 	"github.com/sourcenetwork/defradb/internal/planner/mapper"
 )
@@ -65,8 +70,13 @@ var (
 // defined in the version, so that it can be used as a drop in replacement within
 // the scanNode request planner system.
 //
+// A VersionedFetcher created with [NewVersionedFetcherAsOf] instead recomposes the state of
+// every document within the given spans at the given time, using the timestamps of the
+// composite blocks to find the versions to seek to.
+//
 // Current limitations:
-// - We can only return a single record from an VersionedFetcher instance.
+// - We can only return a single record from an VersionedFetcher instance, unless
+// fetching the state at a given time.
 // - We can't request related sub objects (at the moment, as related objects
 // ids aren't in the state graphs.
 // - Probably more...
@@ -99,6 +109,21 @@ type VersionedFetcher struct {
 	col client.Collection
 	// @todo index  *client.IndexDescription
 	mCRDTs map[uint32]merklecrdt.MerkleCRDT
+
+	// asOf is the time at which the state of the documents should be fetched.
+	//
+	// If it has no value the state at the version given to [Start] is fetched.
+	asOf immutable.Option[time.Time]
+
+	// loadedSnapshots are the snapshots of DAG compactions whose state has been written
+	// to the transient store.
+	loadedSnapshots map[cid.Cid]struct{}
+}
+
+// NewVersionedFetcherAsOf returns a new VersionedFetcher that fetches the state that every
+// document within the spans given to [Start] had at the given time.
+func NewVersionedFetcherAsOf(asOf time.Time) *VersionedFetcher {
+	return &VersionedFetcher{asOf: immutable.Some(asOf)}
 }
 
 // Init initializes the VersionedFetcher.
@@ -118,6 +143,7 @@ func (vf *VersionedFetcher) Init(
 	vf.col = col
 	vf.queuedCids = list.New()
 	vf.mCRDTs = make(map[uint32]merklecrdt.MerkleCRDT)
+	vf.loadedSnapshots = make(map[cid.Cid]struct{})
 	vf.txn = txn
 
 	// create store
@@ -158,6 +184,10 @@ func (vf *VersionedFetcher) Start(ctx context.Context, spans core.Spans) error {
 		return client.NewErrUninitializeProperty("VersionedFetcher", "CollectionDescription")
 	}
 
+	if vf.asOf.HasValue() {
+		return vf.startAsOf(ctx, spans)
+	}
+
 	if len(spans.Value) != 1 {
 		return ErrSingleSpanOnly
 	}
@@ -191,6 +221,132 @@ func (vf *VersionedFetcher) Start(ctx context.Context, spans core.Spans) error {
 	return vf.DocumentFetcher.Start(ctx, core.Spans{})
 }
 
+// startAsOf serializes the state of every document within the given spans at the time
+// the fetcher was created with.
+//
+// The spans are either document spans or a collection span, in which case the state of
+// every document of the collection, including the deleted ones, is serialized.
+func (vf *VersionedFetcher) startAsOf(ctx context.Context, spans core.Spans) error {
+	if clock.GetContextHLC(ctx) == nil {
+		return ErrAsOfWithoutTimestamps
+	}
+	vf.ctx = ctx
+
+	docIDs, err := vf.getAsOfDocIDs(spans)
+	if err != nil {
+		return err
+	}
+
+	for _, docID := range docIDs {
+		vf.dsKey = core.DataStoreKey{DocID: docID}
+		vf.mCRDTs = make(map[uint32]merklecrdt.MerkleCRDT)
+
+		versions, err := vf.getAsOfVersions(docID)
+		if err != nil {
+			return err
+		}
+		for _, version := range versions {
+			if err := vf.seekTo(version); err != nil {
+				return NewErrFailedToSeek(version, err)
+			}
+		}
+	}
+
+	return vf.DocumentFetcher.Start(ctx, core.Spans{})
+}
+
+// getAsOfDocIDs returns the IDs of the documents within the given spans.
+func (vf *VersionedFetcher) getAsOfDocIDs(spans core.Spans) ([]string, error) {
+	docIDs := []string{}
+	for _, span := range spans.Value {
+		if span.Start().DocID != "" {
+			docIDs = append(docIDs, span.Start().DocID)
+		}
+	}
+	if len(docIDs) > 0 {
+		return docIDs, nil
+	}
+
+	prefix := core.PrimaryDataStoreKey{CollectionRootID: vf.col.Description().RootID}
+	results, err := vf.txn.Datastore().Query(vf.ctx, query.Query{
+		Prefix:   prefix.ToString(),
+		KeysOnly: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = results.Close()
+	}()
+
+	for result := range results.Next() {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		docIDs = append(docIDs, ds.NewKey(result.Key).BaseNamespace())
+	}
+	return docIDs, nil
+}
+
+// getAsOfVersions returns the CIDs of the latest composite blocks of the given document
+// that were created at or before the time the fetcher was created with.
+//
+// More than one CID is returned if the document history had diverged at that time.
+// Blocks without a timestamp are only included if a later block is, reaching one while walking
+// back the history returns an error, as the time at which it was created is unknown.
+func (vf *VersionedFetcher) getAsOfVersions(docID string) ([]cid.Cid, error) {
+	headset := clock.NewHeadSet(
+		vf.txn.Headstore(),
+		core.HeadStoreKey{DocID: docID, FieldID: core.COMPOSITE_NAMESPACE},
+	)
+	queue, _, err := headset.List(vf.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := []cid.Cid{}
+	visited := make(map[cid.Cid]struct{})
+	for len(queue) > 0 {
+		c := queue[0]
+		queue = queue[1:]
+		if _, ok := visited[c]; ok {
+			continue
+		}
+		visited[c] = struct{}{}
+
+		blk, err := vf.txn.Blockstore().Get(vf.ctx, c)
+		if errors.Is(err, ipld.ErrNotFound{}) {
+			isPruned, err := clock.IsPruned(vf.ctx, vf.txn.Headstore(), c)
+			if err != nil {
+				return nil, err
+			}
+			if isPruned {
+				// The versions older than the compaction of the document can no longer be fetched.
+				continue
+			}
+		}
+		if err != nil {
+			return nil, NewErrVFetcherFailedToGetBlock(err)
+		}
+		block, err := coreblock.GetFromBytes(blk.RawData())
+		if err != nil {
+			return nil, NewErrVFetcherFailedToDecodeNode(err)
+		}
+
+		if block.Timestamp == nil {
+			return nil, NewErrAsOfBlockWithoutTimestamp(c)
+		}
+		if !clock.HLCTimestampToTime(*block.Timestamp).After(vf.asOf.Value()) {
+			versions = append(versions, c)
+			continue
+		}
+		for _, head := range block.Heads {
+			queue = append(queue, head.Cid)
+		}
+	}
+	return versions, nil
+}
+
 // Rootstore returns the rootstore of the VersionedFetcher.
 func (vf *VersionedFetcher) Rootstore() ds.Datastore {
 	return vf.root
@@ -225,11 +381,19 @@ func (vf *VersionedFetcher) SeekTo(ctx context.Context, c cid.Cid) error {
 // to the closest existing state snapshot in the transient Versioned stores, which on the first
 // run is 0. It seeks by iteratively jumping through the state graph via the `_head` link.
 func (vf *VersionedFetcher) seekTo(c cid.Cid) error {
+	isPruned, err := clock.IsPruned(vf.ctx, vf.txn.Headstore(), c)
+	if err != nil {
+		return err
+	}
+	if isPruned {
+		return NewErrVersionPruned(c)
+	}
+
 	// reinit the queued cids list
 	vf.queuedCids = list.New()
 
 	// recursive step through the graph
-	err := vf.seekNext(c, true)
+	err = vf.seekNext(c, true)
 	if err != nil {
 		return err
 	}
@@ -273,6 +437,9 @@ func (vf *VersionedFetcher) seekTo(c cid.Cid) error {
 // seekNext is the recursive iteration step of seekTo, its goal is
 // to build the queuedCids list, and to transfer the required
 // blocks from the global to the local store.
+//
+// The graph is traversed depth first, the blocks already transferred to the local store
+// being skipped, and each block is queued after its parents.
 func (vf *VersionedFetcher) seekNext(c cid.Cid, topParent bool) error {
 	// check if cid block exists in the global store, handle err
 
@@ -291,6 +458,17 @@ func (vf *VersionedFetcher) seekNext(c cid.Cid, topParent bool) error {
 	}
 
 	blk, err := vf.txn.Blockstore().Get(vf.ctx, c)
+	if errors.Is(err, ipld.ErrNotFound{}) {
+		snapshotCid, isPruned, err := clock.PrunedSnapshot(vf.ctx, vf.txn.Headstore(), c)
+		if err != nil {
+			return err
+		}
+		if isPruned {
+			// The older part of the graph has been squashed by a DAG compaction, its state
+			// is loaded from the snapshot instead.
+			return vf.loadSnapshot(snapshotCid)
+		}
+	}
 	if err != nil {
 		return NewErrVFetcherFailedToGetBlock(err)
 	}
@@ -300,20 +478,15 @@ func (vf *VersionedFetcher) seekNext(c cid.Cid, topParent bool) error {
 		return NewErrVFetcherFailedToWriteBlock(err)
 	}
 
-	// add the CID to the queuedCIDs list
-	if topParent {
-		vf.queuedCids.PushFront(c)
-	}
-
 	// decode the block
 	block, err := coreblock.GetFromBytes(blk.RawData())
 	if err != nil {
 		return NewErrVFetcherFailedToDecodeNode(err)
 	}
 
-	// only seekNext on parent if we have a HEAD link
-	if len(block.Heads) != 0 {
-		err := vf.seekNext(block.Heads[0].Cid, true)
+	// seekNext on every parent so that the changes of all the merged branches are included
+	for _, head := range block.Heads {
+		err := vf.seekNext(head.Cid, true)
 		if err != nil {
 			return err
 		}
@@ -326,9 +499,104 @@ func (vf *VersionedFetcher) seekNext(c cid.Cid, topParent bool) error {
 		}
 	}
 
+	// add the CID to the queuedCIDs list once all its parents have been queued, so that
+	// the blocks are merged in topological order
+	if topParent {
+		vf.queuedCids.PushBack(c)
+	}
+
 	return nil
 }
 
+// loadSnapshot writes the state held by the snapshot with the given CID to the transient store.
+//
+// Pruned blocks that are not linked by the remaining blocks have no snapshot, their state is
+// loaded from the snapshot pointed to by the pruned blocks that are.
+func (vf *VersionedFetcher) loadSnapshot(c cid.Cid) error {
+	if !c.Defined() {
+		return nil
+	}
+	if _, ok := vf.loadedSnapshots[c]; ok {
+		return nil
+	}
+	vf.loadedSnapshots[c] = struct{}{}
+
+	blk, err := vf.txn.Blockstore().Get(vf.ctx, c)
+	if err != nil {
+		return NewErrVFetcherFailedToGetBlock(err)
+	}
+	snapshot, err := coreblock.GetSnapshotFromBytes(blk.RawData())
+	if err != nil {
+		return NewErrVFetcherFailedToDecodeNode(err)
+	}
+
+	for _, value := range snapshot.Values {
+		err := vf.store.Datastore().Put(vf.ctx, ds.NewKey(value.Key), value.Value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSnapshotValues returns the datastore entries holding the state of the document with the
+// given DocID once the given versions have been merged.
+//
+// This is used to create the snapshots of DAG compactions.
+func GetSnapshotValues(
+	ctx context.Context,
+	txn datastore.Txn,
+	col client.Collection,
+	docID string,
+	versions []cid.Cid,
+) ([]coreblock.SnapshotValue, error) {
+	vf := new(VersionedFetcher)
+	err := vf.Init(
+		ctx,
+		immutable.None[acpIdentity.Identity](),
+		txn,
+		immutable.None[acp.ACP](),
+		col,
+		nil,
+		nil,
+		nil,
+		false,
+		true,
+	)
+	if err != nil {
+		_ = vf.Close()
+		return nil, err
+	}
+	defer func() {
+		_ = vf.Close()
+	}()
+
+	vf.ctx = ctx
+	vf.dsKey = core.DataStoreKey{DocID: docID}
+	for _, version := range versions {
+		if err := vf.seekTo(version); err != nil {
+			return nil, NewErrFailedToSeek(version, err)
+		}
+	}
+
+	results, err := vf.store.Datastore().Query(ctx, query.Query{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = results.Close()
+	}()
+
+	values := []coreblock.SnapshotValue{}
+	for result := range results.Next() {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		values = append(values, coreblock.SnapshotValue{Key: result.Key, Value: result.Value})
+	}
+	return values, nil
+}
+
 // merge in the state of the IPLD Block identified by CID c into the VersionedFetcher state.
 // Requires the CID to already exist in the Blockstore.
 // This function only works for merging Composite MerkleCRDT objects.
@@ -431,3 +699,75 @@ func NewVersionedSpan(dsKey core.DataStoreKey, version cid.Cid) core.Spans {
 	// Todo: Dont abuse DataStoreKey for version cid!
 	return core.NewSpans(core.NewSpan(dsKey, core.DataStoreKey{DocID: version.String()}))
 }
+
+// FetchVersion returns the state of the document with the given DocID at the given version.
+//
+// It returns nil if the version is not a composite block of the document, or if the document
+// has no state at that version. Deleted states are only returned if showDeleted is true.
+func FetchVersion(
+	ctx context.Context,
+	identity immutable.Option[acpIdentity.Identity],
+	txn datastore.Txn,
+	acp immutable.Option[acp.ACP],
+	col client.Collection,
+	docID string,
+	version cid.Cid,
+	showDeleted bool,
+) (*client.Document, error) {
+	blk, err := txn.Blockstore().Get(ctx, version)
+	if errors.Is(err, ipld.ErrNotFound{}) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, NewErrVFetcherFailedToGetBlock(err)
+	}
+	block, err := coreblock.GetFromBytes(blk.RawData())
+	if err != nil {
+		return nil, err
+	}
+	if !block.Delta.IsComposite() || string(block.Delta.GetDocID()) != docID {
+		return nil, nil
+	}
+	if !showDeleted && block.Delta.GetStatus() == uint8(client.Deleted) {
+		return nil, nil
+	}
+
+	vf := new(VersionedFetcher)
+	err = vf.Init(ctx, identity, txn, acp, col, nil, nil, nil, false, showDeleted)
+	if err != nil {
+		_ = vf.Close()
+		return nil, err
+	}
+
+	err = vf.Start(ctx, NewVersionedSpan(core.DataStoreKey{DocID: docID}, version))
+	if err != nil {
+		_ = vf.Close()
+		return nil, err
+	}
+
+	encodedDoc, _, err := vf.FetchNext(ctx)
+	if err != nil {
+		_ = vf.Close()
+		return nil, err
+	}
+
+	err = vf.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	if encodedDoc == nil {
+		return nil, nil
+	}
+	return Decode(encodedDoc, col.Definition())
+}
+
+// GetFieldValue returns the value of the given field of the given document, or nil if the field
+// does not exist or has no value.
+func GetFieldValue(doc *client.Document, field string) (any, error) {
+	val, err := doc.TryGetValue(field)
+	if err != nil || val == nil {
+		return nil, err
+	}
+	return val.Value(), nil
+}
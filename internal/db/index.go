@@ -14,10 +14,16 @@ import (
 	"context"
 
 	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/datastore"
 	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/fulltext"
+	"github.com/sourcenetwork/defradb/internal/geo"
+	"github.com/sourcenetwork/defradb/internal/planner/filter"
+	"github.com/sourcenetwork/defradb/internal/planner/mapper"
 	"github.com/sourcenetwork/defradb/internal/utils/slice"
+	"github.com/sourcenetwork/defradb/internal/vector"
 )
 
 // CollectionIndex is an interface for collection indexes
@@ -61,6 +67,26 @@ func isSupportedKind(kind client.FieldKind) bool {
 func NewCollectionIndex(
 	collection client.Collection,
 	desc client.IndexDescription,
+) (CollectionIndex, error) {
+	index, err := newCollectionIndex(collection, desc)
+	if err != nil {
+		return nil, err
+	}
+	if len(desc.Filter) > 0 {
+		index, err = newCollectionPartialIndex(index, collection, desc)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if desc.IsBuilding() {
+		return newCollectionBuildingIndex(index, collection, desc), nil
+	}
+	return index, nil
+}
+
+func newCollectionIndex(
+	collection client.Collection,
+	desc client.IndexDescription,
 ) (CollectionIndex, error) {
 	if len(desc.Fields) == 0 {
 		return nil, NewErrIndexDescHasNoFields(desc)
@@ -69,16 +95,49 @@ func NewCollectionIndex(
 	base.fieldsDescs = make([]client.SchemaFieldDescription, len(desc.Fields))
 	isArray := false
 	for i := range desc.Fields {
-		field, foundField := collection.Schema().GetFieldByName(desc.Fields[i].Name)
+		fieldName := desc.Fields[i].FieldName()
+		field, foundField := collection.Schema().GetFieldByName(fieldName)
 		if !foundField {
-			return nil, client.NewErrFieldNotExist(desc.Fields[i].Name)
+			return nil, client.NewErrFieldNotExist(fieldName)
 		}
 		base.fieldsDescs[i] = field
+		if path := desc.Fields[i].JSONPath(); path != nil {
+			// only the scalar value at the path is indexed, not the whole JSON field
+			if field.Kind != client.FieldKind_NILLABLE_JSON {
+				return nil, NewErrIndexPathOnNonJSONField(desc.Fields[i].Name, field.Kind)
+			}
+			if desc.Type != client.IndexTypeValue {
+				return nil, ErrIndexPathNotValueType
+			}
+			if base.jsonPaths == nil {
+				base.jsonPaths = make([][]string, len(desc.Fields))
+			}
+			base.jsonPaths[i] = path
+			continue
+		}
+		if desc.Type == client.IndexTypeVector {
+			if _, ok := field.Kind.(*client.VectorKind); !ok {
+				return nil, NewErrUnsupportedVectorIndexFieldType(field.Kind)
+			}
+			return &collectionVectorIndex{collectionBaseIndex: base}, nil
+		}
+		if desc.Type == client.IndexTypeGeoHash {
+			if field.Kind != client.FieldKind_NILLABLE_GEOPOINT {
+				return nil, NewErrUnsupportedGeoHashIndexFieldType(field.Kind)
+			}
+			return &collectionGeoHashIndex{collectionBaseIndex: base}, nil
+		}
 		if !isSupportedKind(field.Kind) {
 			return nil, NewErrUnsupportedIndexFieldType(field.Kind)
 		}
 		isArray = isArray || field.Kind.IsArray()
 	}
+	if desc.Type == client.IndexTypeFullText {
+		if base.fieldsDescs[0].Kind != client.FieldKind_NILLABLE_STRING {
+			return nil, NewErrUnsupportedFullTextIndexFieldType(base.fieldsDescs[0].Kind)
+		}
+		return &collectionFullTextIndex{collectionBaseIndex: base}, nil
+	}
 	if isArray {
 		if desc.Unique {
 			return newCollectionArrayUniqueIndex(base), nil
@@ -92,10 +151,229 @@ func NewCollectionIndex(
 	}
 }
 
+// collectionPartialIndex is an index that only holds the documents matching its filter.
+type collectionPartialIndex struct {
+	CollectionIndex
+	filterFields []string
+	mapping      *core.DocumentMapping
+	filter       *mapper.Filter
+}
+
+var _ CollectionIndex = (*collectionPartialIndex)(nil)
+
+func newCollectionPartialIndex(
+	index CollectionIndex,
+	collection client.Collection,
+	desc client.IndexDescription,
+) (*collectionPartialIndex, error) {
+	if desc.Type != client.IndexTypeValue {
+		return nil, ErrFilteredIndexNotValueType
+	}
+	conditions, err := filter.NormalizeIndexFilter(desc.Filter, collection.Definition())
+	if err != nil {
+		return nil, err
+	}
+	filterFields := desc.FilterFields()
+	mapping := core.NewDocumentMapping()
+	for i, name := range filterFields {
+		mapping.Add(i, name)
+	}
+	return &collectionPartialIndex{
+		CollectionIndex: index,
+		filterFields:    filterFields,
+		mapping:         mapping,
+		filter:          mapper.ToFilter(request.Filter{Conditions: conditions}, mapping),
+	}, nil
+}
+
+// matches returns true if the given document matches the filter of the index.
+func (index *collectionPartialIndex) matches(doc *client.Document) (bool, error) {
+	mappedDoc := index.mapping.NewDoc()
+	for i, name := range index.filterFields {
+		fieldVal, err := doc.TryGetValue(name)
+		if err != nil {
+			return false, err
+		}
+		if fieldVal != nil {
+			mappedDoc.Fields[i] = fieldVal.Value()
+		}
+	}
+	return mapper.RunFilter(mappedDoc, index.filter)
+}
+
+// Save indexes the document if it matches the filter of the index.
+func (index *collectionPartialIndex) Save(
+	ctx context.Context,
+	txn datastore.Txn,
+	doc *client.Document,
+) error {
+	isMatch, err := index.matches(doc)
+	if err != nil || !isMatch {
+		return err
+	}
+	return index.CollectionIndex.Save(ctx, txn, doc)
+}
+
+// Update moves the document in or out of the index if the update changes whether
+// it matches the filter of the index.
+func (index *collectionPartialIndex) Update(
+	ctx context.Context,
+	txn datastore.Txn,
+	oldDoc *client.Document,
+	newDoc *client.Document,
+) error {
+	wasMatch, err := index.matches(oldDoc)
+	if err != nil {
+		return err
+	}
+	isMatch, err := index.matches(newDoc)
+	if err != nil {
+		return err
+	}
+	switch {
+	case wasMatch && isMatch:
+		return index.CollectionIndex.Update(ctx, txn, oldDoc, newDoc)
+	case wasMatch:
+		return index.CollectionIndex.Delete(ctx, txn, oldDoc)
+	case isMatch:
+		return index.CollectionIndex.Save(ctx, txn, newDoc)
+	default:
+		return nil
+	}
+}
+
+// Delete removes the document from the index if it matches the filter of the index.
+func (index *collectionPartialIndex) Delete(
+	ctx context.Context,
+	txn datastore.Txn,
+	doc *client.Document,
+) error {
+	isMatch, err := index.matches(doc)
+	if err != nil || !isMatch {
+		return err
+	}
+	return index.CollectionIndex.Delete(ctx, txn, doc)
+}
+
+// collectionBuildingIndex is an index of which the existing documents are still being
+// indexed in the background.
+//
+// Only the documents up to the last one indexed by the build are kept up to date, the
+// following ones are indexed by the build once it reaches them.
+type collectionBuildingIndex struct {
+	CollectionIndex
+	key core.IndexBuildKey
+}
+
+var _ CollectionIndex = (*collectionBuildingIndex)(nil)
+
+func newCollectionBuildingIndex(
+	index CollectionIndex,
+	collection client.Collection,
+	desc client.IndexDescription,
+) *collectionBuildingIndex {
+	return &collectionBuildingIndex{
+		CollectionIndex: index,
+		key:             core.NewIndexBuildKey(collection.ID(), desc.Name),
+	}
+}
+
+// isIndexed returns true if the given document has already been reached by the build.
+//
+// The checkpoint of the build is read within the transaction so that it conflicts with
+// a batch of the build committed concurrently. If the document has not been reached yet,
+// it is recorded in its bucket so that a concurrent batch over the document conflicts with
+// the transaction and is retried with the document. The bucket is written without being
+// read, so that the transactions writing documents do not conflict with each other.
+func (index *collectionBuildingIndex) isIndexed(
+	ctx context.Context,
+	txn datastore.Txn,
+	doc *client.Document,
+) (bool, error) {
+	checkpoint, err := getIndexBuildCheckpoint(ctx, txn, index.key)
+	if err != nil {
+		return false, err
+	}
+	docID := doc.ID().String()
+	if docID <= checkpoint.LastDocID {
+		return true, nil
+	}
+	bucket, err := indexBuildBucket(docID)
+	if err != nil {
+		return false, err
+	}
+	return false, txn.Systemstore().Put(ctx, index.key.WithBucket(formatIndexBuildBucket(bucket)).ToDS(), []byte{})
+}
+
+// Save indexes the document if it has already been reached by the build.
+func (index *collectionBuildingIndex) Save(
+	ctx context.Context,
+	txn datastore.Txn,
+	doc *client.Document,
+) error {
+	isIndexed, err := index.isIndexed(ctx, txn, doc)
+	if err != nil || !isIndexed {
+		return err
+	}
+	return index.CollectionIndex.Save(ctx, txn, doc)
+}
+
+// Update updates the document in the index if it has already been reached by the build.
+func (index *collectionBuildingIndex) Update(
+	ctx context.Context,
+	txn datastore.Txn,
+	oldDoc *client.Document,
+	newDoc *client.Document,
+) error {
+	isIndexed, err := index.isIndexed(ctx, txn, newDoc)
+	if err != nil || !isIndexed {
+		return err
+	}
+	return index.CollectionIndex.Update(ctx, txn, oldDoc, newDoc)
+}
+
+// Delete removes the document from the index if it has already been reached by the build.
+func (index *collectionBuildingIndex) Delete(
+	ctx context.Context,
+	txn datastore.Txn,
+	doc *client.Document,
+) error {
+	isIndexed, err := index.isIndexed(ctx, txn, doc)
+	if err != nil || !isIndexed {
+		return err
+	}
+	return index.CollectionIndex.Delete(ctx, txn, doc)
+}
+
 type collectionBaseIndex struct {
 	collection  client.Collection
 	desc        client.IndexDescription
 	fieldsDescs []client.SchemaFieldDescription
+	// jsonPaths holds the paths of the values indexed inside JSON fields, by position of
+	// the indexed field. It is nil if the index has no such fields.
+	jsonPaths [][]string
+}
+
+// jsonPath returns the path of the value indexed inside the JSON field at the given position,
+// or nil if the whole field is indexed.
+func (index *collectionBaseIndex) jsonPath(i int) []string {
+	if index.jsonPaths == nil {
+		return nil
+	}
+	return index.jsonPaths[i]
+}
+
+// jsonPathValue returns the value at the given path inside the given JSON value, converted to
+// the value stored in the index keys.
+func jsonPathValue(val any, path []string) any {
+	for _, key := range path {
+		obj, ok := val.(map[string]any)
+		if !ok {
+			return nil
+		}
+		val = obj[key]
+	}
+	return core.NormalizeJSONIndexValue(val)
 }
 
 func (index *collectionBaseIndex) getDocFieldValues(doc *client.Document) ([]client.NormalValue, error) {
@@ -105,6 +383,17 @@ func (index *collectionBaseIndex) getDocFieldValues(doc *client.Document) ([]cli
 		if err != nil {
 			return nil, err
 		}
+		if path := index.jsonPath(iter); path != nil && fieldVal != nil {
+			if pathVal := jsonPathValue(fieldVal.Value(), path); pathVal != nil {
+				normalVal, err := client.NewNormalValue(pathVal)
+				if err != nil {
+					return nil, err
+				}
+				result = append(result, normalVal)
+				continue
+			}
+			fieldVal = nil
+		}
 		if fieldVal == nil || fieldVal.Value() == nil {
 			normalNil, err := client.NewNormalNil(index.fieldsDescs[iter].Kind)
 			if err != nil {
@@ -407,9 +696,9 @@ func (index *collectionUniqueIndex) deleteDocIndex(
 }
 
 func isUpdatingIndexedFields(index CollectionIndex, oldDoc, newDoc *client.Document) bool {
-	for _, indexedFields := range index.Description().Fields {
-		oldVal, getOldValErr := oldDoc.GetValue(indexedFields.Name)
-		newVal, getNewValErr := newDoc.GetValue(indexedFields.Name)
+	for _, indexedField := range index.Description().Fields {
+		oldVal, getOldValErr := oldDoc.GetValue(indexedField.FieldName())
+		newVal, getNewValErr := newDoc.GetValue(indexedField.FieldName())
 
 		// GetValue will return an error when the field doesn't exist.
 		// This will happen for oldDoc only if the field hasn't been set
@@ -421,6 +710,12 @@ func isUpdatingIndexedFields(index CollectionIndex, oldDoc, newDoc *client.Docum
 			continue
 		case getOldValErr != nil && getNewValErr == nil:
 			return true
+		case indexedField.JSONPath() != nil:
+			// JSON values can not be compared directly, only the indexed values inside them
+			path := indexedField.JSONPath()
+			if jsonPathValue(oldVal.Value(), path) != jsonPathValue(newVal.Value(), path) {
+				return true
+			}
 		case oldVal.Value() != newVal.Value():
 			return true
 		}
@@ -743,3 +1038,252 @@ func (index *collectionArrayUniqueIndex) Delete(
 	}
 	return nil
 }
+
+// collectionFullTextIndex is an inverted index of the terms of a single String field.
+//
+// For every term of the field value a key made of the term and the docID is stored, the
+// value of the key is the [fulltext.Posting] of the term in the document.
+type collectionFullTextIndex struct {
+	collectionBaseIndex
+}
+
+var _ CollectionIndex = (*collectionFullTextIndex)(nil)
+
+// getDocumentsPostings returns the index keys of the terms of the document, along with their
+// postings.
+func (index *collectionFullTextIndex) getDocumentsPostings(
+	doc *client.Document,
+) ([]core.IndexDataStoreKey, []fulltext.Posting, error) {
+	fieldValues, err := index.getDocFieldValues(doc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var text string
+	if val, ok := fieldValues[0].String(); ok {
+		text = val
+	} else if val, ok := fieldValues[0].NillableString(); ok && val.HasValue() {
+		text = val.Value()
+	}
+
+	frequencies, length := fulltext.Frequencies(text)
+	keys := make([]core.IndexDataStoreKey, 0, len(frequencies))
+	postings := make([]fulltext.Posting, 0, len(frequencies))
+	for term, frequency := range frequencies {
+		fields := []core.IndexedField{
+			{Value: client.NewNormalString(term)},
+			{Value: client.NewNormalString(doc.ID().String())},
+		}
+		keys = append(keys, core.NewIndexDataStoreKey(index.collection.ID(), index.desc.ID, fields))
+		postings = append(postings, fulltext.Posting{Frequency: frequency, Length: length})
+	}
+	return keys, postings, nil
+}
+
+// Save indexes a document by storing the postings of the terms of the indexed field.
+func (index *collectionFullTextIndex) Save(
+	ctx context.Context,
+	txn datastore.Txn,
+	doc *client.Document,
+) error {
+	keys, postings, err := index.getDocumentsPostings(doc)
+	if err != nil {
+		return err
+	}
+	for i := range keys {
+		err = txn.Datastore().Put(ctx, keys[i].ToDS(), postings[i].Bytes())
+		if err != nil {
+			return NewErrFailedToStoreIndexedField(keys[i].ToString(), err)
+		}
+	}
+	return nil
+}
+
+func (index *collectionFullTextIndex) Update(
+	ctx context.Context,
+	txn datastore.Txn,
+	oldDoc *client.Document,
+	newDoc *client.Document,
+) error {
+	err := index.Delete(ctx, txn, oldDoc)
+	if err != nil {
+		return err
+	}
+	return index.Save(ctx, txn, newDoc)
+}
+
+func (index *collectionFullTextIndex) Delete(
+	ctx context.Context,
+	txn datastore.Txn,
+	doc *client.Document,
+) error {
+	keys, _, err := index.getDocumentsPostings(doc)
+	if err != nil {
+		return err
+	}
+	for i := range keys {
+		err = index.deleteIndexKey(ctx, txn, keys[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectionGeoHashIndex is a spatial index of a single GeoPoint field.
+//
+// For every geohash precision a key made of the geohash of the point and the docID is
+// stored, so that the documents within any cell can be found by prefix.
+type collectionGeoHashIndex struct {
+	collectionBaseIndex
+}
+
+var _ CollectionIndex = (*collectionGeoHashIndex)(nil)
+
+// getDocumentsIndexKeys returns the index keys of the point of the document, or nil if it
+// has none.
+func (index *collectionGeoHashIndex) getDocumentsIndexKeys(
+	doc *client.Document,
+) ([]core.IndexDataStoreKey, error) {
+	fieldValues, err := index.getDocFieldValues(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var point client.GeoPoint
+	if val, ok := fieldValues[0].GeoPoint(); ok {
+		point = val
+	} else if val, ok := fieldValues[0].NillableGeoPoint(); ok && val.HasValue() {
+		point = val.Value()
+	} else {
+		return nil, nil
+	}
+
+	keys := make([]core.IndexDataStoreKey, geo.MaxPrecision)
+	for i := range keys {
+		fields := []core.IndexedField{
+			{Value: client.NewNormalString(geo.GeoHash(point, i+1))},
+			{Value: client.NewNormalString(doc.ID().String())},
+		}
+		keys[i] = core.NewIndexDataStoreKey(index.collection.ID(), index.desc.ID, fields)
+	}
+	return keys, nil
+}
+
+// Save indexes a document by storing the geohashes of the indexed field value.
+func (index *collectionGeoHashIndex) Save(
+	ctx context.Context,
+	txn datastore.Txn,
+	doc *client.Document,
+) error {
+	keys, err := index.getDocumentsIndexKeys(doc)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		err = txn.Datastore().Put(ctx, key.ToDS(), []byte{})
+		if err != nil {
+			return NewErrFailedToStoreIndexedField(key.ToString(), err)
+		}
+	}
+	return nil
+}
+
+func (index *collectionGeoHashIndex) Update(
+	ctx context.Context,
+	txn datastore.Txn,
+	oldDoc *client.Document,
+	newDoc *client.Document,
+) error {
+	err := index.Delete(ctx, txn, oldDoc)
+	if err != nil {
+		return err
+	}
+	return index.Save(ctx, txn, newDoc)
+}
+
+func (index *collectionGeoHashIndex) Delete(
+	ctx context.Context,
+	txn datastore.Txn,
+	doc *client.Document,
+) error {
+	keys, err := index.getDocumentsIndexKeys(doc)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		err = index.deleteIndexKey(ctx, txn, key)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectionVectorIndex is an approximate nearest neighbour index of a single vector field.
+//
+// Every document with a vector is a node of a [vector.Index] graph, stored under a key
+// made of its docID.
+type collectionVectorIndex struct {
+	collectionBaseIndex
+}
+
+var _ CollectionIndex = (*collectionVectorIndex)(nil)
+
+func (index *collectionVectorIndex) newIndex(txn datastore.Txn) *vector.Index {
+	return vector.NewIndex(vector.NewStoreGraph(txn.Datastore(), index.collection.ID(), index.desc.ID))
+}
+
+// getDocumentsVector returns the vector of the document, or nil if it has none.
+func (index *collectionVectorIndex) getDocumentsVector(doc *client.Document) ([]float64, error) {
+	fieldValues, err := index.getDocFieldValues(doc)
+	if err != nil {
+		return nil, err
+	}
+	if val, ok := fieldValues[0].FloatArray(); ok {
+		return val, nil
+	}
+	return nil, nil
+}
+
+// Save indexes a document by inserting its vector into the graph.
+func (index *collectionVectorIndex) Save(
+	ctx context.Context,
+	txn datastore.Txn,
+	doc *client.Document,
+) error {
+	vec, err := index.getDocumentsVector(doc)
+	if err != nil || vec == nil {
+		return err
+	}
+	return index.newIndex(txn).Insert(ctx, doc.ID().String(), vec)
+}
+
+func (index *collectionVectorIndex) Update(
+	ctx context.Context,
+	txn datastore.Txn,
+	oldDoc *client.Document,
+	newDoc *client.Document,
+) error {
+	err := index.Delete(ctx, txn, oldDoc)
+	if err != nil {
+		return err
+	}
+	return index.Save(ctx, txn, newDoc)
+}
+
+func (index *collectionVectorIndex) Delete(
+	ctx context.Context,
+	txn datastore.Txn,
+	doc *client.Document,
+) error {
+	vec, err := index.getDocumentsVector(doc)
+	if err != nil || vec == nil {
+		return err
+	}
+	err = index.newIndex(txn).Delete(ctx, doc.ID().String())
+	if errors.Is(err, vector.ErrNodeNotFound) {
+		return NewErrCorruptedIndex(index.desc.Name)
+	}
+	return err
+}
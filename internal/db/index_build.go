@@ -0,0 +1,448 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/sourcenetwork/corelog"
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/db/base"
+)
+
+// indexBuildBatchSize is the number of documents indexed by each transaction of a
+// background index build.
+const indexBuildBatchSize = 100
+
+// indexBuildBucketCount is the number of buckets the documents written past the checkpoint of
+// a background index build are recorded in, one per value of the first byte of the document ID.
+const indexBuildBucketCount = 256
+
+// indexBuildRetryInterval is the delay before the first retry of a batch of a background index
+// build conflicting with a concurrent transaction. It doubles with each retry.
+const indexBuildRetryInterval = 10 * time.Millisecond
+
+// signalIndexBuilds wakes up the background index builder.
+func (db *db) signalIndexBuilds() {
+	select {
+	case db.indexBuildSignal <- struct{}{}:
+	default:
+		// the builder has already been signaled
+	}
+}
+
+// handleIndexBuilds builds the indexes created in the background.
+//
+// It resumes the builds interrupted by a restart and then waits for new builds.
+func (db *db) handleIndexBuilds(ctx context.Context) {
+	defer db.indexBuilds.Done()
+	for {
+		db.buildIndexes(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-db.indexBuildSignal:
+		}
+	}
+}
+
+// buildIndexes builds all the indexes that are still being built, one at a time.
+func (db *db) buildIndexes(ctx context.Context) {
+	keys, err := db.getBuildingIndexKeys(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.ErrorContextE(ctx, "Failed to load index builds", err)
+		}
+		return
+	}
+	for _, key := range keys {
+		err := db.buildIndex(ctx, key)
+		if ctx.Err() != nil {
+			// the db is closing, the build will resume on the next start
+			return
+		}
+		if err == nil {
+			continue
+		}
+		log.ErrorContextE(ctx, "Failed to build index", err, corelog.String("Index", key.IndexName))
+		err = db.failIndexBuild(ctx, key, err)
+		if err != nil {
+			log.ErrorContextE(ctx, "Failed to store index build failure", err,
+				corelog.String("Index", key.IndexName))
+		}
+	}
+}
+
+// getBuildingIndexKeys returns the keys of the indexes that are still being built.
+func (db *db) getBuildingIndexKeys(ctx context.Context) ([]core.CollectionIndexKey, error) {
+	txn, err := db.NewTxn(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Discard(ctx)
+
+	prefix := core.NewCollectionIndexKey(immutable.None[uint32](), "")
+	keys, indexDescriptions, err := datastore.DeserializePrefix[client.IndexDescription](
+		ctx,
+		prefix.ToString(),
+		txn.Systemstore(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []core.CollectionIndexKey
+	for i, desc := range indexDescriptions {
+		if desc.Build == nil || desc.Build.State != client.IndexBuildStateBuilding {
+			continue
+		}
+		indexKey, err := core.NewCollectionIndexKeyFromString(keys[i])
+		if err != nil {
+			return nil, NewErrInvalidStoredIndexKey(keys[i])
+		}
+		result = append(result, indexKey)
+	}
+	return result, nil
+}
+
+// buildIndex indexes the existing documents of the index with the given key in batches
+// until the build is ready.
+//
+// Batches conflicting with concurrent writes are retried after an increasing delay, up to
+// the maximum number of transaction retries of the db.
+func (db *db) buildIndex(ctx context.Context, key core.CollectionIndexKey) error {
+	retries := 0
+	for ctx.Err() == nil {
+		isDone, err := db.buildIndexBatch(ctx, key)
+		if errors.Is(err, datastore.ErrTxnConflict) && retries < db.MaxTxnRetries() {
+			select {
+			case <-ctx.Done():
+			case <-time.After(indexBuildRetryInterval << retries):
+			}
+			retries++
+			continue
+		}
+		if err != nil || isDone {
+			return err
+		}
+		retries = 0
+	}
+	return ctx.Err()
+}
+
+// buildIndexBatch indexes the next batch of documents following the checkpoint of the
+// index build and moves the checkpoint past them within the same transaction.
+//
+// It returns true once all the documents have been indexed, or if the build no longer exists.
+func (db *db) buildIndexBatch(ctx context.Context, key core.CollectionIndexKey) (bool, error) {
+	txn, err := db.NewTxn(ctx, false)
+	if err != nil {
+		return false, err
+	}
+	defer txn.Discard(ctx)
+	ctx = SetContextTxn(ctx, txn)
+
+	desc, err := getIndexDescription(ctx, txn, key)
+	if errors.Is(err, ds.ErrNotFound) {
+		// the index has been dropped
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if desc.Build == nil || desc.Build.State != client.IndexBuildStateBuilding {
+		return true, nil
+	}
+
+	col, err := db.getCollectionByID(ctx, key.CollectionID.Value())
+	if err != nil {
+		return false, err
+	}
+	c := col.(*collection)
+
+	var index CollectionIndex
+	for _, colIndex := range c.indexes {
+		if colIndex.Name() == desc.Name {
+			index = colIndex
+			break
+		}
+	}
+	if building, ok := index.(*collectionBuildingIndex); ok {
+		// the documents following the checkpoint are indexed by the build itself
+		index = building.CollectionIndex
+	}
+	if index == nil {
+		return false, NewErrIndexWithNameDoesNotExists(desc.Name)
+	}
+
+	buildKey := core.NewIndexBuildKey(key.CollectionID.Value(), desc.Name)
+	checkpoint, err := getIndexBuildCheckpoint(ctx, txn, buildKey)
+	if err != nil {
+		return false, err
+	}
+
+	start := base.MakeDataStoreKeyWithCollectionDescription(c.Description())
+	end := start.PrefixEnd()
+	firstBucket := 0
+	if checkpoint.LastDocID != "" {
+		start = start.WithDocID(checkpoint.LastDocID).PrefixEnd()
+		firstBucket, err = indexBuildBucket(checkpoint.LastDocID)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	count := 0
+	err = c.iterateDocs(
+		ctx,
+		c.getIndexedFields(desc),
+		core.NewSpan(start, end),
+		indexBuildBatchSize,
+		func(doc *client.Document) error {
+			count++
+			checkpoint.LastDocID = doc.ID().String()
+			return index.Save(ctx, txn, doc)
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+	checkpoint.DocsProcessed += uint64(count)
+
+	lastBucket := indexBuildBucketCount - 1
+	if count == indexBuildBatchSize {
+		lastBucket, err = indexBuildBucket(checkpoint.LastDocID)
+		if err != nil {
+			return false, err
+		}
+	}
+	// Documents created within the range of the batch by concurrent transactions are not seen
+	// by it, the buckets they are recorded in are read so that the batch conflicts with them.
+	for bucket := firstBucket; bucket <= lastBucket; bucket++ {
+		_, err = txn.Systemstore().Has(ctx, buildKey.WithBucket(formatIndexBuildBucket(bucket)).ToDS())
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if count == indexBuildBatchSize {
+		err = putIndexBuildCheckpoint(ctx, txn, buildKey, checkpoint)
+		if err != nil {
+			return false, err
+		}
+		return false, txn.Commit(ctx)
+	}
+
+	desc.Build.State = client.IndexBuildStateReady
+	desc.Build.DocsProcessed = checkpoint.DocsProcessed
+	err = putIndexDescription(ctx, txn, key, desc)
+	if err != nil {
+		return false, err
+	}
+	err = deleteIndexBuildKeys(ctx, txn, buildKey)
+	if err != nil {
+		return false, err
+	}
+	return true, txn.Commit(ctx)
+}
+
+// failIndexBuild marks the build of the index with the given key as failed.
+func (db *db) failIndexBuild(ctx context.Context, key core.CollectionIndexKey, buildErr error) error {
+	for i := 0; i < db.MaxTxnRetries(); i++ {
+		err := db.storeIndexBuildFailure(ctx, key, buildErr)
+		if !errors.Is(err, datastore.ErrTxnConflict) {
+			return err
+		}
+	}
+	return datastore.ErrTxnConflict
+}
+
+func (db *db) storeIndexBuildFailure(ctx context.Context, key core.CollectionIndexKey, buildErr error) error {
+	txn, err := db.NewTxn(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard(ctx)
+
+	desc, err := getIndexDescription(ctx, txn, key)
+	if errors.Is(err, ds.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if desc.Build == nil {
+		return nil
+	}
+	buildKey := core.NewIndexBuildKey(key.CollectionID.Value(), desc.Name)
+	checkpoint, err := getIndexBuildCheckpoint(ctx, txn, buildKey)
+	if err != nil {
+		return err
+	}
+	desc.Build.State = client.IndexBuildStateFailed
+	desc.Build.DocsProcessed = checkpoint.DocsProcessed
+	desc.Build.Error = buildErr.Error()
+	err = putIndexDescription(ctx, txn, key, desc)
+	if err != nil {
+		return err
+	}
+	err = deleteIndexBuildKeys(ctx, txn, buildKey)
+	if err != nil {
+		return err
+	}
+	return txn.Commit(ctx)
+}
+
+func getIndexDescription(
+	ctx context.Context,
+	txn datastore.Txn,
+	key core.CollectionIndexKey,
+) (client.IndexDescription, error) {
+	buf, err := txn.Systemstore().Get(ctx, key.ToDS())
+	if err != nil {
+		return client.IndexDescription{}, err
+	}
+	var desc client.IndexDescription
+	err = json.Unmarshal(buf, &desc)
+	if err != nil {
+		return client.IndexDescription{}, err
+	}
+	return desc, nil
+}
+
+func putIndexDescription(
+	ctx context.Context,
+	txn datastore.Txn,
+	key core.CollectionIndexKey,
+	desc client.IndexDescription,
+) error {
+	buf, err := json.Marshal(desc)
+	if err != nil {
+		return err
+	}
+	return txn.Systemstore().Put(ctx, key.ToDS(), buf)
+}
+
+// indexBuildCheckpoint is the progress of the background build of an index.
+//
+// It is stored apart from the description of the index, so that the transactions reading the
+// description, such as the ones writing documents, do not conflict with every batch of the build.
+type indexBuildCheckpoint struct {
+	// LastDocID is the ID of the last document indexed so far.
+	//
+	// The build resumes from the document following it, for example after a restart.
+	LastDocID string
+	// DocsProcessed is the number of documents indexed so far.
+	DocsProcessed uint64
+}
+
+// getIndexBuildCheckpoint returns the checkpoint of the index build with the given key.
+//
+// The checkpoint is empty if the build has not indexed any document yet.
+func getIndexBuildCheckpoint(
+	ctx context.Context,
+	txn datastore.Txn,
+	key core.IndexBuildKey,
+) (indexBuildCheckpoint, error) {
+	buf, err := txn.Systemstore().Get(ctx, key.ToDS())
+	if errors.Is(err, ds.ErrNotFound) {
+		return indexBuildCheckpoint{}, nil
+	}
+	if err != nil {
+		return indexBuildCheckpoint{}, err
+	}
+	var checkpoint indexBuildCheckpoint
+	err = json.Unmarshal(buf, &checkpoint)
+	if err != nil {
+		return indexBuildCheckpoint{}, err
+	}
+	return checkpoint, nil
+}
+
+func putIndexBuildCheckpoint(
+	ctx context.Context,
+	txn datastore.Txn,
+	key core.IndexBuildKey,
+	checkpoint indexBuildCheckpoint,
+) error {
+	buf, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	return txn.Systemstore().Put(ctx, key.ToDS(), buf)
+}
+
+// withIndexBuildProgress returns the given description of an index of the given collection
+// with the number of documents processed by its build so far.
+func withIndexBuildProgress(
+	ctx context.Context,
+	txn datastore.Txn,
+	colID uint32,
+	desc client.IndexDescription,
+) (client.IndexDescription, error) {
+	if desc.Build == nil || desc.Build.State != client.IndexBuildStateBuilding {
+		return desc, nil
+	}
+	checkpoint, err := getIndexBuildCheckpoint(ctx, txn, core.NewIndexBuildKey(colID, desc.Name))
+	if err != nil {
+		return client.IndexDescription{}, err
+	}
+	build := *desc.Build
+	build.DocsProcessed = checkpoint.DocsProcessed
+	desc.Build = &build
+	return desc, nil
+}
+
+// deleteIndexBuildKeys deletes the checkpoint and the buckets of the index build with the
+// given key, or of all the index builds of the collection if the key has no index name.
+func deleteIndexBuildKeys(ctx context.Context, txn datastore.Txn, key core.IndexBuildKey) error {
+	keys, err := datastore.FetchKeysForPrefix(ctx, key.ToString(), txn.Systemstore())
+	if err != nil {
+		return err
+	}
+	if key.IndexName != "" {
+		keys = append(keys, key.ToDS())
+	}
+	for _, key := range keys {
+		err = txn.Systemstore().Delete(ctx, key)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// indexBuildBucket returns the bucket of the given document ID.
+//
+// The buckets follow the order of the document IDs, so that a batch of the build only
+// has to read the buckets within its range of documents.
+func indexBuildBucket(docID string) (int, error) {
+	id, err := client.NewDocIDFromString(docID)
+	if err != nil {
+		return 0, err
+	}
+	return int(id.UUID()[0]), nil
+}
+
+// formatIndexBuildBucket returns the name of the given bucket within the keys of the build.
+func formatIndexBuildBucket(bucket int) string {
+	return fmt.Sprintf("%02x", bucket)
+}
@@ -0,0 +1,156 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/internal/core"
+)
+
+func TestBuildIndexes_IfBuildWasInterrupted_ShouldResumeFromCheckpoint(t *testing.T) {
+	f := newIndexTestFixture(t)
+	defer f.db.Close()
+
+	docs := []*client.Document{
+		f.newUserDoc("John", 21, f.users),
+		f.newUserDoc("Islam", 18, f.users),
+		f.newUserDoc("Andy", 30, f.users),
+	}
+	for _, doc := range docs {
+		f.saveDocToCollection(doc, f.users)
+	}
+	slices.SortFunc(docs, func(a, b *client.Document) int {
+		return strings.Compare(a.ID().String(), b.ID().String())
+	})
+
+	// store a build that was interrupted after indexing the first document
+	desc := getUsersIndexDescOnName()
+	desc.ID = 1
+	desc.Background = true
+	desc.Build = &client.IndexBuildStatus{State: client.IndexBuildStateBuilding}
+	key := core.NewCollectionIndexKey(immutable.Some(f.users.ID()), desc.Name)
+	err := putIndexDescription(f.ctx, f.txn, key, desc)
+	require.NoError(t, err)
+	err = putIndexBuildCheckpoint(f.ctx, f.txn, core.NewIndexBuildKey(f.users.ID(), desc.Name), indexBuildCheckpoint{
+		LastDocID:     docs[0].ID().String(),
+		DocsProcessed: 1,
+	})
+	require.NoError(t, err)
+	f.commitTxn()
+
+	f.db.buildIndexes(f.ctx)
+	f.commitTxn()
+
+	indexes, err := f.getCollectionIndexes(f.users.ID())
+	require.NoError(t, err)
+	require.Len(t, indexes, 1)
+	assert.Equal(t, &client.IndexBuildStatus{
+		State:         client.IndexBuildStateReady,
+		DocsProcessed: 3,
+	}, indexes[0].Build)
+
+	for i, doc := range docs {
+		key := newIndexKeyBuilder(f).Col(usersColName).Fields(usersNameFieldName).Doc(doc).Build()
+		exists, err := f.txn.Datastore().Has(f.ctx, key.ToDS())
+		require.NoError(t, err)
+		// only the documents following the checkpoint are indexed by the resumed build
+		assert.Equal(t, i > 0, exists, key.ToString())
+	}
+}
+
+func TestCreateIndex_IfBackground_ShouldNotIndexExistingDocsWithinTxn(t *testing.T) {
+	f := newIndexTestFixture(t)
+	defer f.db.Close()
+
+	doc := f.newUserDoc("John", 21, f.users)
+	f.saveDocToCollection(doc, f.users)
+
+	desc := getUsersIndexDescOnName()
+	desc.Background = true
+	ctx := SetContextTxn(f.ctx, f.txn)
+	_, err := f.db.createCollectionIndex(ctx, f.users.Name().Value(), desc)
+	require.NoError(t, err)
+
+	indexes, err := f.getCollectionIndexes(f.users.ID())
+	require.NoError(t, err)
+	require.Len(t, indexes, 1)
+	assert.Equal(t, &client.IndexBuildStatus{State: client.IndexBuildStateBuilding}, indexes[0].Build)
+
+	key := newIndexKeyBuilder(f).Col(usersColName).Fields(usersNameFieldName).Doc(doc).Build()
+	key.IndexID = indexes[0].ID
+	exists, err := f.txn.Datastore().Has(f.ctx, key.ToDS())
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestBuildingIndex_IfDocsWrittenPastCheckpointConcurrently_ShouldNotConflict(t *testing.T) {
+	f := newIndexTestFixture(t)
+	defer f.db.Close()
+
+	desc := getUsersIndexDescOnName()
+	desc.ID = 1
+	desc.Background = true
+	desc.Build = &client.IndexBuildStatus{State: client.IndexBuildStateBuilding}
+	key := core.NewCollectionIndexKey(immutable.Some(f.users.ID()), desc.Name)
+	err := putIndexDescription(f.ctx, f.txn, key, desc)
+	require.NoError(t, err)
+	f.commitTxn()
+
+	docs := []*client.Document{
+		f.newUserDoc("John", 21, f.users),
+		f.newUserDoc("Islam", 18, f.users),
+	}
+	txns := make([]datastore.Txn, len(docs))
+	for i, doc := range docs {
+		txns[i], err = f.db.NewTxn(f.ctx, false)
+		require.NoError(t, err)
+		err = f.users.Create(SetContextTxn(f.ctx, txns[i]), doc)
+		require.NoError(t, err)
+	}
+	for _, txn := range txns {
+		require.NoError(t, txn.Commit(f.ctx))
+	}
+
+	f.db.buildIndexes(f.ctx)
+	f.commitTxn()
+
+	indexes, err := f.getCollectionIndexes(f.users.ID())
+	require.NoError(t, err)
+	require.Len(t, indexes, 1)
+	assert.Equal(t, &client.IndexBuildStatus{
+		State:         client.IndexBuildStateReady,
+		DocsProcessed: 2,
+	}, indexes[0].Build)
+
+	for _, doc := range docs {
+		key := newIndexKeyBuilder(f).Col(usersColName).Fields(usersNameFieldName).Doc(doc).Build()
+		exists, err := f.txn.Datastore().Has(f.ctx, key.ToDS())
+		require.NoError(t, err)
+		assert.True(t, exists, key.ToString())
+	}
+
+	buildKeys, err := datastore.FetchKeysForPrefix(
+		f.ctx,
+		core.NewIndexBuildKey(f.users.ID(), "").ToString(),
+		f.txn.Systemstore(),
+	)
+	require.NoError(t, err)
+	assert.Empty(t, buildKeys)
+}
@@ -0,0 +1,339 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/core"
+)
+
+// indexEntry is a key-value record stored by an index for a document.
+type indexEntry struct {
+	key   core.IndexDataStoreKey
+	value []byte
+	docID string
+}
+
+// verifiableIndex is implemented by the indexes of which the entries of a document can be
+// computed from the document alone, which is required to verify and repair them.
+type verifiableIndex interface {
+	// getDocumentsEntries returns the entries the index must hold for the given document.
+	getDocumentsEntries(doc *client.Document) ([]indexEntry, error)
+}
+
+var (
+	_ verifiableIndex = (*collectionSimpleIndex)(nil)
+	_ verifiableIndex = (*collectionUniqueIndex)(nil)
+	_ verifiableIndex = (*collectionArrayIndex)(nil)
+	_ verifiableIndex = (*collectionArrayUniqueIndex)(nil)
+	_ verifiableIndex = (*collectionFullTextIndex)(nil)
+	_ verifiableIndex = (*collectionGeoHashIndex)(nil)
+	_ verifiableIndex = (*collectionPartialIndex)(nil)
+)
+
+func newIndexEntries(keys []core.IndexDataStoreKey, doc *client.Document) []indexEntry {
+	entries := make([]indexEntry, len(keys))
+	for i, key := range keys {
+		entries[i] = indexEntry{key: key, value: []byte{}, docID: doc.ID().String()}
+	}
+	return entries
+}
+
+func (index *collectionSimpleIndex) getDocumentsEntries(doc *client.Document) ([]indexEntry, error) {
+	key, err := index.getDocumentsIndexKey(doc)
+	if err != nil {
+		return nil, err
+	}
+	return newIndexEntries([]core.IndexDataStoreKey{key}, doc), nil
+}
+
+func (index *collectionUniqueIndex) getDocumentsEntries(doc *client.Document) ([]indexEntry, error) {
+	key, val, err := index.getDocumentsUniqueIndexRecord(doc)
+	if err != nil {
+		return nil, err
+	}
+	return []indexEntry{{key: key, value: val, docID: doc.ID().String()}}, nil
+}
+
+func (index *collectionArrayIndex) getDocumentsEntries(doc *client.Document) ([]indexEntry, error) {
+	keys, err := index.getAllKeys(doc, true)
+	if err != nil {
+		return nil, err
+	}
+	return newIndexEntries(keys, doc), nil
+}
+
+func (index *collectionArrayUniqueIndex) getDocumentsEntries(doc *client.Document) ([]indexEntry, error) {
+	keys, err := index.getAllKeys(doc, false)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]indexEntry, len(keys))
+	for i := range keys {
+		key, val, err := makeUniqueKeyValueRecord(keys[i], doc)
+		if err != nil {
+			return nil, err
+		}
+		entries[i] = indexEntry{key: key, value: val, docID: doc.ID().String()}
+	}
+	return entries, nil
+}
+
+func (index *collectionFullTextIndex) getDocumentsEntries(doc *client.Document) ([]indexEntry, error) {
+	keys, postings, err := index.getDocumentsPostings(doc)
+	if err != nil {
+		return nil, err
+	}
+	entries := newIndexEntries(keys, doc)
+	for i := range entries {
+		entries[i].value = postings[i].Bytes()
+	}
+	return entries, nil
+}
+
+func (index *collectionGeoHashIndex) getDocumentsEntries(doc *client.Document) ([]indexEntry, error) {
+	keys, err := index.getDocumentsIndexKeys(doc)
+	if err != nil {
+		return nil, err
+	}
+	return newIndexEntries(keys, doc), nil
+}
+
+// getDocumentsEntries returns the entries of the document if it matches the filter of the index.
+func (index *collectionPartialIndex) getDocumentsEntries(doc *client.Document) ([]indexEntry, error) {
+	verifiable, ok := index.CollectionIndex.(verifiableIndex)
+	if !ok {
+		return nil, NewErrCanNotVerifyIndexType(index.Name(), index.Description().Type)
+	}
+	isMatch, err := index.matches(doc)
+	if err != nil || !isMatch {
+		return nil, err
+	}
+	return verifiable.getDocumentsEntries(doc)
+}
+
+// VerifyIndexes cross-checks the entries of the indexes of the collection against its documents.
+//
+// If the options have `Repair` set, the missing entries are stored and the stale and orphaned
+// entries are removed within the same transaction.
+//
+// Indexes that can not be verified, because of their type or because they are still building,
+// are reported as skipped along with the reason, unless they are requested by name, in which
+// case an error is returned.
+func (c *collection) VerifyIndexes(
+	ctx context.Context,
+	opts client.IndexVerifyOptions,
+) ([]client.IndexVerifyResult, error) {
+	ctx, txn, err := ensureContextTxn(ctx, c.db, !opts.Repair)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Discard(ctx)
+
+	err = c.loadIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]client.IndexVerifyResult, 0, len(c.indexes))
+	for _, index := range c.indexes {
+		if opts.IndexName != "" && index.Name() != opts.IndexName {
+			continue
+		}
+		if err := checkIndexIsVerifiable(index); err != nil {
+			if opts.IndexName != "" {
+				return nil, err
+			}
+			results = append(results, client.IndexVerifyResult{
+				IndexName:  index.Name(),
+				Skipped:    true,
+				SkipReason: err.Error(),
+			})
+			continue
+		}
+		result, err := c.verifyIndex(ctx, index, opts.Repair)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	if opts.IndexName != "" && len(results) == 0 {
+		return nil, NewErrIndexWithNameDoesNotExists(opts.IndexName)
+	}
+	return results, txn.Commit(ctx)
+}
+
+// checkIndexIsVerifiable returns an error if the entries of the given index can not be verified.
+func checkIndexIsVerifiable(index CollectionIndex) error {
+	desc := index.Description()
+	if desc.IsBuilding() {
+		return NewErrCanNotVerifyBuildingIndex(desc.Name)
+	}
+	if _, ok := index.(verifiableIndex); !ok {
+		return NewErrCanNotVerifyIndexType(desc.Name, desc.Type)
+	}
+	return nil
+}
+
+// verifyIndex compares the entries stored by the given index with the entries computed from
+// the documents of the collection, and fixes the differences if repair is true.
+//
+// An entry that is stored but not expected is stale if its document exists and orphaned
+// otherwise. An expected entry that is not stored is missing.
+func (c *collection) verifyIndex(
+	ctx context.Context,
+	index CollectionIndex,
+	repair bool,
+) (client.IndexVerifyResult, error) {
+	desc := index.Description()
+	verifiable := index.(verifiableIndex)
+
+	result := client.IndexVerifyResult{IndexName: desc.Name}
+	docIDs := make(map[string]struct{})
+	// the expected entries by their datastore key
+	expected := make(map[string]indexEntry)
+	err := c.iterateAllDocs(ctx, c.getIndexedFields(desc), func(doc *client.Document) error {
+		result.DocsChecked++
+		docIDs[doc.ID().String()] = struct{}{}
+		entries, err := verifiable.getDocumentsEntries(doc)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			key := entry.key.ToDS().String()
+			if other, ok := expected[key]; ok && !bytes.Equal(other.value, entry.value) {
+				// the documents violate the unique index, this can not be repaired
+				return NewErrCanNotIndexNonUniqueFields(entry.docID)
+			}
+			expected[key] = entry
+		}
+		return nil
+	})
+	if err != nil {
+		return client.IndexVerifyResult{}, err
+	}
+
+	keyFields := make([]client.FieldDefinition, 0, len(desc.Fields))
+	for _, field := range desc.Fields {
+		colField, ok := c.Definition().GetFieldByName(field.FieldName())
+		if ok {
+			keyFields = append(keyFields, colField)
+		}
+	}
+
+	txn := mustGetContextTxn(ctx)
+	prefix := core.IndexDataStoreKey{CollectionID: c.ID(), IndexID: desc.ID}
+	q, err := txn.Datastore().Query(ctx, query.Query{Prefix: prefix.ToString()})
+	if err != nil {
+		return client.IndexVerifyResult{}, err
+	}
+	var missing, stale, orphaned []string
+	var unexpectedKeys []string
+	mismatchedKeys := make(map[string]struct{})
+	for res := range q.Next() {
+		if res.Error != nil {
+			return client.IndexVerifyResult{}, errors.Join(res.Error, q.Close())
+		}
+		entry, isExpected := expected[res.Key]
+		if isExpected {
+			delete(expected, res.Key)
+			if bytes.Equal(entry.value, res.Value) {
+				continue
+			}
+		}
+		docID, err := getIndexEntryDocID(res, &desc, keyFields)
+		if err != nil {
+			return client.IndexVerifyResult{}, errors.Join(err, q.Close())
+		}
+		if _, ok := docIDs[docID]; ok {
+			stale = append(stale, docID)
+		} else {
+			orphaned = append(orphaned, docID)
+		}
+		if !isExpected {
+			unexpectedKeys = append(unexpectedKeys, res.Key)
+			continue
+		}
+		if entry.docID != docID {
+			// the entry of another document is stored in place of this one
+			missing = append(missing, entry.docID)
+		}
+		// the stored value is overwritten by the expected one when repairing
+		expected[res.Key] = entry
+		mismatchedKeys[res.Key] = struct{}{}
+	}
+	if err := q.Close(); err != nil {
+		return client.IndexVerifyResult{}, err
+	}
+	// the expected entries stored with the expected value have been removed, the ones left
+	// are either not stored or stored with a different value
+	for key, entry := range expected {
+		if _, ok := mismatchedKeys[key]; !ok {
+			missing = append(missing, entry.docID)
+		}
+	}
+
+	result.Missing = sortedUnique(missing)
+	result.Stale = sortedUnique(stale)
+	result.Orphaned = sortedUnique(orphaned)
+	if !repair || result.IsConsistent() {
+		return result, nil
+	}
+
+	for _, key := range unexpectedKeys {
+		err := txn.Datastore().Delete(ctx, ds.NewKey(key))
+		if err != nil {
+			return client.IndexVerifyResult{}, NewCanNotDeleteIndexedField(err)
+		}
+	}
+	for _, entry := range expected {
+		err := txn.Datastore().Put(ctx, entry.key.ToDS(), entry.value)
+		if err != nil {
+			return client.IndexVerifyResult{}, NewErrFailedToStoreIndexedField(entry.key.ToString(), err)
+		}
+	}
+	result.Repaired = true
+	return result, nil
+}
+
+// getIndexEntryDocID returns the ID of the document the given stored entry of an index belongs to.
+//
+// The docID is the last field of the key if it has more fields than the index, which is the case
+// of all the entries except those of unique indexes without nil values, where it is the value.
+func getIndexEntryDocID(
+	res query.Result,
+	desc *client.IndexDescription,
+	keyFields []client.FieldDefinition,
+) (string, error) {
+	key, err := core.DecodeIndexDataStoreKey([]byte(res.Key), desc, keyFields)
+	if err != nil {
+		return "", err
+	}
+	if len(key.Fields) <= len(desc.Fields) {
+		return string(res.Value), nil
+	}
+	docID, _ := key.Fields[len(key.Fields)-1].Value.String()
+	return docID, nil
+}
+
+// sortedUnique returns the given values sorted and without duplicates.
+func sortedUnique(values []string) []string {
+	slices.Sort(values)
+	return slices.Compact(values)
+}
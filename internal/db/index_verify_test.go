@@ -0,0 +1,163 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/core"
+)
+
+func (f *indexTestFixture) verifyIndexes(opts client.IndexVerifyOptions) []client.IndexVerifyResult {
+	results, err := f.users.VerifyIndexes(f.ctx, opts)
+	require.NoError(f.t, err)
+	return results
+}
+
+func (f *indexTestFixture) newUsersNameIndexKey(indexID uint32, name string, docID string) core.IndexDataStoreKey {
+	return core.NewIndexDataStoreKey(f.users.ID(), indexID, []core.IndexedField{
+		{Value: client.NewNormalString(name)},
+		{Value: client.NewNormalString(docID)},
+	})
+}
+
+func TestVerifyIndexes_IfIndexIsConsistent_ShouldReportNothing(t *testing.T) {
+	f := newIndexTestFixture(t)
+	defer f.db.Close()
+
+	f.createUserCollectionIndexOnName()
+	f.saveDocToCollection(f.newUserDoc("John", 21, f.users), f.users)
+	f.saveDocToCollection(f.newUserDoc("Islam", 18, f.users), f.users)
+
+	results := f.verifyIndexes(client.IndexVerifyOptions{})
+	assert.Equal(t, []client.IndexVerifyResult{
+		{IndexName: testUsersColIndexName, DocsChecked: 2},
+	}, results)
+}
+
+func TestVerifyIndexes_WithInconsistentEntries_ShouldReportAndRepairThem(t *testing.T) {
+	f := newIndexTestFixture(t)
+	defer f.db.Close()
+
+	desc := f.createUserCollectionIndexOnName()
+	john := f.newUserDoc("John", 21, f.users)
+	f.saveDocToCollection(john, f.users)
+	islam := f.newUserDoc("Islam", 18, f.users)
+	f.saveDocToCollection(islam, f.users)
+	// not saved to the collection
+	andy := f.newUserDoc("Andy", 30, f.users)
+
+	islamKey := newIndexKeyBuilder(f).Col(usersColName).Fields(usersNameFieldName).Doc(islam).Build()
+	err := f.txn.Datastore().Delete(f.ctx, islamKey.ToDS())
+	require.NoError(t, err)
+	staleKey := f.newUsersNameIndexKey(desc.ID, "Johnny", john.ID().String())
+	err = f.txn.Datastore().Put(f.ctx, staleKey.ToDS(), []byte{})
+	require.NoError(t, err)
+	orphanedKey := f.newUsersNameIndexKey(desc.ID, "Andy", andy.ID().String())
+	err = f.txn.Datastore().Put(f.ctx, orphanedKey.ToDS(), []byte{})
+	require.NoError(t, err)
+	f.commitTxn()
+
+	expected := client.IndexVerifyResult{
+		IndexName:   testUsersColIndexName,
+		DocsChecked: 2,
+		Missing:     []string{islam.ID().String()},
+		Stale:       []string{john.ID().String()},
+		Orphaned:    []string{andy.ID().String()},
+	}
+	results := f.verifyIndexes(client.IndexVerifyOptions{})
+	assert.Equal(t, []client.IndexVerifyResult{expected}, results)
+
+	expected.Repaired = true
+	results = f.verifyIndexes(client.IndexVerifyOptions{IndexName: testUsersColIndexName, Repair: true})
+	assert.Equal(t, []client.IndexVerifyResult{expected}, results)
+
+	results = f.verifyIndexes(client.IndexVerifyOptions{})
+	assert.Equal(t, []client.IndexVerifyResult{
+		{IndexName: testUsersColIndexName, DocsChecked: 2},
+	}, results)
+
+	f.commitTxn()
+	for _, key := range []core.IndexDataStoreKey{staleKey, orphanedKey} {
+		exists, err := f.txn.Datastore().Has(f.ctx, key.ToDS())
+		require.NoError(t, err)
+		assert.False(t, exists, key.ToString())
+	}
+}
+
+func TestVerifyIndexes_IfUniqueEntryPointsToAnotherDoc_ShouldReportAndRepairIt(t *testing.T) {
+	f := newIndexTestFixture(t)
+	defer f.db.Close()
+
+	f.createUserCollectionUniqueIndexOnName()
+	john := f.newUserDoc("John", 21, f.users)
+	f.saveDocToCollection(john, f.users)
+	andy := f.newUserDoc("Andy", 30, f.users)
+
+	key := newIndexKeyBuilder(f).Col(usersColName).Fields(usersNameFieldName).Doc(john).Unique().Build()
+	err := f.txn.Datastore().Put(f.ctx, key.ToDS(), []byte(andy.ID().String()))
+	require.NoError(t, err)
+	f.commitTxn()
+
+	results := f.verifyIndexes(client.IndexVerifyOptions{Repair: true})
+	assert.Equal(t, []client.IndexVerifyResult{
+		{
+			IndexName:   testUsersColIndexName,
+			DocsChecked: 1,
+			Missing:     []string{john.ID().String()},
+			Orphaned:    []string{andy.ID().String()},
+			Repaired:    true,
+		},
+	}, results)
+
+	f.commitTxn()
+	val, err := f.txn.Datastore().Get(f.ctx, key.ToDS())
+	require.NoError(t, err)
+	assert.Equal(t, john.ID().String(), string(val))
+}
+
+func TestVerifyIndexes_IfIndexDoesNotExist_ReturnError(t *testing.T) {
+	f := newIndexTestFixture(t)
+	defer f.db.Close()
+
+	f.createUserCollectionIndexOnName()
+
+	_, err := f.users.VerifyIndexes(f.ctx, client.IndexVerifyOptions{IndexName: "non_existing"})
+	assert.ErrorIs(t, err, NewErrIndexWithNameDoesNotExists("non_existing"))
+}
+
+func TestVerifyIndexes_IfIndexIsBuilding_ShouldSkipItUnlessRequestedByName(t *testing.T) {
+	f := newIndexTestFixture(t)
+	defer f.db.Close()
+
+	f.saveDocToCollection(f.newUserDoc("John", 21, f.users), f.users)
+
+	desc := getUsersIndexDescOnName()
+	desc.Background = true
+	ctx := SetContextTxn(f.ctx, f.txn)
+	_, err := f.db.createCollectionIndex(ctx, f.users.Name().Value(), desc)
+	require.NoError(t, err)
+
+	results, err := f.users.VerifyIndexes(ctx, client.IndexVerifyOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []client.IndexVerifyResult{{
+		IndexName:  testUsersColIndexName,
+		Skipped:    true,
+		SkipReason: NewErrCanNotVerifyBuildingIndex(testUsersColIndexName).Error(),
+	}}, results)
+
+	_, err = f.users.VerifyIndexes(ctx, client.IndexVerifyOptions{IndexName: testUsersColIndexName})
+	assert.ErrorIs(t, err, NewErrCanNotVerifyBuildingIndex(testUsersColIndexName))
+}
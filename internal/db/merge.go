@@ -40,6 +40,7 @@ func (db *db) executeMerge(ctx context.Context, dagMerge event.Merge) error {
 		return err
 	}
 	defer txn.Discard(ctx)
+	ctx = clock.SetContextHLC(ctx, db.hlc)
 
 	col, err := getCollectionFromRootSchema(ctx, db, dagMerge.SchemaRoot)
 	if err != nil {
@@ -189,6 +190,15 @@ func (mp *mergeProcessor) loadComposites(
 		return nil
 	}
 
+	isPruned, err := clock.IsPruned(ctx, mp.txn.Headstore(), blockCid)
+	if err != nil {
+		return err
+	}
+	if isPruned {
+		// The block has been processed before being removed by a DAG compaction.
+		return nil
+	}
+
 	nd, err := mp.blockLS.Load(linking.LinkContext{Ctx: ctx}, cidlink.Link{Cid: blockCid}, coreblock.SchemaPrototype)
 	if err != nil {
 		return err
@@ -214,6 +224,14 @@ func (mp *mergeProcessor) loadComposites(
 		newMT := newMergeTarget()
 		for _, b := range mt.heads {
 			for _, link := range b.Heads {
+				isPruned, err := clock.IsPruned(ctx, mp.txn.Headstore(), link.Cid)
+				if err != nil {
+					return err
+				}
+				if isPruned {
+					continue
+				}
+
 				nd, err := mp.blockLS.Load(linking.LinkContext{Ctx: ctx}, link, coreblock.SchemaPrototype)
 				if err != nil {
 					return err
@@ -355,12 +373,27 @@ func (mp *mergeProcessor) processEncryptedBlock(
 	return dagBlock, true, nil
 }
 
+// verifyBlockSignature returns an error if the block signature is invalid, or if the block is not
+// signed and the db requires signed blocks.
+func (mp *mergeProcessor) verifyBlockSignature(dagBlock *coreblock.Block) error {
+	err := dagBlock.VerifySignature()
+	if errors.Is(err, coreblock.ErrBlockNotSigned) && !mp.col.db.requireSignedBlocks {
+		return nil
+	}
+	return err
+}
+
 // processBlock merges the block and its children to the datastore and sets the head accordingly.
 func (mp *mergeProcessor) processBlock(
 	ctx context.Context,
 	dagBlock *coreblock.Block,
 	blockLink cidlink.Link,
 ) error {
+	err := mp.verifyBlockSignature(dagBlock)
+	if err != nil {
+		return err
+	}
+
 	block, canRead, err := mp.processEncryptedBlock(ctx, dagBlock)
 	if err != nil {
 		return err
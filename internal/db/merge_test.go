@@ -15,12 +15,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"github.com/ipfs/go-cid"
 	"github.com/ipld/go-ipld-prime"
 	"github.com/ipld/go-ipld-prime/linking"
 	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/sourcenetwork/immutable"
 	"github.com/stretchr/testify/require"
 
+	acpIdentity "github.com/sourcenetwork/defradb/acp/identity"
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/event"
 	coreblock "github.com/sourcenetwork/defradb/internal/core/block"
@@ -201,10 +204,152 @@ func TestMerge_DualBranchWithOneIncomplete_CouldNotFindCID(t *testing.T) {
 	require.Equal(t, expectedDocMap, docMap)
 }
 
+func TestMerge_WithSignedBlocks_NoError(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := newDefraMemoryDB(ctx)
+	require.NoError(t, err)
+	db.requireSignedBlocks = true
+
+	_, err = db.AddSchema(ctx, userSchema)
+	require.NoError(t, err)
+
+	col, err := db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+
+	lsys := cidlink.DefaultLinkSystem()
+	lsys.SetWriteStorage(db.multistore.Blockstore().AsIPLDStorage())
+
+	initialDocState := map[string]any{
+		"name": "John",
+	}
+	d, docID := newDagBuilder(col, initialDocState)
+	d.signer = immutable.Some(newTestIdentity(t))
+	compInfo, err := d.generateCompositeUpdate(&lsys, initialDocState, compositeInfo{})
+	require.NoError(t, err)
+
+	err = db.executeMerge(ctx, event.Merge{
+		DocID:      docID.String(),
+		Cid:        compInfo.link.Cid,
+		SchemaRoot: col.SchemaRoot(),
+	})
+	require.NoError(t, err)
+
+	doc, err := col.Get(ctx, docID, false)
+	require.NoError(t, err)
+	name, err := doc.Get("name")
+	require.NoError(t, err)
+	require.Equal(t, "John", name)
+}
+
+func TestMerge_WithUnsignedBlocksAndRequireSignedBlocks_Error(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := newDefraMemoryDB(ctx)
+	require.NoError(t, err)
+	db.requireSignedBlocks = true
+
+	_, err = db.AddSchema(ctx, userSchema)
+	require.NoError(t, err)
+
+	col, err := db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+
+	lsys := cidlink.DefaultLinkSystem()
+	lsys.SetWriteStorage(db.multistore.Blockstore().AsIPLDStorage())
+
+	initialDocState := map[string]any{
+		"name": "John",
+	}
+	d, docID := newDagBuilder(col, initialDocState)
+	compInfo, err := d.generateCompositeUpdate(&lsys, initialDocState, compositeInfo{})
+	require.NoError(t, err)
+
+	err = db.executeMerge(ctx, event.Merge{
+		DocID:      docID.String(),
+		Cid:        compInfo.link.Cid,
+		SchemaRoot: col.SchemaRoot(),
+	})
+	require.ErrorIs(t, err, coreblock.ErrBlockNotSigned)
+
+	_, err = col.Get(ctx, docID, false)
+	require.ErrorIs(t, err, client.ErrDocumentNotFoundOrNotAuthorized)
+}
+
+func TestMerge_WithTamperedSignedBlock_Error(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := newDefraMemoryDB(ctx)
+	require.NoError(t, err)
+
+	_, err = db.AddSchema(ctx, userSchema)
+	require.NoError(t, err)
+
+	col, err := db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+
+	lsys := cidlink.DefaultLinkSystem()
+	lsys.SetWriteStorage(db.multistore.Blockstore().AsIPLDStorage())
+
+	_, docID := newDagBuilder(col, map[string]any{"name": "John"})
+
+	fieldBlock := coreblock.New(
+		&crdt.LWWRegDelta{
+			DocID:           []byte(docID.String()),
+			FieldName:       "name",
+			Priority:        1,
+			SchemaVersionID: col.Schema().VersionID,
+			Data:            encodeValue("John"),
+		},
+		nil,
+	)
+	err = fieldBlock.Sign(newTestIdentity(t))
+	require.NoError(t, err)
+	// Change the value after the block has been signed.
+	fieldBlock.Delta.SetData(encodeValue("Islam"))
+	fieldBlockLink, err := lsys.Store(ipld.LinkContext{}, coreblock.GetLinkPrototype(), fieldBlock.GenerateNode())
+	require.NoError(t, err)
+
+	compositeBlock := coreblock.New(
+		&crdt.CompositeDAGDelta{
+			DocID:           []byte(docID.String()),
+			Priority:        1,
+			SchemaVersionID: col.Schema().VersionID,
+			Status:          1,
+		},
+		[]coreblock.DAGLink{coreblock.NewDAGLink("name", fieldBlockLink.(cidlink.Link))},
+	)
+	compositeBlockLink, err := lsys.Store(ipld.LinkContext{}, coreblock.GetLinkPrototype(), compositeBlock.GenerateNode())
+	require.NoError(t, err)
+
+	err = db.executeMerge(ctx, event.Merge{
+		DocID:      docID.String(),
+		Cid:        compositeBlockLink.(cidlink.Link).Cid,
+		SchemaRoot: col.SchemaRoot(),
+	})
+	require.ErrorIs(t, err, coreblock.ErrInvalidBlockSignature)
+}
+
+func newTestIdentity(t *testing.T) acpIdentity.Identity {
+	privateKey, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	identity, err := acpIdentity.FromPrivateKey(
+		privateKey,
+		time.Hour,
+		immutable.None[string](),
+		immutable.None[string](),
+		true,
+	)
+	require.NoError(t, err)
+	return identity
+}
+
 type dagBuilder struct {
 	fieldsHeight map[string]uint64
 	docID        []byte
 	col          client.Collection
+	// signer is the identity that signs the generated blocks if set.
+	signer immutable.Option[acpIdentity.Identity]
 }
 
 func newDagBuilder(col client.Collection, initalDocState map[string]any) (*dagBuilder, client.DocID) {
@@ -249,6 +394,12 @@ func (d *dagBuilder) generateCompositeUpdate(lsys *linking.LinkSystem, fields ma
 				},
 			},
 		}
+		if d.signer.HasValue() {
+			err := fieldBlock.Sign(d.signer.Value())
+			if err != nil {
+				return compositeInfo{}, err
+			}
+		}
 		fieldBlockLink, err := lsys.Store(ipld.LinkContext{}, coreblock.GetLinkPrototype(), fieldBlock.GenerateNode())
 		if err != nil {
 			return compositeInfo{}, err
@@ -270,6 +421,13 @@ func (d *dagBuilder) generateCompositeUpdate(lsys *linking.LinkSystem, fields ma
 		heads...,
 	)
 
+	if d.signer.HasValue() {
+		err := compositeBlock.Sign(d.signer.Value())
+		if err != nil {
+			return compositeInfo{}, err
+		}
+	}
+
 	compositeBlockLink, err := lsys.Store(ipld.LinkContext{}, coreblock.GetLinkPrototype(), compositeBlock.GenerateNode())
 	if err != nil {
 		return compositeInfo{}, err
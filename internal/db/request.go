@@ -14,6 +14,7 @@ import (
 	"context"
 
 	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/merkle/clock"
 	"github.com/sourcenetwork/defradb/internal/planner"
 )
 
@@ -48,6 +49,8 @@ func (db *db) execRequest(ctx context.Context, request string, options *client.G
 
 	txn := mustGetContextTxn(ctx)
 	identity := GetContextIdentity(ctx)
+	// the clock is required by the queries of documents at a point in time
+	ctx = clock.SetContextHLC(ctx, db.hlc)
 	planner := planner.New(ctx, identity, db.acp, db, txn)
 
 	results, err := planner.RunRequest(ctx, parsedRequest)
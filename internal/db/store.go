@@ -279,6 +279,28 @@ func (db *db) BasicImport(ctx context.Context, filepath string) error {
 	return txn.Commit(ctx)
 }
 
+// CompactDAG squashes the history of the documents that is older than the configured height or
+// age into snapshots, and removes the squashed blocks from the blockstore.
+func (db *db) CompactDAG(ctx context.Context, config client.CompactionConfig) (client.CompactionResult, error) {
+	ctx, txn, err := ensureContextTxn(ctx, db, false)
+	if err != nil {
+		return client.CompactionResult{}, err
+	}
+	defer txn.Discard(ctx)
+
+	result, err := db.compactDAG(ctx, config)
+	if err != nil {
+		return client.CompactionResult{}, err
+	}
+
+	err = txn.Commit(ctx)
+	if err != nil {
+		return client.CompactionResult{}, err
+	}
+
+	return result, nil
+}
+
 // BasicExport exports the current data or subset of data to file in json format.
 func (db *db) BasicExport(ctx context.Context, config *client.BackupConfig) error {
 	ctx, txn, err := ensureContextTxn(ctx, db, true)
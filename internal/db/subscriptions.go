@@ -16,6 +16,7 @@ import (
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/event"
+	"github.com/sourcenetwork/defradb/internal/merkle/clock"
 	"github.com/sourcenetwork/defradb/internal/planner"
 )
 
@@ -65,6 +66,7 @@ func (db *db) handleSubscription(ctx context.Context, r *request.Request) (<-cha
 			}
 
 			ctx := SetContextTxn(ctx, txn)
+			ctx = clock.SetContextHLC(ctx, db.hlc)
 			identity := GetContextIdentity(ctx)
 
 			p := planner.New(ctx, identity, db.acp, db, txn)
@@ -24,6 +24,7 @@ import (
 	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/internal/core"
 	"github.com/sourcenetwork/defradb/internal/db/description"
+	"github.com/sourcenetwork/defradb/internal/merkle/clock"
 	"github.com/sourcenetwork/defradb/internal/planner"
 )
 
@@ -145,6 +146,7 @@ func (db *db) getViews(ctx context.Context, opts client.CollectionFetchOptions)
 func (db *db) buildViewCache(ctx context.Context, col client.CollectionDefinition) (err error) {
 	txn := mustGetContextTxn(ctx)
 	identity := GetContextIdentity(ctx)
+	ctx = clock.SetContextHLC(ctx, db.hlc)
 
 	p := planner.New(ctx, identity, db.acp, db, txn)
 
@@ -11,8 +11,10 @@
 package encoding
 
 import (
+	"bytes"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -146,3 +148,105 @@ func TestDecodeInvalidFieldValue(t *testing.T) {
 		}
 	}
 }
+
+func TestEncodeFieldValue_ShouldPreserveOrder(t *testing.T) {
+	newNil := func(kind client.FieldKind) client.NormalValue {
+		val, err := client.NewNormalNil(kind)
+		require.NoError(t, err)
+		return val
+	}
+
+	tests := []struct {
+		name string
+		// values are in ascending order
+		values []client.NormalValue
+	}{
+		{
+			name: "bool",
+			values: []client.NormalValue{
+				newNil(client.FieldKind_NILLABLE_BOOL),
+				client.NewNormalBool(false),
+				client.NewNormalBool(true),
+			},
+		},
+		{
+			name: "int",
+			values: []client.NormalValue{
+				newNil(client.FieldKind_NILLABLE_INT),
+				client.NewNormalInt(-1 << 40),
+				client.NewNormalInt(-300),
+				client.NewNormalInt(-1),
+				client.NewNormalInt(0),
+				client.NewNormalInt(1),
+				client.NewNormalInt(109),
+				client.NewNormalInt(110),
+				client.NewNormalInt(300),
+				client.NewNormalInt(1 << 40),
+			},
+		},
+		{
+			name: "float",
+			values: []client.NormalValue{
+				newNil(client.FieldKind_NILLABLE_FLOAT),
+				client.NewNormalFloat(-1e10),
+				client.NewNormalFloat(-1.5),
+				client.NewNormalFloat(-1e-10),
+				client.NewNormalFloat(0),
+				client.NewNormalFloat(1e-10),
+				client.NewNormalFloat(1.5),
+				client.NewNormalFloat(1e10),
+			},
+		},
+		{
+			name: "string",
+			values: []client.NormalValue{
+				newNil(client.FieldKind_NILLABLE_STRING),
+				client.NewNormalString(""),
+				client.NewNormalString("\x00"),
+				client.NewNormalString("\x00\x00"),
+				client.NewNormalString("a"),
+				client.NewNormalString("a\x00"),
+				client.NewNormalString("ab"),
+				client.NewNormalString("b"),
+				client.NewNormalString("\xff"),
+			},
+		},
+		{
+			name: "time",
+			values: []client.NormalValue{
+				newNil(client.FieldKind_NILLABLE_DATETIME),
+				client.NewNormalTime(time.Date(1960, 1, 1, 0, 0, 0, 0, time.UTC)),
+				client.NewNormalTime(time.Date(1960, 1, 1, 0, 0, 0, 1, time.UTC)),
+				client.NewNormalTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				client.NewNormalTime(time.Date(2024, 1, 1, 0, 0, 0, 999, time.UTC)),
+				client.NewNormalTime(time.Date(2024, 1, 1, 0, 0, 1, 0, time.UTC)),
+				// the time zone is not part of the encoding, the absolute time is compared
+				client.NewNormalTime(time.Date(2024, 1, 1, 3, 0, 0, 0, time.FixedZone("", 3600*2))),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 1; i < len(tt.values); i++ {
+				for _, descending := range []bool{false, true} {
+					prev := EncodeFieldValue(nil, tt.values[i-1], descending)
+					next := EncodeFieldValue(nil, tt.values[i], descending)
+					// the order must hold for the whole keys of composite indexes, in which
+					// the value is followed by a separator and the next values
+					prevKey := append(prev, '/', 0xff, 0xff)
+					nextKey := append(next, '/', 0x00)
+
+					expected := -1
+					if descending {
+						expected = 1
+						prevKey = append(prev, '/', 0x00)
+						nextKey = append(next, '/', 0xff, 0xff)
+					}
+					assert.Equal(t, expected, bytes.Compare(prevKey, nextKey),
+						"%v, %v, descending: %v", tt.values[i-1], tt.values[i], descending)
+				}
+			}
+		})
+	}
+}
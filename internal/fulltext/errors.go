@@ -0,0 +1,29 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package fulltext
+
+import (
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+const (
+	errInvalidPosting string = "invalid full-text index posting"
+)
+
+var (
+	ErrInvalidPosting = errors.New(errInvalidPosting)
+)
+
+// NewErrInvalidPosting returns a new error indicating that a full-text index posting
+// could not be decoded.
+func NewErrInvalidPosting(inner error) error {
+	return errors.Wrap(errInvalidPosting, inner)
+}
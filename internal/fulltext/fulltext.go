@@ -0,0 +1,127 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+/*
+Package fulltext provides the text analysis shared by the full-text indexes and the `_search`
+filter operator.
+*/
+package fulltext
+
+import (
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/sourcenetwork/defradb/internal/encoding"
+)
+
+// Tokenize returns the terms of the given text.
+//
+// Terms are the runs of letters and digits of the text, converted to lower case.
+func Tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// QueryTerms returns the distinct terms of the given search query.
+func QueryTerms(query string) []string {
+	terms := Tokenize(query)
+	seen := make(map[string]struct{}, len(terms))
+	result := make([]string, 0, len(terms))
+	for _, term := range terms {
+		if _, ok := seen[term]; ok {
+			continue
+		}
+		seen[term] = struct{}{}
+		result = append(result, term)
+	}
+	return result
+}
+
+// Frequencies returns the number of occurrences of every term of the given text, along with
+// the total number of terms of the text.
+func Frequencies(text string) (map[string]uint64, uint64) {
+	terms := Tokenize(text)
+	frequencies := make(map[string]uint64, len(terms))
+	for _, term := range terms {
+		frequencies[term]++
+	}
+	return frequencies, uint64(len(terms))
+}
+
+// Match returns true if the given text contains at least one of the terms of the search query.
+func Match(text, query string) bool {
+	frequencies, _ := Frequencies(text)
+	for _, term := range QueryTerms(query) {
+		if _, ok := frequencies[term]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Posting records the occurrences of a term in a document.
+type Posting struct {
+	// Frequency is the number of occurrences of the term in the document.
+	Frequency uint64
+	// Length is the total number of terms of the document.
+	Length uint64
+}
+
+// Bytes returns the encoded posting.
+func (p Posting) Bytes() []byte {
+	b := encoding.EncodeUvarintAscending(nil, p.Frequency)
+	return encoding.EncodeUvarintAscending(b, p.Length)
+}
+
+// DecodePosting decodes a posting from the given bytes.
+func DecodePosting(b []byte) (Posting, error) {
+	b, frequency, err := encoding.DecodeUvarintAscending(b)
+	if err != nil {
+		return Posting{}, NewErrInvalidPosting(err)
+	}
+	_, length, err := encoding.DecodeUvarintAscending(b)
+	if err != nil {
+		return Posting{}, NewErrInvalidPosting(err)
+	}
+	return Posting{Frequency: frequency, Length: length}, nil
+}
+
+// Score returns the relevance score of every document given the postings of the query terms,
+// which are mapped by term and then by document.
+//
+// The score of a document is the sum, for each query term it contains, of the frequency of
+// the term relative to the length of the document, weighted by the rarity of the term
+// amongst the matched documents. Documents that contain more of the query terms, and rarer
+// terms, are ranked higher.
+func Score(postings map[string]map[string]Posting) map[string]float64 {
+	scores := make(map[string]float64)
+	for _, termPostings := range postings {
+		for docID := range termPostings {
+			scores[docID] = 0
+		}
+	}
+
+	matched := float64(len(scores))
+	for _, termPostings := range postings {
+		if len(termPostings) == 0 {
+			continue
+		}
+		idf := math.Log(1 + matched/float64(len(termPostings)))
+		for docID, posting := range termPostings {
+			if posting.Length == 0 {
+				continue
+			}
+			scores[docID] += float64(posting.Frequency) / float64(posting.Length) * idf
+		}
+	}
+	return scores
+}
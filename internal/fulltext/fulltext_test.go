@@ -0,0 +1,69 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package fulltext
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenize(t *testing.T) {
+	terms := Tokenize("Red running-shoes, size 42!")
+	assert.Equal(t, []string{"red", "running", "shoes", "size", "42"}, terms)
+}
+
+func TestQueryTerms_RemovesDuplicates(t *testing.T) {
+	terms := QueryTerms("red Shoes RED")
+	assert.Equal(t, []string{"red", "shoes"}, terms)
+}
+
+func TestFrequencies(t *testing.T) {
+	frequencies, length := Frequencies("the red shoes and the blue shoes")
+	assert.Equal(t, uint64(7), length)
+	assert.Equal(t, uint64(2), frequencies["shoes"])
+	assert.Equal(t, uint64(1), frequencies["red"])
+}
+
+func TestMatch(t *testing.T) {
+	assert.True(t, Match("Red running shoes", "blue shoes"))
+	assert.False(t, Match("Red running shoes", "shoe"))
+	assert.False(t, Match("Red running shoes", ""))
+}
+
+func TestPosting_EncodeDecode(t *testing.T) {
+	posting := Posting{Frequency: 3, Length: 120}
+	decoded, err := DecodePosting(posting.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, posting, decoded)
+}
+
+func TestDecodePosting_WithInvalidBytes_Error(t *testing.T) {
+	_, err := DecodePosting([]byte{0xff})
+	assert.ErrorIs(t, err, ErrInvalidPosting)
+}
+
+func TestScore_RanksRarerAndMoreFrequentTermsHigher(t *testing.T) {
+	scores := Score(map[string]map[string]Posting{
+		"shoes": {
+			"a": {Frequency: 1, Length: 2},
+			"b": {Frequency: 1, Length: 2},
+			"c": {Frequency: 2, Length: 2},
+		},
+		"red": {
+			"a": {Frequency: 1, Length: 2},
+		},
+	})
+	require.Len(t, scores, 3)
+	assert.Greater(t, scores["a"], scores["c"])
+	assert.Greater(t, scores["c"], scores["b"])
+}
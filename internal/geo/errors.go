@@ -0,0 +1,36 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package geo
+
+import (
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+const (
+	errInvalidArea     string = "invalid geo area"
+	errInvalidDistance string = "geo distance must not be negative"
+)
+
+var (
+	ErrInvalidArea     = errors.New(errInvalidArea)
+	ErrInvalidDistance = errors.New(errInvalidDistance)
+)
+
+// NewErrInvalidArea returns a new error indicating that the given value is not a
+// valid description of a geographic area.
+func NewErrInvalidArea(value any) error {
+	return errors.New(errInvalidArea, errors.NewKV("Value", value))
+}
+
+// NewErrInvalidDistance returns a new error indicating that the given distance is invalid.
+func NewErrInvalidDistance(distance float64) error {
+	return errors.New(errInvalidDistance, errors.NewKV("Distance", distance))
+}
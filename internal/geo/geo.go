@@ -0,0 +1,312 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+/*
+Package geo provides the geographic computations shared by the geo point filter operators
+and the geohash indexes.
+*/
+package geo
+
+import (
+	"math"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+// EarthRadius is the mean radius of the Earth in meters.
+const EarthRadius = 6371008.8
+
+const (
+	// DistanceFieldName is the name of the property holding the radius, in meters,
+	// of the `_withinRadius` operator.
+	DistanceFieldName = "distance"
+	// MinLatFieldName is the name of the property holding the southern bound of the `_withinBox` operator.
+	MinLatFieldName = "minLat"
+	// MinLonFieldName is the name of the property holding the western bound of the `_withinBox` operator.
+	MinLonFieldName = "minLon"
+	// MaxLatFieldName is the name of the property holding the northern bound of the `_withinBox` operator.
+	MaxLatFieldName = "maxLat"
+	// MaxLonFieldName is the name of the property holding the eastern bound of the `_withinBox` operator.
+	MaxLonFieldName = "maxLon"
+)
+
+// Area is a geographic area.
+type Area interface {
+	// Contains returns true if the given point is within the area.
+	Contains(p client.GeoPoint) bool
+	// Bounds returns the boxes enclosing the area.
+	Bounds() []Box
+}
+
+// Distance returns the great-circle distance, in meters, between the two given points.
+func Distance(a, b client.GeoPoint) float64 {
+	lat1 := toRadians(a.Lat)
+	lat2 := toRadians(b.Lat)
+	dLat := lat2 - lat1
+	dLon := toRadians(b.Lon - a.Lon)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// Circle is the area within a distance of a center point.
+type Circle struct {
+	Center client.GeoPoint
+	// Radius is the distance from the center in meters.
+	Radius float64
+}
+
+var _ Area = Circle{}
+
+// NewCircle returns the circle described by the given `_withinRadius` condition.
+func NewCircle(condition any) (Circle, error) {
+	props, ok := condition.(map[string]any)
+	if !ok {
+		return Circle{}, NewErrInvalidArea(condition)
+	}
+	lat, err := getCoordinate(props, client.GeoPointLatFieldName)
+	if err != nil {
+		return Circle{}, err
+	}
+	lon, err := getCoordinate(props, client.GeoPointLonFieldName)
+	if err != nil {
+		return Circle{}, err
+	}
+	radius, err := getCoordinate(props, DistanceFieldName)
+	if err != nil {
+		return Circle{}, err
+	}
+	if radius < 0 || math.IsNaN(radius) {
+		return Circle{}, NewErrInvalidDistance(radius)
+	}
+	center, err := client.NewGeoPoint(lat, lon)
+	if err != nil {
+		return Circle{}, err
+	}
+	return Circle{Center: center, Radius: radius}, nil
+}
+
+func (c Circle) Contains(p client.GeoPoint) bool {
+	return Distance(c.Center, p) <= c.Radius
+}
+
+func (c Circle) Bounds() []Box {
+	angle := c.Radius / EarthRadius
+	dLat := toDegrees(angle)
+	minLat := c.Center.Lat - dLat
+	maxLat := c.Center.Lat + dLat
+	if minLat <= -90 || maxLat >= 90 {
+		// the circle contains a pole and so every longitude
+		return []Box{{MinLat: math.Max(minLat, -90), MinLon: -180, MaxLat: math.Min(maxLat, 90), MaxLon: 180}}
+	}
+
+	sinLon := math.Sin(angle) / math.Cos(toRadians(c.Center.Lat))
+	if sinLon >= 1 {
+		return []Box{{MinLat: minLat, MinLon: -180, MaxLat: maxLat, MaxLon: 180}}
+	}
+	dLon := toDegrees(math.Asin(sinLon))
+	box := Box{MinLat: minLat, MinLon: c.Center.Lon - dLon, MaxLat: maxLat, MaxLon: c.Center.Lon + dLon}
+	if box.MinLon < -180 {
+		box.MinLon += 360
+	}
+	if box.MaxLon > 180 {
+		box.MaxLon -= 360
+	}
+	return box.Bounds()
+}
+
+// Box is the area between two latitudes and two longitudes.
+//
+// If MinLon is greater than MaxLon the box crosses the antimeridian.
+type Box struct {
+	MinLat float64
+	MinLon float64
+	MaxLat float64
+	MaxLon float64
+}
+
+var _ Area = Box{}
+
+// NewBox returns the box described by the given `_withinBox` condition.
+func NewBox(condition any) (Box, error) {
+	props, ok := condition.(map[string]any)
+	if !ok {
+		return Box{}, NewErrInvalidArea(condition)
+	}
+	var box Box
+	for name, target := range map[string]*float64{
+		MinLatFieldName: &box.MinLat,
+		MinLonFieldName: &box.MinLon,
+		MaxLatFieldName: &box.MaxLat,
+		MaxLonFieldName: &box.MaxLon,
+	} {
+		value, err := getCoordinate(props, name)
+		if err != nil {
+			return Box{}, err
+		}
+		*target = value
+	}
+	if _, err := client.NewGeoPoint(box.MinLat, box.MinLon); err != nil {
+		return Box{}, err
+	}
+	if _, err := client.NewGeoPoint(box.MaxLat, box.MaxLon); err != nil {
+		return Box{}, err
+	}
+	if box.MinLat > box.MaxLat {
+		return Box{}, NewErrInvalidArea(condition)
+	}
+	return box, nil
+}
+
+func (b Box) Contains(p client.GeoPoint) bool {
+	if p.Lat < b.MinLat || p.Lat > b.MaxLat {
+		return false
+	}
+	if b.MinLon <= b.MaxLon {
+		return p.Lon >= b.MinLon && p.Lon <= b.MaxLon
+	}
+	return p.Lon >= b.MinLon || p.Lon <= b.MaxLon
+}
+
+func (b Box) Bounds() []Box {
+	if b.MinLon <= b.MaxLon {
+		return []Box{b}
+	}
+	// boxes crossing the antimeridian are split in two
+	return []Box{
+		{MinLat: b.MinLat, MinLon: b.MinLon, MaxLat: b.MaxLat, MaxLon: 180},
+		{MinLat: b.MinLat, MinLon: -180, MaxLat: b.MaxLat, MaxLon: b.MaxLon},
+	}
+}
+
+// MaxPrecision is the length of the longest geohashes, whose cells are a few meters wide.
+const MaxPrecision = 9
+
+// base32 is the alphabet of geohashes.
+const base32 = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// GeoHash returns the geohash of the given point with the given number of characters.
+func GeoHash(p client.GeoPoint, precision int) string {
+	return encodeCell(interleave(quantize(p.Lon, 180), quantize(p.Lat, 90)), precision)
+}
+
+// Cover returns the geohashes of the cells covering the bounds of the given area.
+//
+// The cells are all of the same size, the smallest one for which there are at most maxCells of
+// them and whose geohashes are at most [MaxPrecision] long. If even the largest cells are too many,
+// nil is returned.
+func Cover(area Area, maxCells int) []string {
+	bounds := area.Bounds()
+	precision := 0
+	for p := 1; p <= MaxPrecision; p++ {
+		if countCells(bounds, p) > maxCells {
+			break
+		}
+		precision = p
+	}
+	if precision == 0 {
+		return nil
+	}
+
+	lonShift, latShift := cellShifts(precision)
+	var cells []string
+	seen := make(map[string]struct{})
+	for _, box := range bounds {
+		minX, maxX := uint64(quantize(box.MinLon, 180)>>lonShift), uint64(quantize(box.MaxLon, 180)>>lonShift)
+		minY, maxY := uint64(quantize(box.MinLat, 90)>>latShift), uint64(quantize(box.MaxLat, 90)>>latShift)
+		for x := minX; x <= maxX; x++ {
+			for y := minY; y <= maxY; y++ {
+				cell := encodeCell(interleave(uint32(x<<lonShift), uint32(y<<latShift)), precision)
+				if _, ok := seen[cell]; ok {
+					continue
+				}
+				seen[cell] = struct{}{}
+				cells = append(cells, cell)
+			}
+		}
+	}
+	return cells
+}
+
+func countCells(bounds []Box, precision int) int {
+	lonShift, latShift := cellShifts(precision)
+	count := 0
+	for _, box := range bounds {
+		width := int(quantize(box.MaxLon, 180)>>lonShift-quantize(box.MinLon, 180)>>lonShift) + 1
+		height := int(quantize(box.MaxLat, 90)>>latShift-quantize(box.MinLat, 90)>>latShift) + 1
+		count += width * height
+	}
+	return count
+}
+
+// cellShifts returns the number of low bits of the quantized longitude and latitude that are
+// not part of geohashes of the given precision.
+//
+// Each character of a geohash holds 5 bits, starting with a longitude bit.
+func cellShifts(precision int) (int, int) {
+	bits := 5 * precision
+	return 32 - (bits+1)/2, 32 - bits/2
+}
+
+// encodeCell returns the geohash made of the given number of characters of the given hash.
+//
+// The bits of the hash alternate between the longitude and the latitude, starting with the most
+// significant bit of the longitude, so that points sharing a geohash prefix lay in the same cell.
+func encodeCell(hash uint64, precision int) string {
+	cell := make([]byte, precision)
+	for i := range cell {
+		cell[i] = base32[hash>>(64-5*(i+1))&0x1f]
+	}
+	return string(cell)
+}
+
+// quantize maps the given coordinate, within [-bound, bound], to a 32 bits integer.
+func quantize(value float64, bound float64) uint32 {
+	q := (value + bound) / (2 * bound) * (1 << 32)
+	switch {
+	case q <= 0 || math.IsNaN(q):
+		return 0
+	case q >= 1<<32:
+		return math.MaxUint32
+	default:
+		return uint32(q)
+	}
+}
+
+func interleave(x, y uint32) uint64 {
+	var hash uint64
+	for i := 31; i >= 0; i-- {
+		hash = hash<<2 | uint64(x>>i&1)<<1 | uint64(y>>i&1)
+	}
+	return hash
+}
+
+func getCoordinate(props map[string]any, name string) (float64, error) {
+	switch v := props[name].(type) {
+	case float64:
+		return v, nil
+	case int32:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	default:
+		return 0, NewErrInvalidArea(props)
+	}
+}
+
+func toRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func toDegrees(radians float64) float64 {
+	return radians * 180 / math.Pi
+}
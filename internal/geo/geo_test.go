@@ -0,0 +1,84 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package geo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+var (
+	paris  = client.GeoPoint{Lat: 48.8566, Lon: 2.3522}
+	london = client.GeoPoint{Lat: 51.5074, Lon: -0.1278}
+)
+
+func TestDistance(t *testing.T) {
+	assert.InDelta(t, 343_500, Distance(paris, london), 1_000)
+	assert.Equal(t, float64(0), Distance(paris, paris))
+}
+
+func TestNewCircle(t *testing.T) {
+	circle, err := NewCircle(map[string]any{"lat": 48.8566, "lon": 2.3522, "distance": float64(400_000)})
+	require.NoError(t, err)
+	assert.True(t, circle.Contains(london))
+
+	circle.Radius = 300_000
+	assert.False(t, circle.Contains(london))
+}
+
+func TestNewCircle_WithNegativeDistance_ReturnsError(t *testing.T) {
+	_, err := NewCircle(map[string]any{"lat": 48.8566, "lon": 2.3522, "distance": float64(-1)})
+	require.ErrorIs(t, err, ErrInvalidDistance)
+}
+
+func TestNewBox_WithMissingBound_ReturnsError(t *testing.T) {
+	_, err := NewBox(map[string]any{"minLat": float64(0), "minLon": float64(0), "maxLat": float64(1)})
+	require.ErrorIs(t, err, ErrInvalidArea)
+}
+
+func TestBox_CrossingAntimeridian(t *testing.T) {
+	box := Box{MinLat: -10, MinLon: 170, MaxLat: 10, MaxLon: -170}
+	assert.True(t, box.Contains(client.GeoPoint{Lat: 0, Lon: 175}))
+	assert.True(t, box.Contains(client.GeoPoint{Lat: 0, Lon: -175}))
+	assert.False(t, box.Contains(client.GeoPoint{Lat: 0, Lon: 0}))
+	assert.Len(t, box.Bounds(), 2)
+}
+
+func TestGeoHash(t *testing.T) {
+	assert.Equal(t, "u09tvw0f6", GeoHash(paris, MaxPrecision))
+	assert.Equal(t, "gcpvj", GeoHash(london, 5))
+}
+
+func TestCover_ContainsCellsOfPointsWithinArea(t *testing.T) {
+	circle := Circle{Center: paris, Radius: 5_000}
+	cells := Cover(circle, 16)
+	require.NotEmpty(t, cells)
+	require.LessOrEqual(t, len(cells), 16)
+
+	precision := len(cells[0])
+	for _, point := range []client.GeoPoint{
+		paris,
+		{Lat: paris.Lat + 0.04, Lon: paris.Lon},
+		{Lat: paris.Lat, Lon: paris.Lon - 0.06},
+	} {
+		require.True(t, circle.Contains(point))
+		assert.Contains(t, cells, GeoHash(point, precision))
+	}
+}
+
+func TestCover_WithTooManyCells_ReturnsNoCells(t *testing.T) {
+	cells := Cover(Box{MinLat: -90, MinLon: -180, MaxLat: 90, MaxLon: 180}, 16)
+	assert.Empty(t, cells)
+}
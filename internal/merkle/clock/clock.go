@@ -103,6 +103,11 @@ func (mc *MerkleClock) AddDelta(
 	delta.SetPriority(height)
 	block := coreblock.New(delta, links, heads...)
 
+	if hlc := GetContextHLC(ctx); hlc != nil {
+		timestamp := hlc.Now()
+		block.Timestamp = &timestamp
+	}
+
 	fieldName := immutable.None[string]()
 	if block.Delta.GetFieldName() != "" {
 		fieldName = immutable.Some(block.Delta.GetFieldName())
@@ -121,6 +126,14 @@ func (mc *MerkleClock) AddDelta(
 		dagBlock.Encryption = &encLink
 	}
 
+	// The stored block is signed so that peers can verify it before decrypting it.
+	if signer := GetContextSigner(ctx); signer.HasValue() && signer.Value().PrivateKey != nil {
+		err = dagBlock.Sign(signer.Value())
+		if err != nil {
+			return cidlink.Link{}, nil, err
+		}
+	}
+
 	link, err := mc.putBlock(ctx, dagBlock)
 	if err != nil {
 		return cidlink.Link{}, nil, err
@@ -217,7 +230,12 @@ func encryptBlock(
 		return nil, err
 	}
 	clonedCRDT.SetData(bytes)
-	return &coreblock.Block{Delta: clonedCRDT, Heads: block.Heads, Links: block.Links}, nil
+	return &coreblock.Block{
+		Delta:     clonedCRDT,
+		Heads:     block.Heads,
+		Links:     block.Links,
+		Timestamp: block.Timestamp,
+	}, nil
 }
 
 // ProcessBlock merges the delta CRDT and updates the state accordingly.
@@ -231,6 +249,10 @@ func (mc *MerkleClock) ProcessBlock(
 		return NewErrMergingDelta(blockLink.Cid, err)
 	}
 
+	if hlc := GetContextHLC(ctx); hlc != nil && block.Timestamp != nil {
+		hlc.Update(*block.Timestamp)
+	}
+
 	return mc.updateHeads(ctx, block, blockLink)
 }
 
@@ -270,6 +292,13 @@ func (mc *MerkleClock) updateHeads(
 		if err != nil {
 			return NewErrCouldNotFindBlock(linkCid, err)
 		}
+		if !known {
+			// Blocks removed by a DAG compaction are part of the known tree.
+			known, err = IsPruned(ctx, mc.headstore, linkCid)
+			if err != nil {
+				return NewErrCouldNotFindBlock(linkCid, err)
+			}
+		}
 		if known {
 			// we reached a non-head node in the known tree.
 			// This means our root block is a new head
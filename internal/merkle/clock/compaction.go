@@ -0,0 +1,314 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package clock
+
+import (
+	"context"
+
+	"github.com/ipfs/boxo/blockstore"
+	cid "github.com/ipfs/go-cid"
+	ds "github.com/ipfs/go-datastore"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/ipld/go-ipld-prime/linking"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/internal/core"
+	coreblock "github.com/sourcenetwork/defradb/internal/core/block"
+)
+
+// Compaction squashes the part of a document DAG that is older than a boundary.
+//
+// The pruned blocks are removed from the blockstore and the state they hold is kept in a
+// [coreblock.Snapshot]. The newer blocks, along with the current heads of every field, are kept
+// so that new blocks can still be added to the DAG and merged with the blocks of peers that still
+// hold the older blocks.
+//
+// A marker is written to the headstore for every pruned composite block and every pruned block
+// linked by a kept block. Markers let the processes walking the DAG tell pruned blocks apart from
+// blocks that have not been synced yet, and the markers of the blocks linked by kept blocks point
+// to the snapshot.
+type Compaction struct {
+	// Heads are the pruned composite blocks linked by the kept composite blocks.
+	Heads []cid.Cid
+	// Height is the height of the highest pruned composite block.
+	Height uint64
+	// Pruned are all the blocks removed from the blockstore.
+	Pruned []cid.Cid
+
+	// marked are the pruned blocks that get a marker.
+	marked map[cid.Cid]struct{}
+	// frontier are the pruned blocks linked by kept blocks, their markers point to the snapshot.
+	frontier map[cid.Cid]struct{}
+	// previousSnapshots are the snapshots of previous compactions that this one replaces.
+	previousSnapshots map[cid.Cid]struct{}
+}
+
+// NewCompaction returns the compaction of the DAG of the document with the given DocID.
+//
+// The composite blocks for which isCompactable returns true are pruned, unless they are current
+// heads. Blocks older than a compactable block must be compactable too. If no block can be
+// pruned the returned compaction has no heads.
+func NewCompaction(
+	ctx context.Context,
+	headstore datastore.DSReaderWriter,
+	bs datastore.Blockstore,
+	docID string,
+	isCompactable func(*coreblock.Block) bool,
+) (*Compaction, error) {
+	compositeHeads, _, err := NewHeadSet(
+		headstore,
+		core.HeadStoreKey{DocID: docID, FieldID: core.COMPOSITE_NAMESPACE},
+	).List(ctx)
+	if err != nil {
+		return nil, NewErrGettingHeads(err)
+	}
+	allHeads, _, err := NewHeadSet(headstore, core.HeadStoreKey{DocID: docID}).List(ctx)
+	if err != nil {
+		return nil, NewErrGettingHeads(err)
+	}
+
+	compaction := &Compaction{
+		marked:            make(map[cid.Cid]struct{}),
+		frontier:          make(map[cid.Cid]struct{}),
+		previousSnapshots: make(map[cid.Cid]struct{}),
+	}
+
+	kept := make(map[cid.Cid]*coreblock.Block)
+	for _, head := range allHeads {
+		kept[head] = nil
+	}
+
+	// Walk the composite DAG from the heads to find the kept composite blocks, stopping at
+	// the compactable ones.
+	isHead := make(map[cid.Cid]struct{})
+	for _, head := range compositeHeads {
+		isHead[head] = struct{}{}
+	}
+	visited := make(map[cid.Cid]struct{})
+	queue := compositeHeads
+	for len(queue) > 0 {
+		c := queue[0]
+		queue = queue[1:]
+		if _, ok := visited[c]; ok {
+			continue
+		}
+		visited[c] = struct{}{}
+
+		block, err := loadBlock(ctx, bs, c)
+		if err != nil {
+			return nil, err
+		}
+		if block == nil {
+			// The block has been pruned by a previous compaction.
+			continue
+		}
+
+		if _, ok := isHead[c]; !ok && isCompactable(block) {
+			compaction.Heads = append(compaction.Heads, c)
+			if block.Delta.GetPriority() > compaction.Height {
+				compaction.Height = block.Delta.GetPriority()
+			}
+			continue
+		}
+
+		kept[c] = block
+		for _, link := range block.Links {
+			kept[link.Cid] = nil
+		}
+		for _, head := range block.Heads {
+			queue = append(queue, head.Cid)
+		}
+	}
+
+	if len(compaction.Heads) == 0 {
+		return compaction, nil
+	}
+
+	// Walk the DAG from the newest compactable blocks to find every block to prune.
+	pruned := make(map[cid.Cid]struct{})
+	queue = compaction.Heads
+	for len(queue) > 0 {
+		c := queue[0]
+		queue = queue[1:]
+		if _, ok := pruned[c]; ok {
+			continue
+		}
+		if _, ok := kept[c]; ok {
+			continue
+		}
+
+		block, err := loadBlock(ctx, bs, c)
+		if err != nil {
+			return nil, err
+		}
+		if block == nil {
+			// The block has been pruned by a previous compaction, its marker no longer
+			// needs to point to the previous snapshot.
+			err := compaction.addPrevious(ctx, headstore, c)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		pruned[c] = struct{}{}
+		compaction.Pruned = append(compaction.Pruned, c)
+		if block.Delta.IsComposite() {
+			compaction.marked[c] = struct{}{}
+		}
+		for _, link := range block.AllLinks() {
+			queue = append(queue, link.Cid)
+		}
+	}
+
+	// Find the pruned blocks, and the blocks pruned by previous compactions, that are linked
+	// by the kept blocks.
+	for c, block := range kept {
+		if block == nil {
+			block, err = loadBlock(ctx, bs, c)
+			if err != nil {
+				return nil, err
+			}
+			if block == nil {
+				continue
+			}
+		}
+		for _, link := range block.AllLinks() {
+			if _, ok := compaction.frontier[link.Cid]; ok {
+				continue
+			}
+			if _, ok := pruned[link.Cid]; ok {
+				compaction.frontier[link.Cid] = struct{}{}
+				compaction.marked[link.Cid] = struct{}{}
+				continue
+			}
+			if _, ok := kept[link.Cid]; ok {
+				continue
+			}
+			err := compaction.addPrevious(ctx, headstore, link.Cid)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := compaction.marked[link.Cid]; ok {
+				compaction.frontier[link.Cid] = struct{}{}
+			}
+		}
+	}
+
+	return compaction, nil
+}
+
+// addPrevious records the marker of the given block if it has been pruned by a previous
+// compaction, so that it gets rewritten, along with the snapshot it points to so that it
+// gets removed.
+func (c *Compaction) addPrevious(ctx context.Context, headstore ds.Read, blockCid cid.Cid) error {
+	snapshotCid, isPruned, err := PrunedSnapshot(ctx, headstore, blockCid)
+	if err != nil {
+		return err
+	}
+	if !isPruned {
+		return nil
+	}
+	c.marked[blockCid] = struct{}{}
+	if snapshotCid.Defined() {
+		c.previousSnapshots[snapshotCid] = struct{}{}
+	}
+	return nil
+}
+
+// Apply stores the given snapshot, writes the markers of the pruned blocks to the headstore and
+// removes the pruned blocks from the blockstore.
+func (c *Compaction) Apply(
+	ctx context.Context,
+	headstore datastore.DSReaderWriter,
+	bs datastore.Blockstore,
+	snapshot *coreblock.Snapshot,
+) (cid.Cid, error) {
+	lsys := cidlink.DefaultLinkSystem()
+	lsys.SetWriteStorage(bs.AsIPLDStorage())
+	link, err := lsys.Store(linking.LinkContext{Ctx: ctx}, coreblock.GetLinkPrototype(), snapshot.GenerateNode())
+	if err != nil {
+		return cid.Undef, NewErrWritingBlock(err)
+	}
+	snapshotCid := link.(cidlink.Link).Cid
+
+	for blockCid := range c.marked {
+		var value []byte
+		if _, ok := c.frontier[blockCid]; ok {
+			value = snapshotCid.Bytes()
+		}
+		err := headstore.Put(ctx, core.NewPrunedBlockKey(blockCid).ToDS(), value)
+		if err != nil {
+			return cid.Undef, err
+		}
+	}
+
+	for _, blockCid := range c.Pruned {
+		err := bs.DeleteBlock(ctx, blockCid)
+		if err != nil {
+			return cid.Undef, err
+		}
+	}
+
+	for previous := range c.previousSnapshots {
+		if previous == snapshotCid {
+			continue
+		}
+		err := bs.DeleteBlock(ctx, previous)
+		if err != nil {
+			return cid.Undef, err
+		}
+	}
+
+	return snapshotCid, nil
+}
+
+// PrunedSnapshot returns whether the block with the given cid has been pruned by a compaction.
+//
+// If the pruned block is linked by a block that has been kept by the compaction, the cid of the
+// snapshot holding the state of the pruned part of the DAG is also returned.
+func PrunedSnapshot(ctx context.Context, headstore ds.Read, c cid.Cid) (cid.Cid, bool, error) {
+	value, err := headstore.Get(ctx, core.NewPrunedBlockKey(c).ToDS())
+	if errors.Is(err, ds.ErrNotFound) {
+		return cid.Undef, false, nil
+	}
+	if err != nil {
+		return cid.Undef, false, err
+	}
+	if len(value) == 0 {
+		return cid.Undef, true, nil
+	}
+	_, snapshotCid, err := cid.CidFromBytes(value)
+	if err != nil {
+		return cid.Undef, false, err
+	}
+	return snapshotCid, true, nil
+}
+
+// IsPruned returns true if the block with the given cid has been pruned by a compaction.
+func IsPruned(ctx context.Context, headstore ds.Read, c cid.Cid) (bool, error) {
+	_, isPruned, err := PrunedSnapshot(ctx, headstore, c)
+	return isPruned, err
+}
+
+// loadBlock returns the block with the given cid, or nil if it is not in the blockstore.
+func loadBlock(ctx context.Context, bs blockstore.Blockstore, c cid.Cid) (*coreblock.Block, error) {
+	blk, err := bs.Get(ctx, c)
+	if errors.Is(err, ipld.ErrNotFound{}) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, NewErrCouldNotFindBlock(c, err)
+	}
+	return coreblock.GetFromBytes(blk.RawData())
+}
@@ -0,0 +1,153 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package clock
+
+import (
+	"context"
+	"testing"
+
+	cid "github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/internal/core"
+	coreblock "github.com/sourcenetwork/defradb/internal/core/block"
+	"github.com/sourcenetwork/defradb/internal/core/crdt"
+)
+
+const compactionTestDocID = "bae-compaction"
+
+// newCompactionTestDAG creates a document DAG with the given number of versions in the given
+// multistore, and returns the CIDs of its composite and field blocks ordered by height.
+func newCompactionTestDAG(
+	t *testing.T,
+	ctx context.Context,
+	multistore datastore.MultiStore,
+	versions int,
+) ([]cid.Cid, []cid.Cid) {
+	key := core.DataStoreKey{DocID: compactionTestDocID}
+
+	reg := crdt.NewLWWRegister(multistore.Datastore(), core.CollectionSchemaVersionKey{}, key, "name")
+	fieldClock := NewMerkleClock(
+		multistore.Headstore(),
+		multistore.Blockstore(),
+		multistore.Encstore(),
+		core.HeadStoreKey{DocID: compactionTestDocID, FieldID: "1"},
+		reg,
+	)
+	composite := crdt.NewCompositeDAG(multistore.Datastore(), core.CollectionSchemaVersionKey{}, key)
+	compositeClock := NewMerkleClock(
+		multistore.Headstore(),
+		multistore.Blockstore(),
+		multistore.Encstore(),
+		core.HeadStoreKey{DocID: compactionTestDocID, FieldID: core.COMPOSITE_NAMESPACE},
+		composite,
+	)
+
+	var composites, fields []cid.Cid
+	for i := 0; i < versions; i++ {
+		fieldLink, _, err := fieldClock.AddDelta(ctx, reg.Set([]byte{byte(i)}))
+		require.NoError(t, err)
+		compositeLink, _, err := compositeClock.AddDelta(
+			ctx,
+			composite.Set(client.Active),
+			coreblock.DAGLink{Name: "name", Link: fieldLink},
+		)
+		require.NoError(t, err)
+
+		fields = append(fields, fieldLink.Cid)
+		composites = append(composites, compositeLink.Cid)
+	}
+	return composites, fields
+}
+
+func TestCompaction_PrunesBlocksOlderThanBoundary(t *testing.T) {
+	ctx := context.Background()
+	multistore := datastore.MultiStoreFrom(newDS())
+	composites, fields := newCompactionTestDAG(t, ctx, multistore, 4)
+
+	compaction, err := NewCompaction(
+		ctx,
+		multistore.Headstore(),
+		multistore.Blockstore(),
+		compactionTestDocID,
+		func(block *coreblock.Block) bool { return block.Delta.GetPriority() <= 2 },
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, []cid.Cid{composites[1]}, compaction.Heads)
+	require.Equal(t, uint64(2), compaction.Height)
+	require.ElementsMatch(t, []cid.Cid{composites[0], composites[1], fields[0], fields[1]}, compaction.Pruned)
+
+	snapshotCid, err := compaction.Apply(ctx, multistore.Headstore(), multistore.Blockstore(), &coreblock.Snapshot{
+		DocID:  []byte(compactionTestDocID),
+		Height: compaction.Height,
+	})
+	require.NoError(t, err)
+
+	for _, c := range compaction.Pruned {
+		hasBlock, err := multistore.Blockstore().Has(ctx, c)
+		require.NoError(t, err)
+		require.False(t, hasBlock)
+	}
+	for _, c := range []cid.Cid{composites[2], composites[3], fields[2], fields[3], snapshotCid} {
+		hasBlock, err := multistore.Blockstore().Has(ctx, c)
+		require.NoError(t, err)
+		require.True(t, hasBlock)
+	}
+
+	// The pruned blocks linked by the kept blocks point to the snapshot.
+	for _, c := range []cid.Cid{composites[1], fields[1]} {
+		linkedSnapshot, isPruned, err := PrunedSnapshot(ctx, multistore.Headstore(), c)
+		require.NoError(t, err)
+		require.True(t, isPruned)
+		require.Equal(t, snapshotCid, linkedSnapshot)
+	}
+
+	linkedSnapshot, isPruned, err := PrunedSnapshot(ctx, multistore.Headstore(), composites[0])
+	require.NoError(t, err)
+	require.True(t, isPruned)
+	require.False(t, linkedSnapshot.Defined())
+
+	isPruned, err = IsPruned(ctx, multistore.Headstore(), composites[2])
+	require.NoError(t, err)
+	require.False(t, isPruned)
+
+	// The markers are kept out of the blockstore, which only holds content addressed blocks.
+	keys, err := multistore.Blockstore().AllKeysChan(ctx)
+	require.NoError(t, err)
+	for c := range keys {
+		blk, err := multistore.Blockstore().Get(ctx, c)
+		require.NoError(t, err)
+		blkCid, err := c.Prefix().Sum(blk.RawData())
+		require.NoError(t, err)
+		require.True(t, c.Equals(blkCid), c.String())
+	}
+}
+
+func TestCompaction_KeepsHeads(t *testing.T) {
+	ctx := context.Background()
+	multistore := datastore.MultiStoreFrom(newDS())
+	newCompactionTestDAG(t, ctx, multistore, 1)
+
+	compaction, err := NewCompaction(
+		ctx,
+		multistore.Headstore(),
+		multistore.Blockstore(),
+		compactionTestDocID,
+		func(block *coreblock.Block) bool { return true },
+	)
+	require.NoError(t, err)
+
+	require.Empty(t, compaction.Heads)
+	require.Empty(t, compaction.Pruned)
+}
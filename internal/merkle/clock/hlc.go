@@ -0,0 +1,101 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package clock
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// hlcLogicalBits is the number of low bits of a hybrid logical clock timestamp that hold
+// the logical counter. The remaining high bits hold the wall clock time in milliseconds.
+const hlcLogicalBits = 16
+
+// hlcContextKey is the key type for hybrid logical clock context values.
+type hlcContextKey struct{}
+
+// HLC is a hybrid logical clock.
+//
+// The timestamps it returns follow the wall clock time as closely as possible while being
+// greater than every timestamp previously returned or observed by the clock. This keeps the
+// timestamps of the blocks consistent with the causal order of the DAG even when the wall
+// clocks of the peers drift apart.
+//
+// Timestamps are encoded as a single uint64 holding the wall clock time in milliseconds in
+// the high bits and a logical counter in the low bits, so they can be compared as integers.
+type HLC struct {
+	mutex        sync.Mutex
+	last         uint64
+	physicalTime func() time.Time
+}
+
+// NewHLC returns a new hybrid logical clock reading the wall clock time from the given
+// function.
+//
+// If the function is nil, [time.Now] is used.
+func NewHLC(physicalTime func() time.Time) *HLC {
+	if physicalTime == nil {
+		physicalTime = time.Now
+	}
+	return &HLC{physicalTime: physicalTime}
+}
+
+// Now advances the clock for a local event and returns the new timestamp.
+func (c *HLC) Now() uint64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	physical := uint64(c.physicalTime().UnixMilli()) << hlcLogicalBits
+	if physical > c.last {
+		c.last = physical
+	} else {
+		c.last++
+	}
+	return c.last
+}
+
+// Update advances the clock past the given timestamp observed from another event, such as
+// a block received from another peer.
+func (c *HLC) Update(timestamp uint64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if timestamp > c.last {
+		c.last = timestamp
+	}
+}
+
+// HLCTimestampToTime returns the time represented by the given hybrid logical clock timestamp.
+//
+// The logical counter is held in the sub-millisecond part of the returned time so that
+// ordering the times matches ordering the timestamps.
+func HLCTimestampToTime(timestamp uint64) time.Time {
+	millis := int64(timestamp >> hlcLogicalBits)
+	logical := int64(timestamp & (1<<hlcLogicalBits - 1))
+	return time.UnixMilli(millis).Add(time.Duration(logical)).UTC()
+}
+
+// SetContextHLC returns a new context with the hybrid logical clock value set.
+//
+// Blocks added to a [MerkleClock] with this context will be timestamped with the given
+// clock, and the clock will observe the timestamps of the processed blocks.
+func SetContextHLC(ctx context.Context, hlc *HLC) context.Context {
+	return context.WithValue(ctx, hlcContextKey{}, hlc)
+}
+
+// GetContextHLC returns the hybrid logical clock from the given context.
+//
+// If a clock does not exist nil is returned.
+func GetContextHLC(ctx context.Context) *HLC {
+	hlc, _ := ctx.Value(hlcContextKey{}).(*HLC)
+	return hlc
+}
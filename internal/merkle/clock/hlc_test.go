@@ -0,0 +1,84 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHLCNow_WithStoppedWallClock_Increases(t *testing.T) {
+	wallTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	hlc := NewHLC(func() time.Time { return wallTime })
+
+	first := hlc.Now()
+	second := hlc.Now()
+	if second <= first {
+		t.Errorf("Expected %v to be greater than %v", second, first)
+	}
+	if !HLCTimestampToTime(first).Equal(wallTime) {
+		t.Errorf("Expected timestamp time to be %v, got %v", wallTime, HLCTimestampToTime(first))
+	}
+	if !HLCTimestampToTime(second).After(HLCTimestampToTime(first)) {
+		t.Errorf("Expected timestamp times to preserve the timestamp order")
+	}
+}
+
+func TestHLCNow_WithWallClockGoingBackwards_Increases(t *testing.T) {
+	wallTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	hlc := NewHLC(func() time.Time { return wallTime })
+
+	first := hlc.Now()
+	wallTime = wallTime.Add(-time.Hour)
+	second := hlc.Now()
+	if second <= first {
+		t.Errorf("Expected %v to be greater than %v", second, first)
+	}
+}
+
+func TestHLCNow_WithWallClockAdvancing_FollowsWallClock(t *testing.T) {
+	wallTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	hlc := NewHLC(func() time.Time { return wallTime })
+
+	hlc.Now()
+	hlc.Now()
+	wallTime = wallTime.Add(time.Second)
+	timestamp := hlc.Now()
+	if !HLCTimestampToTime(timestamp).Equal(wallTime) {
+		t.Errorf("Expected timestamp time to be %v, got %v", wallTime, HLCTimestampToTime(timestamp))
+	}
+}
+
+func TestHLCUpdate_WithTimestampAhead_AdvancesClock(t *testing.T) {
+	wallTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	hlc := NewHLC(func() time.Time { return wallTime })
+
+	remote := NewHLC(func() time.Time { return wallTime.Add(time.Hour) }).Now()
+	hlc.Update(remote)
+
+	timestamp := hlc.Now()
+	if timestamp <= remote {
+		t.Errorf("Expected %v to be greater than %v", timestamp, remote)
+	}
+}
+
+func TestHLCUpdate_WithTimestampBehind_DoesNotRewindClock(t *testing.T) {
+	wallTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	hlc := NewHLC(func() time.Time { return wallTime })
+
+	first := hlc.Now()
+	hlc.Update(NewHLC(func() time.Time { return wallTime.Add(-time.Hour) }).Now())
+
+	second := hlc.Now()
+	if second <= first {
+		t.Errorf("Expected %v to be greater than %v", second, first)
+	}
+}
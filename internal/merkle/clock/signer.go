@@ -0,0 +1,38 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package clock
+
+import (
+	"context"
+
+	"github.com/sourcenetwork/immutable"
+
+	acpIdentity "github.com/sourcenetwork/defradb/acp/identity"
+)
+
+// signerContextKey is the key type for block signer context values.
+type signerContextKey struct{}
+
+// SetContextSigner returns a new context with the block signer value set.
+//
+// Blocks added to a [MerkleClock] with this context will be signed by the given identity
+// if it holds a private key.
+func SetContextSigner(ctx context.Context, signer immutable.Option[acpIdentity.Identity]) context.Context {
+	return context.WithValue(ctx, signerContextKey{}, signer)
+}
+
+// GetContextSigner returns the block signer from the given context.
+//
+// If a signer does not exist none is returned.
+func GetContextSigner(ctx context.Context) immutable.Option[acpIdentity.Identity] {
+	signer, _ := ctx.Value(signerContextKey{}).(immutable.Option[acpIdentity.Identity])
+	return signer
+}
@@ -0,0 +1,66 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package merklecrdt
+
+import (
+	"context"
+
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/core/crdt"
+	"github.com/sourcenetwork/defradb/internal/merkle/clock"
+)
+
+// MerkleLWWMap is a MerkleCRDT implementation of the LWWMap using MerkleClocks.
+type MerkleLWWMap struct {
+	*baseMerkleCRDT
+
+	lwwMap crdt.LWWMap
+}
+
+// NewMerkleLWWMap creates a new instance (or loaded from DB) of a MerkleCRDT
+// backed by an LWWMap CRDT.
+func NewMerkleLWWMap(
+	store Stores,
+	schemaVersionKey core.CollectionSchemaVersionKey,
+	key core.DataStoreKey,
+	fieldName string,
+) *MerkleLWWMap {
+	lwwMap := crdt.NewLWWMap(store.Datastore(), schemaVersionKey, key, fieldName)
+	clk := clock.NewMerkleClock(store.Headstore(), store.Blockstore(), store.Encstore(), key.ToHeadStoreKey(), lwwMap)
+	base := &baseMerkleCRDT{clock: clk, crdt: lwwMap}
+	return &MerkleLWWMap{
+		baseMerkleCRDT: base,
+		lwwMap:         lwwMap,
+	}
+}
+
+// Save the value of the LWWMap to the DAG.
+//
+// The given JSON value is diffed against the current map, only the key paths whose
+// value changed are recorded in the delta.
+func (mm *MerkleLWWMap) Save(ctx context.Context, data any) (cidlink.Link, []byte, error) {
+	value, ok := data.(*DocField)
+	if !ok {
+		return cidlink.Link{}, nil, NewErrUnexpectedValueType(client.LWW_MAP, &client.FieldValue{}, data)
+	}
+	bytes, err := value.FieldValue.Bytes()
+	if err != nil {
+		return cidlink.Link{}, nil, err
+	}
+	delta, err := mm.lwwMap.Set(ctx, bytes)
+	if err != nil {
+		return cidlink.Link{}, nil, err
+	}
+	return mm.clock.AddDelta(ctx, delta)
+}
@@ -98,6 +98,34 @@ func InstanceWithStore(
 			cType == client.PN_COUNTER,
 			kind.(client.ScalarKind),
 		), nil
+	case client.OR_SET:
+		return NewMerkleORSet(
+			store,
+			schemaVersionKey,
+			key,
+			fieldName,
+		), nil
+	case client.MV_REGISTER:
+		return NewMerkleMVRegister(
+			store,
+			schemaVersionKey,
+			key,
+			fieldName,
+		), nil
+	case client.RGA_TEXT:
+		return NewMerkleRGAText(
+			store,
+			schemaVersionKey,
+			key,
+			fieldName,
+		), nil
+	case client.LWW_MAP:
+		return NewMerkleLWWMap(
+			store,
+			schemaVersionKey,
+			key,
+			fieldName,
+		), nil
 	case client.COMPOSITE:
 		return NewMerkleCompositeDAG(
 			store,
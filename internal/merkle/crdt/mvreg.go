@@ -0,0 +1,65 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package merklecrdt
+
+import (
+	"context"
+
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/core/crdt"
+	"github.com/sourcenetwork/defradb/internal/merkle/clock"
+)
+
+// MerkleMVRegister is a MerkleCRDT implementation of the MVRegister using MerkleClocks.
+type MerkleMVRegister struct {
+	*baseMerkleCRDT
+
+	reg crdt.MVRegister
+}
+
+// NewMerkleMVRegister creates a new instance (or loaded from DB) of a MerkleCRDT
+// backed by an MVRegister CRDT.
+func NewMerkleMVRegister(
+	store Stores,
+	schemaVersionKey core.CollectionSchemaVersionKey,
+	key core.DataStoreKey,
+	fieldName string,
+) *MerkleMVRegister {
+	reg := crdt.NewMVRegister(store.Datastore(), schemaVersionKey, key, fieldName)
+	clk := clock.NewMerkleClock(store.Headstore(), store.Blockstore(), store.Encstore(), key.ToHeadStoreKey(), reg)
+	base := &baseMerkleCRDT{clock: clk, crdt: reg}
+	return &MerkleMVRegister{
+		baseMerkleCRDT: base,
+		reg:            reg,
+	}
+}
+
+// Save the value of the MVRegister to the DAG.
+//
+// The value supersedes the concurrent values currently held by the register.
+func (mr *MerkleMVRegister) Save(ctx context.Context, data any) (cidlink.Link, []byte, error) {
+	value, ok := data.(*DocField)
+	if !ok {
+		return cidlink.Link{}, nil, NewErrUnexpectedValueType(client.MV_REGISTER, &client.FieldValue{}, data)
+	}
+	bytes, err := value.FieldValue.Bytes()
+	if err != nil {
+		return cidlink.Link{}, nil, err
+	}
+	delta, err := mr.reg.Set(ctx, bytes)
+	if err != nil {
+		return cidlink.Link{}, nil, err
+	}
+	return mr.clock.AddDelta(ctx, delta)
+}
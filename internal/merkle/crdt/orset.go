@@ -0,0 +1,66 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package merklecrdt
+
+import (
+	"context"
+
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/core/crdt"
+	"github.com/sourcenetwork/defradb/internal/merkle/clock"
+)
+
+// MerkleORSet is a MerkleCRDT implementation of the ORSet using MerkleClocks.
+type MerkleORSet struct {
+	*baseMerkleCRDT
+
+	reg crdt.ORSet
+}
+
+// NewMerkleORSet creates a new instance (or loaded from DB) of a MerkleCRDT
+// backed by an ORSet CRDT.
+func NewMerkleORSet(
+	store Stores,
+	schemaVersionKey core.CollectionSchemaVersionKey,
+	key core.DataStoreKey,
+	fieldName string,
+) *MerkleORSet {
+	set := crdt.NewORSet(store.Datastore(), schemaVersionKey, key, fieldName)
+	clk := clock.NewMerkleClock(store.Headstore(), store.Blockstore(), store.Encstore(), key.ToHeadStoreKey(), set)
+	base := &baseMerkleCRDT{clock: clk, crdt: set}
+	return &MerkleORSet{
+		baseMerkleCRDT: base,
+		reg:            set,
+	}
+}
+
+// Save the value of the ORSet to the DAG.
+//
+// The given array value replaces the current set, only the element differences are
+// recorded in the delta.
+func (ms *MerkleORSet) Save(ctx context.Context, data any) (cidlink.Link, []byte, error) {
+	value, ok := data.(*DocField)
+	if !ok {
+		return cidlink.Link{}, nil, NewErrUnexpectedValueType(client.OR_SET, &client.FieldValue{}, data)
+	}
+	bytes, err := value.FieldValue.Bytes()
+	if err != nil {
+		return cidlink.Link{}, nil, err
+	}
+	delta, err := ms.reg.Set(ctx, bytes)
+	if err != nil {
+		return cidlink.Link{}, nil, err
+	}
+	return ms.clock.AddDelta(ctx, delta)
+}
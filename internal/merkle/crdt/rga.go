@@ -0,0 +1,74 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package merklecrdt
+
+import (
+	"context"
+
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/core/crdt"
+	"github.com/sourcenetwork/defradb/internal/merkle/clock"
+)
+
+// MerkleRGAText is a MerkleCRDT implementation of the RGAText using MerkleClocks.
+type MerkleRGAText struct {
+	*baseMerkleCRDT
+
+	text crdt.RGAText
+}
+
+// NewMerkleRGAText creates a new instance (or loaded from DB) of a MerkleCRDT
+// backed by an RGAText CRDT.
+func NewMerkleRGAText(
+	store Stores,
+	schemaVersionKey core.CollectionSchemaVersionKey,
+	key core.DataStoreKey,
+	fieldName string,
+) *MerkleRGAText {
+	text := crdt.NewRGAText(store.Datastore(), schemaVersionKey, key, fieldName)
+	clk := clock.NewMerkleClock(store.Headstore(), store.Blockstore(), store.Encstore(), key.ToHeadStoreKey(), text)
+	base := &baseMerkleCRDT{clock: clk, crdt: text}
+	return &MerkleRGAText{
+		baseMerkleCRDT: base,
+		text:           text,
+	}
+}
+
+// Save the value of the RGAText to the DAG.
+//
+// If the value is the result of splices, the splices are applied to the current text.
+// Otherwise the given string is diffed against the current text. Either way, only the
+// inserted and deleted characters are recorded in the delta.
+func (mt *MerkleRGAText) Save(ctx context.Context, data any) (cidlink.Link, []byte, error) {
+	value, ok := data.(*DocField)
+	if !ok {
+		return cidlink.Link{}, nil, NewErrUnexpectedValueType(client.RGA_TEXT, &client.FieldValue{}, data)
+	}
+	if splices := value.FieldValue.Splices(); len(splices) > 0 {
+		delta, err := mt.text.Splice(ctx, splices)
+		if err != nil {
+			return cidlink.Link{}, nil, err
+		}
+		return mt.clock.AddDelta(ctx, delta)
+	}
+	bytes, err := value.FieldValue.Bytes()
+	if err != nil {
+		return cidlink.Link{}, nil, err
+	}
+	delta, err := mt.text.Set(ctx, bytes)
+	if err != nil {
+		return cidlink.Link{}, nil, err
+	}
+	return mt.clock.AddDelta(ctx, delta)
+}
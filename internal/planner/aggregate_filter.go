@@ -0,0 +1,105 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/planner/mapper"
+)
+
+// aggregateFilterNode yields only the documents whose aggregates satisfy the conditions of
+// the filter on the aggregates of a select.
+//
+// For a grouped select the documents are the groups, so the groups can be filtered by the
+// aggregates computed over their members.
+type aggregateFilterNode struct {
+	docMapper
+
+	p    *Planner
+	plan planNode
+
+	filter *mapper.Filter
+
+	execInfo aggregateFilterExecInfo
+}
+
+type aggregateFilterExecInfo struct {
+	// Total number of times aggregateFilterNode was executed.
+	iterations uint64
+
+	// Total number of times the filter passed / matched.
+	filterMatches uint64
+}
+
+// AggregateFilter creates a new aggregateFilterNode for the given select, it returns nil if
+// the select has no filter on its aggregates.
+func (p *Planner) AggregateFilter(parsed *mapper.Select) *aggregateFilterNode {
+	if parsed.AggregateFilter == nil {
+		return nil
+	}
+	return &aggregateFilterNode{
+		p:         p,
+		filter:    parsed.AggregateFilter,
+		docMapper: docMapper{parsed.DocumentMapping},
+	}
+}
+
+func (n *aggregateFilterNode) Kind() string {
+	return "aggregateFilterNode"
+}
+
+func (n *aggregateFilterNode) Init() error            { return n.plan.Init() }
+func (n *aggregateFilterNode) Start() error           { return n.plan.Start() }
+func (n *aggregateFilterNode) Spans(spans core.Spans) { n.plan.Spans(spans) }
+func (n *aggregateFilterNode) Close() error           { return n.plan.Close() }
+func (n *aggregateFilterNode) Value() core.Doc        { return n.plan.Value() }
+func (n *aggregateFilterNode) Source() planNode       { return n.plan }
+
+func (n *aggregateFilterNode) Next() (bool, error) {
+	n.execInfo.iterations++
+
+	for {
+		hasNext, err := n.plan.Next()
+		if err != nil || !hasNext {
+			return hasNext, err
+		}
+
+		passes, err := mapper.RunFilter(n.plan.Value(), n.filter)
+		if err != nil {
+			return false, err
+		}
+		if passes {
+			n.execInfo.filterMatches++
+			return true, nil
+		}
+	}
+}
+
+// Explain method returns a map containing all attributes of this node that
+// are to be explained, subscribes / opts-in this node to be an explainablePlanNode.
+func (n *aggregateFilterNode) Explain(explainType request.ExplainType) (map[string]any, error) {
+	switch explainType {
+	case request.SimpleExplain:
+		return map[string]any{
+			filterLabel: n.filter.ToMap(n.documentMapping),
+		}, nil
+
+	case request.ExecuteExplain:
+		return map[string]any{
+			"iterations":    n.execInfo.iterations,
+			"filterMatches": n.execInfo.filterMatches,
+		}, nil
+
+	default:
+		return nil, ErrUnknownExplainRequestType
+	}
+}
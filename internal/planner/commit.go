@@ -20,6 +20,7 @@ import (
 	"github.com/sourcenetwork/defradb/internal/core"
 	coreblock "github.com/sourcenetwork/defradb/internal/core/block"
 	"github.com/sourcenetwork/defradb/internal/db/fetcher"
+	"github.com/sourcenetwork/defradb/internal/merkle/clock"
 	"github.com/sourcenetwork/defradb/internal/planner/mapper"
 )
 
@@ -212,6 +213,15 @@ func (n *dagScanNode) Next() (bool, error) {
 	// clear the cid after
 	block, err := store.Get(n.planner.ctx, *currentCid)
 	if err != nil {
+		isPruned, pruneErr := clock.IsPruned(n.planner.ctx, n.planner.txn.Headstore(), *currentCid)
+		if pruneErr != nil {
+			return false, pruneErr
+		}
+		if isPruned {
+			// The block has been removed by a DAG compaction, the history stops here.
+			n.visitedNodes[currentCid.String()] = true
+			return n.Next()
+		}
 		return false, err
 	}
 
@@ -345,6 +355,16 @@ func (n *dagScanNode) dagBlockToNodeDoc(block *coreblock.Block) (core.Doc, error
 		n.commitSelect.DocumentMapping.SetFirstOfName(&commit, request.DeltaFieldName, nil)
 	}
 	n.commitSelect.DocumentMapping.SetFirstOfName(&commit, request.HeightFieldName, int64(prio))
+	if block.Timestamp != nil {
+		n.commitSelect.DocumentMapping.SetFirstOfName(
+			&commit,
+			request.TimestampFieldName,
+			clock.HLCTimestampToTime(*block.Timestamp),
+		)
+	}
+	if block.Signature != nil {
+		n.commitSelect.DocumentMapping.SetFirstOfName(&commit, request.SignerFieldName, block.Signature.Identity)
+	}
 	n.commitSelect.DocumentMapping.SetFirstOfName(&commit, request.FieldNameFieldName, fieldName)
 	n.commitSelect.DocumentMapping.SetFirstOfName(&commit, request.FieldIDFieldName, fieldID)
 
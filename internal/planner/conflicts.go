@@ -0,0 +1,128 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"encoding/hex"
+
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/core/crdt"
+	"github.com/sourcenetwork/defradb/internal/db/base"
+	"github.com/sourcenetwork/defradb/internal/planner/mapper"
+)
+
+// conflictsNode yields the concurrent values of the multi-value register fields
+// of a single document.
+//
+// It is used by the `_conflicts` sub selection, the docID of the parent document
+// is passed in via the Spans interface.
+type conflictsNode struct {
+	documentIterator
+	docMapper
+
+	planner    *Planner
+	collection client.Collection
+
+	docID     string
+	conflicts []core.Doc
+}
+
+func (p *Planner) Conflicts(collection client.Collection, conflictsSelect *mapper.Select) *conflictsNode {
+	return &conflictsNode{
+		planner:    p,
+		collection: collection,
+		docMapper:  docMapper{conflictsSelect.DocumentMapping},
+	}
+}
+
+func (n *conflictsNode) Kind() string {
+	return "conflictsNode"
+}
+
+func (n *conflictsNode) Init() error {
+	n.conflicts = nil
+
+	dsKey := base.MakeDataStoreKeyWithCollectionAndDocID(n.collection.Description(), n.docID)
+	for _, field := range n.collection.Definition().GetFields() {
+		if field.Typ != client.MV_REGISTER {
+			continue
+		}
+
+		reg := crdt.NewMVRegister(
+			n.planner.txn.Datastore(),
+			core.CollectionSchemaVersionKey{},
+			dsKey.WithFieldID(field.ID.String()),
+			field.Name,
+		)
+		values, err := reg.Values(n.planner.ctx)
+		if err != nil {
+			return err
+		}
+		if len(values) < 2 {
+			continue
+		}
+
+		fieldValues := make([]any, len(values))
+		heads := make([]any, len(values))
+		for i, value := range values {
+			var val any
+			err := cbor.Unmarshal(value.Value, &val)
+			if err != nil {
+				return err
+			}
+			fieldValues[i], err = core.NormalizeFieldValue(field, val)
+			if err != nil {
+				return err
+			}
+			heads[i] = hex.EncodeToString(value.ID)
+		}
+
+		conflict := n.documentMapping.NewDoc()
+		n.documentMapping.SetFirstOfName(&conflict, request.FieldNameFieldName, field.Name)
+		n.documentMapping.SetFirstOfName(&conflict, request.ConflictValuesFieldName, fieldValues)
+		n.documentMapping.SetFirstOfName(&conflict, request.ConflictHeadsFieldName, heads)
+		n.conflicts = append(n.conflicts, conflict)
+	}
+
+	return nil
+}
+
+func (n *conflictsNode) Start() error {
+	return nil
+}
+
+// Spans sets the docID of the document to yield the conflicts of, it only
+// cares about the first value in the span set.
+func (n *conflictsNode) Spans(spans core.Spans) {
+	if len(spans.Value) == 0 {
+		return
+	}
+	n.docID = spans.Value[0].Start().DocID
+}
+
+func (n *conflictsNode) Next() (bool, error) {
+	if len(n.conflicts) == 0 {
+		return false, nil
+	}
+	n.currentValue = n.conflicts[0]
+	n.conflicts = n.conflicts[1:]
+	return true, nil
+}
+
+func (n *conflictsNode) Close() error {
+	return nil
+}
+
+func (n *conflictsNode) Source() planNode { return nil }
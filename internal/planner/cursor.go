@@ -0,0 +1,447 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"encoding/base64"
+	"reflect"
+	"slices"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/db/base"
+	"github.com/sourcenetwork/defradb/internal/db/fetcher"
+	"github.com/sourcenetwork/defradb/internal/planner/mapper"
+)
+
+// cursorNode paginates the ordered documents of its source with cursors, and sets the
+// `_cursor` field of the documents it yields.
+//
+// A cursor encodes the values of the order conditions of a document. As the order of a
+// select paginated with cursors always ends with the docID, every document has a distinct
+// cursor and the page boundaries are stable while documents are being inserted.
+type cursorNode struct {
+	docMapper
+
+	p    *Planner
+	plan planNode
+
+	cursor   mapper.Cursor
+	ordering []mapper.OrderCondition
+
+	// after and before hold the decoded positions of the cursor arguments, they are nil
+	// if the argument is not set.
+	after  []any
+	before []any
+
+	// docs holds the last documents of the page once the source has been consumed.
+	docs     []core.Doc
+	consumed bool
+	current  int
+
+	// yielded is the number of documents yielded so far.
+	yielded      uint64
+	currentValue core.Doc
+
+	execInfo cursorExecInfo
+}
+
+type cursorExecInfo struct {
+	// Total number of times cursorNode was executed.
+	iterations uint64
+
+	// Total number of documents of the source that were outside of the page.
+	docsSkipped uint64
+}
+
+// Cursor creates a new cursorNode for the cursor pagination of the given select, it
+// returns nil if the select is not paginated with cursors.
+func (p *Planner) Cursor(parsed *mapper.Select) (*cursorNode, error) {
+	if !parsed.Cursor.HasValue() {
+		return nil, nil
+	}
+	cursor := parsed.Cursor.Value()
+	ordering := parsed.OrderBy.Conditions
+
+	n := &cursorNode{
+		p:         p,
+		cursor:    cursor,
+		ordering:  ordering,
+		docMapper: docMapper{parsed.DocumentMapping},
+	}
+	var err error
+	if cursor.After.HasValue() {
+		n.after, err = decodeCursor(cursor.After.Value(), len(ordering))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cursor.Before.HasValue() {
+		n.before, err = decodeCursor(cursor.Before.Value(), len(ordering))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return n, nil
+}
+
+func (n *cursorNode) Kind() string {
+	return "cursorNode"
+}
+
+func (n *cursorNode) Init() error {
+	n.docs = nil
+	n.consumed = false
+	n.current = -1
+	n.yielded = 0
+	return n.plan.Init()
+}
+
+func (n *cursorNode) Start() error           { return n.plan.Start() }
+func (n *cursorNode) Spans(spans core.Spans) { n.plan.Spans(spans) }
+func (n *cursorNode) Close() error           { return n.plan.Close() }
+func (n *cursorNode) Value() core.Doc        { return n.currentValue }
+func (n *cursorNode) Source() planNode       { return n.plan }
+
+func (n *cursorNode) Next() (bool, error) {
+	n.execInfo.iterations++
+
+	if n.cursor.Last.HasValue() {
+		if !n.consumed {
+			err := n.consumeLast()
+			if err != nil {
+				return false, err
+			}
+		}
+		if n.current+1 >= len(n.docs) {
+			return false, nil
+		}
+		n.current++
+		n.currentValue = n.docs[n.current]
+		return true, nil
+	}
+
+	if n.cursor.First.HasValue() && n.yielded >= n.cursor.First.Value() {
+		return false, nil
+	}
+	doc, ok, err := n.nextInPage()
+	if err != nil || !ok {
+		return false, err
+	}
+	n.yielded++
+	n.currentValue = doc
+	return true, nil
+}
+
+// consumeLast reads the documents of the page and keeps the last ones.
+//
+// The source is only read forwards, so every document of the page is read. This is O(n) in
+// the size of the page, which without `before` ends at the end of the collection, while
+// only the last documents are kept in memory.
+func (n *cursorNode) consumeLast() error {
+	last := n.cursor.Last.Value()
+	for {
+		if n.cursor.First.HasValue() && n.yielded >= n.cursor.First.Value() {
+			break
+		}
+		doc, ok, err := n.nextInPage()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+		n.yielded++
+
+		// the document is cloned as the source may reuse it for the next one.
+		n.docs = append(n.docs, doc.Clone())
+		if uint64(len(n.docs)) > last {
+			n.docs = n.docs[1:]
+		}
+	}
+	n.consumed = true
+	return nil
+}
+
+// nextInPage returns the next document of the source that is within the page, with its
+// `_cursor` field set. The second return value is false if there is no such document.
+func (n *cursorNode) nextInPage() (core.Doc, bool, error) {
+	for {
+		next, err := n.plan.Next()
+		if err != nil || !next {
+			return core.Doc{}, false, err
+		}
+		doc := n.plan.Value()
+
+		if n.after != nil {
+			c, err := n.compare(doc, n.after)
+			if err != nil {
+				return core.Doc{}, false, err
+			}
+			if c <= 0 {
+				n.execInfo.docsSkipped++
+				continue
+			}
+		}
+		if n.before != nil {
+			c, err := n.compare(doc, n.before)
+			if err != nil {
+				return core.Doc{}, false, err
+			}
+			if c >= 0 {
+				// the end of the page has been reached
+				return core.Doc{}, false, nil
+			}
+		}
+
+		values := make([]any, len(n.ordering))
+		for i, order := range n.ordering {
+			values[i] = getDocProp(doc, order.FieldIndexes)
+		}
+		cursor, err := encodeCursor(values)
+		if err != nil {
+			return core.Doc{}, false, err
+		}
+		n.documentMapping.TrySetFirstOfName(&doc, request.CursorFieldName, cursor)
+		return doc, true, nil
+	}
+}
+
+// compare returns the position of the given document relative to the given cursor position
+// in the order of the select.
+func (n *cursorNode) compare(doc core.Doc, position []any) (int, error) {
+	for i, order := range n.ordering {
+		value := getDocProp(doc, order.FieldIndexes)
+		if value != nil && position[i] != nil && reflect.TypeOf(value) != reflect.TypeOf(position[i]) {
+			// the cursor has been returned by a select with a different order
+			return 0, ErrInvalidCursor
+		}
+		c := base.Compare(value, position[i])
+		if order.Direction == mapper.DESC {
+			c = -c
+		}
+		if c != 0 {
+			return c, nil
+		}
+	}
+	return 0, nil
+}
+
+// trySeek restricts the scan of the given select to the documents of the page, if the
+// documents are ordered by docID only, or by a field indexed in the order direction and then by
+// docID. It returns true if the documents are yielded by the scan in the order of the select,
+// in which case they need not be ordered.
+func (n *cursorNode) trySeek(s *selectNode) bool {
+	scan, ok := rootScanInOrder(s.source)
+	if !ok || scan.spans.HasValue || scan.index.HasValue() ||
+		s.selectReq.Cid.HasValue() || s.selectReq.AsOf.HasValue() || s.selectReq.Similar.HasValue() {
+		return false
+	}
+	switch {
+	case len(n.ordering) == 1 && isDocIDOrder(n.ordering[0]):
+		return n.seekDocID(scan)
+	case len(n.ordering) == 2 && isDocIDOrder(n.ordering[1]) && n.ordering[1].Direction == mapper.ASC:
+		return n.trySeekIndex(scan)
+	default:
+		return false
+	}
+}
+
+// seekDocID restricts the given scan to the documents with a docID within the page. It
+// returns true if the scan yields the documents in the order of the select.
+func (n *cursorNode) seekDocID(scan *scanNode) bool {
+	desc := scan.col.Description()
+	start := base.MakeDataStoreKeyWithCollectionDescription(desc)
+	end := start.PrefixEnd()
+
+	after := n.after
+	before := n.before
+	descending := n.ordering[0].Direction == mapper.DESC
+	if descending {
+		after, before = before, after
+	}
+	if after != nil {
+		if docID, ok := after[0].(string); ok {
+			start = base.MakeDataStoreKeyWithCollectionAndDocID(desc, docID).PrefixEnd()
+		}
+	}
+	if before != nil {
+		if docID, ok := before[0].(string); ok {
+			end = base.MakeDataStoreKeyWithCollectionAndDocID(desc, docID)
+		}
+	}
+
+	if start.ToString() >= end.ToString() {
+		scan.Spans(core.NewSpans())
+	} else {
+		scan.Spans(core.NewSpans(core.NewSpan(start, end)))
+	}
+	// the scan yields the documents in ascending docID order.
+	return !descending
+}
+
+// trySeekIndex makes the given scan iterate through the keys of a value index on the first
+// order field, starting from the key of the page start. It returns true if such an index
+// exists.
+//
+// The keys of a value index are ordered by value and then by docID, so they are in the order
+// of the select if the field is indexed in the order direction. The documents at the values of
+// the page bounds are still compared to the cursors, to skip those outside of the page.
+func (n *cursorNode) trySeekIndex(scan *scanNode) bool {
+	// documents read from index keys are neither checked against the policy of the
+	// collection nor against the filter of the scan.
+	if scan.showDeleted || scan.filter != nil || scan.col.Description().Policy.HasValue() {
+		return false
+	}
+	order := n.ordering[0]
+	if len(order.FieldIndexes) != 1 {
+		return false
+	}
+	for _, index := range filterUsableIndexes(scan.col.Description().Indexes, scan.col.Definition(), nil) {
+		if index.Type != client.IndexTypeValue || len(index.Fields) != 1 ||
+			index.Fields[0].JSONPath() != nil || index.Fields[0].Descending != (order.Direction == mapper.DESC) {
+			continue
+		}
+		fieldName := index.Fields[0].FieldName()
+		if !slices.Contains(scan.documentMapping.IndexesByName[fieldName], order.FieldIndexes[0]) {
+			continue
+		}
+		field, ok := scan.col.Definition().GetFieldByName(fieldName)
+		if !ok || field.Kind.IsArray() {
+			// the keys of an array index only hold one element of the array.
+			continue
+		}
+
+		var scanRange fetcher.IndexScanRange
+		if n.after != nil {
+			scanRange.Start = immutable.Some(n.after[0])
+		}
+		if n.before != nil {
+			scanRange.End = immutable.Some(n.before[0])
+		}
+		scan.initIndexScanFetcher(index, scanRange)
+		return true
+	}
+	return false
+}
+
+// isDocIDOrder returns true if the given order condition is on the docID.
+func isDocIDOrder(order mapper.OrderCondition) bool {
+	return slices.Equal(order.FieldIndexes, []int{core.DocIDFieldIndex})
+}
+
+// rootScanInOrder returns the scan node of the given select source if the source yields the
+// documents in the order of the scan.
+func rootScanInOrder(source planNode) (*scanNode, bool) {
+	switch node := source.(type) {
+	case *scanNode:
+		return node, true
+	case *typeIndexJoin:
+		var join *invertibleTypeJoin
+		switch joinPlan := node.joinPlan.(type) {
+		case *typeJoinOne:
+			join = &joinPlan.invertibleTypeJoin
+		case *typeJoinMany:
+			join = &joinPlan.invertibleTypeJoin
+		default:
+			return nil, false
+		}
+		if !join.parentSide.isFirst {
+			return nil, false
+		}
+		scan, ok := join.parentSide.plan.(*scanNode)
+		return scan, ok
+	default:
+		return nil, false
+	}
+}
+
+// encodeCursor returns the opaque cursor of the given order condition values.
+func encodeCursor(values []any) (string, error) {
+	opts := client.CborEncodingOptions()
+	opts.TimeTag = cbor.EncTagRequired
+	em, err := opts.EncMode()
+	if err != nil {
+		return "", err
+	}
+	data, err := em.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeCursor returns the order condition values encoded in the given cursor.
+//
+// An error is returned if the cursor does not hold the given number of values.
+func decodeCursor(cursor string, length int) ([]any, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, NewErrInvalidCursor(cursor, err)
+	}
+	dm, err := cbor.DecOptions{IntDec: cbor.IntDecConvertSignedOrFail}.DecMode()
+	if err != nil {
+		return nil, err
+	}
+	var values []any
+	err = dm.Unmarshal(data, &values)
+	if err != nil {
+		return nil, NewErrInvalidCursor(cursor, err)
+	}
+	if len(values) != length {
+		return nil, NewErrInvalidCursor(cursor, ErrInvalidCursor)
+	}
+	return values, nil
+}
+
+func (n *cursorNode) simpleExplain() (map[string]any, error) {
+	simpleExplainMap := map[string]any{
+		request.FirstClause:  nil,
+		request.AfterClause:  nil,
+		request.LastClause:   nil,
+		request.BeforeClause: nil,
+	}
+	if n.cursor.First.HasValue() {
+		simpleExplainMap[request.FirstClause] = n.cursor.First.Value()
+	}
+	if n.cursor.After.HasValue() {
+		simpleExplainMap[request.AfterClause] = n.cursor.After.Value()
+	}
+	if n.cursor.Last.HasValue() {
+		simpleExplainMap[request.LastClause] = n.cursor.Last.Value()
+	}
+	if n.cursor.Before.HasValue() {
+		simpleExplainMap[request.BeforeClause] = n.cursor.Before.Value()
+	}
+	return simpleExplainMap, nil
+}
+
+// Explain method returns a map containing all attributes of this node that
+// are to be explained, subscribes / opts-in this node to be an explainablePlanNode.
+func (n *cursorNode) Explain(explainType request.ExplainType) (map[string]any, error) {
+	switch explainType {
+	case request.SimpleExplain:
+		return n.simpleExplain()
+
+	case request.ExecuteExplain:
+		return map[string]any{
+			"iterations":  n.execInfo.iterations,
+			"docsSkipped": n.execInfo.docsSkipped,
+		}, nil
+
+	default:
+		return nil, ErrUnknownExplainRequestType
+	}
+}
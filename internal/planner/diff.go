@@ -0,0 +1,202 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"reflect"
+
+	"github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/internal/core"
+	coreblock "github.com/sourcenetwork/defradb/internal/core/block"
+	"github.com/sourcenetwork/defradb/internal/db/fetcher"
+	"github.com/sourcenetwork/defradb/internal/planner/mapper"
+)
+
+// diffNode yields the fields that changed between two versions of a single document,
+// along with their old and new values.
+//
+// It is used by the `_diff` sub selection, the docID of the parent document
+// is passed in via the Spans interface. No changes are yielded for the documents
+// the versions do not belong to.
+type diffNode struct {
+	documentIterator
+	docMapper
+
+	planner    *Planner
+	collection client.Collection
+
+	from cid.Cid
+	// to is the version compared to, if it has no value the current state of
+	// the document is compared to.
+	to immutable.Option[cid.Cid]
+
+	docID   string
+	changes []core.Doc
+}
+
+func (p *Planner) Diff(collection client.Collection, diffSelect *mapper.Select) (*diffNode, error) {
+	from, err := p.getDiffVersion(diffSelect.DiffFrom.Value())
+	if err != nil {
+		return nil, err
+	}
+
+	var to immutable.Option[cid.Cid]
+	if diffSelect.DiffTo.HasValue() {
+		c, err := p.getDiffVersion(diffSelect.DiffTo.Value())
+		if err != nil {
+			return nil, err
+		}
+		to = immutable.Some(c)
+	}
+
+	return &diffNode{
+		planner:    p,
+		collection: collection,
+		from:       from,
+		to:         to,
+		docMapper:  docMapper{diffSelect.DocumentMapping},
+	}, nil
+}
+
+// getDiffVersion decodes the given version and checks that it is the CID of a composite
+// block, the document it belongs to is only checked once the documents are fetched.
+func (p *Planner) getDiffVersion(version string) (cid.Cid, error) {
+	c, err := cid.Decode(version)
+	if err != nil {
+		return cid.Undef, err
+	}
+	blk, err := p.txn.Blockstore().Get(p.ctx, c)
+	if errors.Is(err, ipld.ErrNotFound{}) {
+		return cid.Undef, NewErrInvalidDiffVersion(version)
+	}
+	if err != nil {
+		return cid.Undef, err
+	}
+	block, err := coreblock.GetFromBytes(blk.RawData())
+	if err != nil {
+		return cid.Undef, err
+	}
+	if !block.Delta.IsComposite() {
+		return cid.Undef, NewErrInvalidDiffVersion(version)
+	}
+	return c, nil
+}
+
+func (n *diffNode) Kind() string {
+	return "diffNode"
+}
+
+func (n *diffNode) Init() error {
+	n.changes = nil
+	if n.docID == "" {
+		// The docID is only known once the parent document has been fetched.
+		return nil
+	}
+
+	oldDoc, err := n.getVersion(n.from)
+	if err != nil || oldDoc == nil {
+		return err
+	}
+
+	var newDoc *client.Document
+	if n.to.HasValue() {
+		newDoc, err = n.getVersion(n.to.Value())
+	} else {
+		newDoc, err = n.getCurrent()
+	}
+	if err != nil || newDoc == nil {
+		return err
+	}
+
+	for _, field := range n.collection.Definition().GetFields() {
+		if field.Name == request.DocIDFieldName || field.Kind.IsObject() {
+			continue
+		}
+
+		oldValue, err := fetcher.GetFieldValue(oldDoc, field.Name)
+		if err != nil {
+			return err
+		}
+		newValue, err := fetcher.GetFieldValue(newDoc, field.Name)
+		if err != nil {
+			return err
+		}
+		if reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+
+		change := n.documentMapping.NewDoc()
+		n.documentMapping.SetFirstOfName(&change, request.FieldNameFieldName, field.Name)
+		n.documentMapping.SetFirstOfName(&change, request.DiffOldValueFieldName, oldValue)
+		n.documentMapping.SetFirstOfName(&change, request.DiffNewValueFieldName, newValue)
+		n.changes = append(n.changes, change)
+	}
+
+	return nil
+}
+
+// getVersion returns the state of the document at the given version, or nil if the version
+// does not belong to the document.
+func (n *diffNode) getVersion(version cid.Cid) (*client.Document, error) {
+	return fetcher.FetchVersion(
+		n.planner.ctx,
+		n.planner.identity,
+		n.planner.txn,
+		n.planner.acp,
+		n.collection,
+		n.docID,
+		version,
+		true,
+	)
+}
+
+// getCurrent returns the current state of the document.
+func (n *diffNode) getCurrent() (*client.Document, error) {
+	docID, err := client.NewDocIDFromString(n.docID)
+	if err != nil {
+		return nil, err
+	}
+	return n.collection.Get(n.planner.ctx, docID, true)
+}
+
+func (n *diffNode) Start() error {
+	return nil
+}
+
+// Spans sets the docID of the document to yield the changes of, it only
+// cares about the first value in the span set.
+func (n *diffNode) Spans(spans core.Spans) {
+	if len(spans.Value) == 0 {
+		return
+	}
+	n.docID = spans.Value[0].Start().DocID
+}
+
+func (n *diffNode) Next() (bool, error) {
+	if len(n.changes) == 0 {
+		return false, nil
+	}
+	n.currentValue = n.changes[0]
+	n.changes = n.changes[1:]
+	return true, nil
+}
+
+func (n *diffNode) Close() error {
+	return nil
+}
+
+func (n *diffNode) Source() planNode { return nil }
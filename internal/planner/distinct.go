@@ -0,0 +1,169 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/db/fetcher"
+	"github.com/sourcenetwork/defradb/internal/planner/mapper"
+)
+
+// distinctNode yields only the first document of each unique combination of the values
+// of the distinct fields.
+type distinctNode struct {
+	docMapper
+
+	p    *Planner
+	plan planNode
+
+	distinctFields []mapper.Field
+
+	// seen holds the keys of the combinations that were already yielded.
+	seen map[string]struct{}
+
+	execInfo distinctExecInfo
+}
+
+type distinctExecInfo struct {
+	// Total number of times distinctNode was executed.
+	iterations uint64
+
+	// Total number of documents of the source that were skipped as duplicates.
+	duplicatesSkipped uint64
+}
+
+// Distinct creates a new distinctNode for the given select, it returns nil if the
+// select is not deduplicated.
+func (p *Planner) Distinct(parsed *mapper.Select) *distinctNode {
+	if parsed.Distinct == nil {
+		return nil
+	}
+	return &distinctNode{
+		p:              p,
+		distinctFields: parsed.Distinct.Fields,
+		docMapper:      docMapper{parsed.DocumentMapping},
+	}
+}
+
+func (n *distinctNode) Kind() string {
+	return "distinctNode"
+}
+
+func (n *distinctNode) Init() error {
+	n.seen = map[string]struct{}{}
+	return n.plan.Init()
+}
+
+func (n *distinctNode) Start() error           { return n.plan.Start() }
+func (n *distinctNode) Spans(spans core.Spans) { n.plan.Spans(spans) }
+func (n *distinctNode) Close() error           { return n.plan.Close() }
+func (n *distinctNode) Value() core.Doc        { return n.plan.Value() }
+func (n *distinctNode) Source() planNode       { return n.plan }
+
+func (n *distinctNode) Next() (bool, error) {
+	n.execInfo.iterations++
+
+	for {
+		hasNext, err := n.plan.Next()
+		if err != nil || !hasNext {
+			return hasNext, err
+		}
+
+		key := generateKey(n.plan.Value(), n.distinctFields)
+		if _, isDuplicate := n.seen[key]; !isDuplicate {
+			n.seen[key] = struct{}{}
+			return true, nil
+		}
+		n.execInfo.duplicatesSkipped++
+	}
+}
+
+// tryIndexScan makes the scan of the given select read the documents from the keys of a
+// value index holding every fetched field, so that no document has to be fetched.
+//
+// The keys of a value index are ordered by value and then by docID, so the first document
+// of each combination is the same as in a scan of the documents.
+func (n *distinctNode) tryIndexScan(s *selectNode) {
+	scan, ok := s.source.(*scanNode)
+	if !ok || scan.index.HasValue() || scan.spans.HasValue || scan.showDeleted ||
+		s.selectReq.Cid.HasValue() || s.selectReq.AsOf.HasValue() || s.selectReq.Similar.HasValue() {
+		return
+	}
+	// documents read from index keys are not checked against the policy of the collection.
+	if scan.col.Description().Policy.HasValue() {
+		return
+	}
+	// the keys of the whole index are read without being checked against the filter, filters
+	// that the index can serve have already made the scan use it.
+	if scan.filter != nil {
+		return
+	}
+
+	indexes := filterUsableIndexes(scan.col.Description().Indexes, scan.col.Definition(), nil)
+	for _, index := range indexes {
+		if index.Type == client.IndexTypeValue && indexHoldsFields(index, scan.fields) {
+			scan.initIndexScanFetcher(index, fetcher.IndexScanRange{})
+			return
+		}
+	}
+}
+
+// indexHoldsFields returns true if the keys of the given index hold the values of all the
+// given fields.
+func indexHoldsFields(index client.IndexDescription, fields []client.FieldDefinition) bool {
+	for _, field := range fields {
+		if field.Kind.IsArray() {
+			// the keys of an array index only hold one element of the array.
+			return false
+		}
+		found := false
+		for _, indexedField := range index.Fields {
+			if indexedField.Name == field.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (n *distinctNode) simpleExplain() (map[string]any, error) {
+	distinctFields := make([]string, len(n.distinctFields))
+	for i, field := range n.distinctFields {
+		distinctFields[i] = field.Name
+	}
+	return map[string]any{
+		"distinctFields": distinctFields,
+	}, nil
+}
+
+// Explain method returns a map containing all attributes of this node that
+// are to be explained, subscribes / opts-in this node to be an explainablePlanNode.
+func (n *distinctNode) Explain(explainType request.ExplainType) (map[string]any, error) {
+	switch explainType {
+	case request.SimpleExplain:
+		return n.simpleExplain()
+
+	case request.ExecuteExplain:
+		return map[string]any{
+			"iterations":        n.execInfo.iterations,
+			"duplicatesSkipped": n.execInfo.duplicatesSkipped,
+		}, nil
+
+	default:
+		return nil, ErrUnknownExplainRequestType
+	}
+}
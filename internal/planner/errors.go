@@ -17,6 +17,10 @@ const (
 	errFailedToClosePlan              string = "failed to close the plan"
 	errFailedToCollectExecExplainInfo string = "failed to collect execution explain information"
 	errSubTypeInit                    string = "sub-type initialization error at scan node reset"
+	errInvalidConflictHead            string = "invalid conflict head"
+	errInvalidDiffVersion             string = "the given version is not a version of the document"
+	errInvalidCursor                  string = "invalid cursor"
+	errInvalidPercentile              string = "percentile must be between 0 and 100"
 )
 
 var (
@@ -35,6 +39,9 @@ var (
 	ErrUnknownRelationType                 = errors.New("failed sub selection, unknown relation type")
 	ErrUnknownExplainRequestType           = errors.New("can not explain request of unknown type")
 	ErrUpsertMultipleDocuments             = errors.New("cannot upsert multiple matching documents")
+	ErrInvalidDiffVersion                  = errors.New(errInvalidDiffVersion)
+	ErrInvalidCursor                       = errors.New(errInvalidCursor)
+	ErrInvalidPercentile                   = errors.New(errInvalidPercentile)
 )
 
 func NewErrUnknownDependency(name string) error {
@@ -52,3 +59,19 @@ func NewErrFailedToCollectExecExplainInfo(inner error) error {
 func NewErrSubTypeInit(inner error) error {
 	return errors.Wrap(errSubTypeInit, inner)
 }
+
+func NewErrInvalidConflictHead(head string, inner error) error {
+	return errors.Wrap(errInvalidConflictHead, inner, errors.NewKV("Head", head))
+}
+
+func NewErrInvalidDiffVersion(version string) error {
+	return errors.New(errInvalidDiffVersion, errors.NewKV("Version", version))
+}
+
+func NewErrInvalidCursor(cursor string, inner error) error {
+	return errors.Wrap(errInvalidCursor, inner, errors.NewKV("Cursor", cursor))
+}
+
+func NewErrInvalidPercentile(percentile float64) error {
+	return errors.New(errInvalidPercentile, errors.NewKV("Percentile", percentile))
+}
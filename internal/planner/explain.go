@@ -32,11 +32,14 @@ type explainablePlanNode interface {
 
 // Compile time check for all planNodes that should be explainable (satisfy explainablePlanNode).
 var (
+	_ explainablePlanNode = (*aggregateFilterNode)(nil)
 	_ explainablePlanNode = (*averageNode)(nil)
 	_ explainablePlanNode = (*countNode)(nil)
 	_ explainablePlanNode = (*createNode)(nil)
+	_ explainablePlanNode = (*cursorNode)(nil)
 	_ explainablePlanNode = (*dagScanNode)(nil)
 	_ explainablePlanNode = (*deleteNode)(nil)
+	_ explainablePlanNode = (*distinctNode)(nil)
 	_ explainablePlanNode = (*groupNode)(nil)
 	_ explainablePlanNode = (*limitNode)(nil)
 	_ explainablePlanNode = (*maxNode)(nil)
@@ -45,6 +48,8 @@ var (
 	_ explainablePlanNode = (*scanNode)(nil)
 	_ explainablePlanNode = (*selectNode)(nil)
 	_ explainablePlanNode = (*selectTopNode)(nil)
+	_ explainablePlanNode = (*similarNode)(nil)
+	_ explainablePlanNode = (*statisticNode)(nil)
 	_ explainablePlanNode = (*sumNode)(nil)
 	_ explainablePlanNode = (*topLevelNode)(nil)
 	_ explainablePlanNode = (*typeIndexJoin)(nil)
@@ -0,0 +1,39 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package filter
+
+import "github.com/sourcenetwork/defradb/errors"
+
+const (
+	errIndexFilterOnRelation   string = "an index filter can not have conditions on relation fields"
+	errInvalidIndexFilterValue string = "invalid index filter value"
+)
+
+var (
+	ErrIndexFilterOnRelation   = errors.New(errIndexFilterOnRelation)
+	ErrInvalidIndexFilterValue = errors.New(errInvalidIndexFilterValue)
+)
+
+// NewErrIndexFilterOnRelation returns an error indicating that the filter of an index
+// has conditions on the given relation field.
+func NewErrIndexFilterOnRelation(name string) error {
+	return errors.New(errIndexFilterOnRelation, errors.NewKV("Field", name))
+}
+
+// NewErrInvalidIndexFilterValue returns an error indicating that the filter of an index
+// has a value that can not be compared with the values of the given field.
+func NewErrInvalidIndexFilterValue(name string, value any) error {
+	return errors.New(
+		errInvalidIndexFilterValue,
+		errors.NewKV("Field", name),
+		errors.NewKV("Value", value),
+	)
+}
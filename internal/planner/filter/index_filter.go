@@ -0,0 +1,399 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package filter
+
+import (
+	"math"
+	"time"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/internal/connor"
+	"github.com/sourcenetwork/defradb/internal/connor/numbers"
+	"github.com/sourcenetwork/defradb/internal/planner/mapper"
+)
+
+// NormalizeIndexFilter validates the filter of a partial index against the given collection
+// and returns a copy of it with its values converted to the types used by request filters.
+//
+// The filter of an index is stored as JSON, so for example the values of Int fields are
+// decoded as float64 and the values of DateTime fields as strings.
+func NormalizeIndexFilter(
+	conditions map[string]any,
+	col client.CollectionDefinition,
+) (map[string]any, error) {
+	result := make(map[string]any, len(conditions))
+	for key, value := range conditions {
+		switch key {
+		case request.FilterOpAnd, request.FilterOpOr:
+			list, ok := value.([]any)
+			if !ok {
+				return nil, client.NewErrUnexpectedType[[]any](key, value)
+			}
+			normalized := make([]any, len(list))
+			for i, item := range list {
+				itemConditions, ok := item.(map[string]any)
+				if !ok {
+					return nil, client.NewErrUnexpectedType[map[string]any](key, item)
+				}
+				normalizedItem, err := NormalizeIndexFilter(itemConditions, col)
+				if err != nil {
+					return nil, err
+				}
+				normalized[i] = normalizedItem
+			}
+			result[key] = normalized
+
+		case request.FilterOpNot:
+			notConditions, ok := value.(map[string]any)
+			if !ok {
+				return nil, client.NewErrUnexpectedType[map[string]any](key, value)
+			}
+			normalized, err := NormalizeIndexFilter(notConditions, col)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = normalized
+
+		default:
+			field, ok := col.GetFieldByName(key)
+			if !ok {
+				return nil, client.NewErrFieldNotExist(key)
+			}
+			if field.Kind.IsObject() {
+				return nil, NewErrIndexFilterOnRelation(key)
+			}
+			normalized, err := normalizeIndexFilterOperators(field, value)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = normalized
+		}
+	}
+	return result, nil
+}
+
+// normalizeIndexFilterOperators normalizes the operators applied to the given field.
+func normalizeIndexFilterOperators(field client.FieldDefinition, value any) (map[string]any, error) {
+	operators, ok := value.(map[string]any)
+	if !ok || len(operators) == 0 {
+		return nil, client.NewErrUnexpectedType[map[string]any](field.Name, value)
+	}
+	result := make(map[string]any, len(operators))
+	for op, opValue := range operators {
+		switch op {
+		case connor.AnyOp, connor.AllOp, connor.NoneOp:
+			normalized, err := normalizeIndexFilterOperators(field, opValue)
+			if err != nil {
+				return nil, err
+			}
+			result[op] = normalized
+
+		case connor.InOp, connor.NotInOp:
+			list, ok := opValue.([]any)
+			if !ok {
+				return nil, client.NewErrUnexpectedType[[]any](op, opValue)
+			}
+			normalized := make([]any, len(list))
+			for i, item := range list {
+				normalizedItem, err := normalizeIndexFilterValue(field, item)
+				if err != nil {
+					return nil, err
+				}
+				normalized[i] = normalizedItem
+			}
+			result[op] = normalized
+
+		case connor.SearchOp, connor.WithinRadiusOp, connor.WithinBoxOp:
+			// these operators rely on the indexes they are evaluated with
+			return nil, connor.NewErrUnknownOperator(op)
+
+		default:
+			if !connor.IsOpSimple(op) {
+				return nil, connor.NewErrUnknownOperator(op)
+			}
+			normalized, err := normalizeIndexFilterValue(field, opValue)
+			if err != nil {
+				return nil, err
+			}
+			result[op] = normalized
+		}
+	}
+	return result, nil
+}
+
+// normalizeIndexFilterValue converts the given value to the type the values of the
+// given field have in request filters.
+func normalizeIndexFilterValue(field client.FieldDefinition, value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	switch field.Kind {
+	case client.FieldKind_NILLABLE_INT, client.FieldKind_INT_ARRAY, client.FieldKind_NILLABLE_INT_ARRAY:
+		switch v := numbers.TryUpcast(value).(type) {
+		case int64:
+			return v, nil
+		case float64:
+			if v == math.Trunc(v) {
+				return int64(v), nil
+			}
+		}
+
+	case client.FieldKind_NILLABLE_FLOAT, client.FieldKind_FLOAT_ARRAY, client.FieldKind_NILLABLE_FLOAT_ARRAY:
+		switch v := numbers.TryUpcast(value).(type) {
+		case int64:
+			return float64(v), nil
+		case float64:
+			return v, nil
+		}
+
+	case client.FieldKind_NILLABLE_DATETIME:
+		switch v := value.(type) {
+		case time.Time:
+			return v, nil
+		case string:
+			t, err := time.Parse(time.RFC3339, v)
+			if err == nil {
+				return t, nil
+			}
+		}
+
+	case client.FieldKind_NILLABLE_BOOL, client.FieldKind_BOOL_ARRAY, client.FieldKind_NILLABLE_BOOL_ARRAY:
+		if _, ok := value.(bool); ok {
+			return value, nil
+		}
+
+	case client.FieldKind_DocID, client.FieldKind_NILLABLE_STRING,
+		client.FieldKind_STRING_ARRAY, client.FieldKind_NILLABLE_STRING_ARRAY:
+		if _, ok := value.(string); ok {
+			return value, nil
+		}
+
+	default:
+		return value, nil
+	}
+	return nil, NewErrInvalidIndexFilterValue(field.Name, value)
+}
+
+// Implies returns true if every document matching the given conditions also matches
+// the given predicate.
+//
+// Both are expected to be name-keyed filter conditions with values of the types used by
+// request filters. The check is conservative and only looks at the top level conjunction
+// of the conditions, so it may return false for conditions that do imply the predicate.
+func Implies(conditions, predicate map[string]any) bool {
+	for key, value := range predicate {
+		switch key {
+		case request.FilterOpAnd:
+			list, ok := value.([]any)
+			if !ok {
+				return false
+			}
+			for _, item := range list {
+				itemPredicate, ok := item.(map[string]any)
+				if !ok || !Implies(conditions, itemPredicate) {
+					return false
+				}
+			}
+
+		case request.FilterOpOr:
+			list, ok := value.([]any)
+			if !ok || !impliesAny(conditions, list) {
+				return false
+			}
+
+		case request.FilterOpNot:
+			notPredicate, ok := value.(map[string]any)
+			if !ok || !contradicts(conditions, notPredicate) {
+				return false
+			}
+
+		default:
+			operators, ok := value.(map[string]any)
+			if !ok {
+				return false
+			}
+			for op, opValue := range operators {
+				if !propertyImplies(conditions, key, op, opValue) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// impliesAny returns true if every document matching the given conditions also matches
+// at least one of the given predicates.
+func impliesAny(conditions map[string]any, predicates []any) bool {
+	for _, item := range predicates {
+		if itemPredicate, ok := item.(map[string]any); ok && Implies(conditions, itemPredicate) {
+			return true
+		}
+	}
+	// each branch of an _or of the conditions may imply a different predicate
+	for _, orConditions := range conjunctionClauses(conditions, request.FilterOpOr) {
+		list, ok := orConditions.([]any)
+		if !ok || len(list) == 0 {
+			continue
+		}
+		impliedByAll := true
+		for _, item := range list {
+			itemConditions, ok := item.(map[string]any)
+			if !ok || !impliesAny(itemConditions, predicates) {
+				impliedByAll = false
+				break
+			}
+		}
+		if impliedByAll {
+			return true
+		}
+	}
+	return false
+}
+
+// contradicts returns true if no document matching the given conditions can match
+// the given predicate.
+func contradicts(conditions, predicate map[string]any) bool {
+	for key, value := range predicate {
+		if key == request.FilterOpAnd || key == request.FilterOpOr || key == request.FilterOpNot {
+			continue
+		}
+		operators, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+		for op, opValue := range operators {
+			for _, condOperators := range conjunctionClauses(conditions, key) {
+				condOperators, ok := condOperators.(map[string]any)
+				if !ok {
+					continue
+				}
+				for condOp, condValue := range condOperators {
+					if operatorContradicts(condOp, condValue, op, opValue) {
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
+// conjunctionClauses returns the clauses with the given key of the top level conjunction
+// of the given conditions.
+func conjunctionClauses(conditions map[string]any, key string) []any {
+	var clauses []any
+	if clause, ok := conditions[key]; ok {
+		clauses = append(clauses, clause)
+	}
+	list, _ := conditions[request.FilterOpAnd].([]any)
+	for _, item := range list {
+		if itemConditions, ok := item.(map[string]any); ok {
+			clauses = append(clauses, conjunctionClauses(itemConditions, key)...)
+		}
+	}
+	return clauses
+}
+
+// propertyImplies returns true if the top level conjunction of the given conditions restricts
+// the given property to values matching the given operator and value.
+func propertyImplies(conditions map[string]any, prop string, op string, value any) bool {
+	for _, operators := range conjunctionClauses(conditions, prop) {
+		operators, ok := operators.(map[string]any)
+		if !ok {
+			continue
+		}
+		for condOp, condValue := range operators {
+			if operatorImplies(condOp, condValue, op, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// operatorImplies returns true if every value matching the condition operator and value
+// also matches the given operator and value.
+func operatorImplies(condOp string, condValue any, op string, value any) bool {
+	switch condOp {
+	case connor.EqualOp:
+		return matchesOperator(op, value, condValue)
+
+	case connor.InOp:
+		list, ok := condValue.([]any)
+		if !ok {
+			return false
+		}
+		for _, item := range list {
+			if !matchesOperator(op, value, item) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if condOp == op && matchesOperator(connor.EqualOp, value, condValue) {
+		return true
+	}
+	if condValue == nil {
+		return false
+	}
+	switch {
+	case isLowerBound(condOp) && isLowerBound(op), isUpperBound(condOp) && isUpperBound(op):
+		// the bound of the condition must be at least as tight as the given one
+		return matchesOperator(op, value, condValue)
+
+	case isLowerBound(condOp) || isUpperBound(condOp):
+		// nil values never match a non-nil bound
+		return op == connor.NotEqualOp && value == nil
+	}
+	return false
+}
+
+// operatorContradicts returns true if no value matching the condition operator and value
+// can match the given operator and value.
+func operatorContradicts(condOp string, condValue any, op string, value any) bool {
+	switch condOp {
+	case connor.EqualOp:
+		return !matchesOperator(op, value, condValue)
+
+	case connor.InOp:
+		list, ok := condValue.([]any)
+		if !ok {
+			return false
+		}
+		for _, item := range list {
+			if matchesOperator(op, value, item) {
+				return false
+			}
+		}
+		return true
+
+	case connor.NotEqualOp:
+		return op == connor.EqualOp && matchesOperator(connor.EqualOp, value, condValue)
+	}
+	return false
+}
+
+// matchesOperator returns true if the given data matches the given operator and value.
+func matchesOperator(op string, value any, data any) bool {
+	matches, err := connor.Match(map[connor.FilterKey]any{&mapper.Operator{Operation: op}: value}, data)
+	return err == nil && matches
+}
+
+func isLowerBound(op string) bool {
+	return op == connor.GreaterOp || op == connor.GreaterOrEqualOp
+}
+
+func isUpperBound(op string) bool {
+	return op == connor.LesserOp || op == connor.LesserOrEqualOp
+}
@@ -0,0 +1,299 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+package filter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func getIndexFilterColDefinition() client.CollectionDefinition {
+	return client.CollectionDefinition{
+		Schema: client.SchemaDescription{
+			Fields: []client.SchemaFieldDescription{
+				{Name: "status", Kind: client.FieldKind_NILLABLE_STRING},
+				{Name: "age", Kind: client.FieldKind_NILLABLE_INT},
+				{Name: "score", Kind: client.FieldKind_NILLABLE_FLOAT},
+				{Name: "created", Kind: client.FieldKind_NILLABLE_DATETIME},
+				{Name: "author", Kind: client.NewNamedKind("Author", false)},
+			},
+		},
+	}
+}
+
+func TestNormalizeIndexFilter(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name       string
+		conditions map[string]any
+		expected   map[string]any
+	}{
+		{
+			name:       "string value",
+			conditions: m("status", m("_eq", "open")),
+			expected:   m("status", m("_eq", "open")),
+		},
+		{
+			name:       "json number of int field",
+			conditions: m("age", m("_gt", float64(20))),
+			expected:   m("age", m("_gt", int64(20))),
+		},
+		{
+			name:       "int of float field",
+			conditions: m("score", m("_ge", 1)),
+			expected:   m("score", m("_ge", float64(1))),
+		},
+		{
+			name:       "datetime string",
+			conditions: m("created", m("_lt", "2024-01-02T03:04:05Z")),
+			expected:   m("created", m("_lt", created)),
+		},
+		{
+			name:       "_in list",
+			conditions: m("age", m("_in", []any{float64(1), float64(2)})),
+			expected:   m("age", m("_in", []any{int64(1), int64(2)})),
+		},
+		{
+			name: "compound operators",
+			conditions: map[string]any{
+				"_or": []any{
+					m("age", m("_eq", nil)),
+					m("_not", m("age", m("_lt", float64(3)))),
+				},
+			},
+			expected: map[string]any{
+				"_or": []any{
+					m("age", m("_eq", nil)),
+					m("_not", m("age", m("_lt", int64(3)))),
+				},
+			},
+		},
+	}
+
+	col := getIndexFilterColDefinition()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := NormalizeIndexFilter(tt.conditions, col)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestNormalizeIndexFilter_WithInvalidConditions_ShouldError(t *testing.T) {
+	tests := []struct {
+		name        string
+		conditions  map[string]any
+		expectedErr string
+	}{
+		{
+			name:        "unknown field",
+			conditions:  m("unknown", m("_eq", "open")),
+			expectedErr: "does not exist",
+		},
+		{
+			name:        "relation field",
+			conditions:  m("author", m("name", m("_eq", "John"))),
+			expectedErr: errIndexFilterOnRelation,
+		},
+		{
+			name:        "value without operator",
+			conditions:  m("status", "open"),
+			expectedErr: "unexpected type",
+		},
+		{
+			name:        "fractional value of int field",
+			conditions:  m("age", m("_eq", 1.5)),
+			expectedErr: errInvalidIndexFilterValue,
+		},
+		{
+			name:        "invalid datetime",
+			conditions:  m("created", m("_eq", "yesterday")),
+			expectedErr: errInvalidIndexFilterValue,
+		},
+		{
+			name:        "unknown operator",
+			conditions:  m("status", m("_unknown", "open")),
+			expectedErr: "unknown operator",
+		},
+	}
+
+	col := getIndexFilterColDefinition()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NormalizeIndexFilter(tt.conditions, col)
+			require.ErrorContains(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestImplies(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions map[string]any
+		predicate  map[string]any
+		expected   bool
+	}{
+		{
+			name:       "same condition",
+			conditions: m("status", m("_eq", "open")),
+			predicate:  m("status", m("_eq", "open")),
+			expected:   true,
+		},
+		{
+			name:       "different value",
+			conditions: m("status", m("_eq", "closed")),
+			predicate:  m("status", m("_eq", "open")),
+			expected:   false,
+		},
+		{
+			name:       "no condition on the field",
+			conditions: m("age", m("_eq", int64(3))),
+			predicate:  m("status", m("_eq", "open")),
+			expected:   false,
+		},
+		{
+			name: "condition within _and",
+			conditions: map[string]any{
+				"_and": []any{
+					m("age", m("_gt", int64(3))),
+					m("status", m("_eq", "open")),
+				},
+			},
+			predicate: m("status", m("_eq", "open")),
+			expected:  true,
+		},
+		{
+			name:       "_eq implies _ne",
+			conditions: m("status", m("_eq", "open")),
+			predicate:  m("status", m("_ne", "archived")),
+			expected:   true,
+		},
+		{
+			name:       "_ne does not imply _eq",
+			conditions: m("status", m("_ne", "archived")),
+			predicate:  m("status", m("_eq", "open")),
+			expected:   false,
+		},
+		{
+			name:       "_in implies _in with more values",
+			conditions: m("status", m("_in", []any{"open", "new"})),
+			predicate:  m("status", m("_in", []any{"new", "pending", "open"})),
+			expected:   true,
+		},
+		{
+			name:       "_in does not imply _in with fewer values",
+			conditions: m("status", m("_in", []any{"open", "new"})),
+			predicate:  m("status", m("_in", []any{"open"})),
+			expected:   false,
+		},
+		{
+			name:       "tighter lower bound",
+			conditions: m("age", m("_gt", int32(30))),
+			predicate:  m("age", m("_ge", int64(18))),
+			expected:   true,
+		},
+		{
+			name:       "looser lower bound",
+			conditions: m("age", m("_ge", int32(18))),
+			predicate:  m("age", m("_gt", int64(18))),
+			expected:   false,
+		},
+		{
+			name:       "tighter upper bound",
+			conditions: m("age", m("_le", int32(10))),
+			predicate:  m("age", m("_lt", int64(18))),
+			expected:   true,
+		},
+		{
+			name:       "upper bound does not imply lower bound",
+			conditions: m("age", m("_lt", int32(10))),
+			predicate:  m("age", m("_gt", int64(0))),
+			expected:   false,
+		},
+		{
+			name:       "bound implies not nil",
+			conditions: m("age", m("_lt", int32(10))),
+			predicate:  m("age", m("_ne", nil)),
+			expected:   true,
+		},
+		{
+			name:       "_eq implies range",
+			conditions: m("age", m("_eq", int32(10))),
+			predicate:  m("age", map[string]any{"_gt": int64(5), "_lt": int64(18)}),
+			expected:   true,
+		},
+		{
+			name:       "_or predicate implied by one branch",
+			conditions: m("status", m("_eq", "open")),
+			predicate: m("_or", []any{
+				m("status", m("_eq", "new")),
+				m("status", m("_eq", "open")),
+			}),
+			expected: true,
+		},
+		{
+			name: "_or predicate implied by each branch of _or conditions",
+			conditions: m("_or", []any{
+				m("status", m("_eq", "new")),
+				m("status", m("_eq", "open")),
+			}),
+			predicate: m("_or", []any{
+				m("status", m("_eq", "open")),
+				m("status", m("_eq", "new")),
+			}),
+			expected: true,
+		},
+		{
+			name: "_or conditions do not imply property predicate",
+			conditions: m("_or", []any{
+				m("status", m("_eq", "new")),
+				m("status", m("_eq", "open")),
+			}),
+			predicate: m("status", m("_eq", "open")),
+			expected:  false,
+		},
+		{
+			name:       "_not predicate contradicted by _eq",
+			conditions: m("status", m("_eq", "open")),
+			predicate:  m("_not", m("status", m("_eq", "archived"))),
+			expected:   true,
+		},
+		{
+			name:       "_not predicate contradicted by _ne",
+			conditions: m("status", m("_ne", "archived")),
+			predicate:  m("_not", m("status", m("_eq", "archived"))),
+			expected:   true,
+		},
+		{
+			name:       "_not predicate not contradicted",
+			conditions: m("status", m("_eq", "archived")),
+			predicate:  m("_not", m("status", m("_eq", "archived"))),
+			expected:   false,
+		},
+		{
+			name:       "datetime bound",
+			conditions: m("created", m("_ge", time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))),
+			predicate:  m("created", m("_gt", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))),
+			expected:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Implies(tt.conditions, tt.predicate))
+		})
+	}
+}
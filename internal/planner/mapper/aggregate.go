@@ -10,7 +10,11 @@
 
 package mapper
 
-import "github.com/sourcenetwork/defradb/internal/core"
+import (
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/internal/core"
+)
 
 // An optional child target.
 type OptionalChildTarget struct {
@@ -54,6 +58,11 @@ type Aggregate struct {
 	//
 	// For example, Average is dependent on a Sum and Count field.
 	Dependencies []*Aggregate
+
+	// The percentile, from 0 to 100, that this aggregate should return.
+	//
+	// Only `_percentile` aggregates have a percentile.
+	Percentile immutable.Option[float64]
 }
 
 func (a *Aggregate) CloneTo(index int) Requestable {
@@ -65,5 +74,6 @@ func (a *Aggregate) cloneTo(index int) *Aggregate {
 		Field:            *a.Field.cloneTo(index),
 		DocumentMapping:  a.DocumentMapping,
 		AggregateTargets: a.AggregateTargets,
+		Percentile:       a.Percentile,
 	}
 }
@@ -0,0 +1,112 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package mapper
+
+import (
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/internal/connor"
+	"github.com/sourcenetwork/defradb/internal/core"
+)
+
+// splitAggregateFilter removes the conditions on aggregate fields from the filter of the
+// given select request and returns them.
+//
+// The conditions on aggregate fields are evaluated once the aggregates have been computed,
+// that is after the documents have been filtered and grouped, so they are only supported
+// at the top level of the filter.
+func splitAggregateFilter(selectRequest *request.Select) (map[string]any, error) {
+	if !selectRequest.Filter.HasValue() {
+		return nil, nil
+	}
+
+	var aggregateConditions map[string]any
+	conditions := map[string]any{}
+	for key, value := range selectRequest.Filter.Value().Conditions {
+		if _, isAggregate := request.Aggregates[key]; isAggregate {
+			if aggregateConditions == nil {
+				aggregateConditions = map[string]any{}
+			}
+			aggregateConditions[key] = value
+			continue
+		}
+		if containsAggregateCondition(value) {
+			return nil, NewErrNestedAggregateFilter(key)
+		}
+		conditions[key] = value
+	}
+
+	if aggregateConditions == nil {
+		return nil, nil
+	}
+	if len(conditions) == 0 {
+		selectRequest.Filter = immutable.None[request.Filter]()
+	} else {
+		selectRequest.Filter = immutable.Some(request.Filter{Conditions: conditions})
+	}
+	return aggregateConditions, nil
+}
+
+// containsAggregateCondition returns true if the given filter clause has a condition on an
+// aggregate field.
+func containsAggregateCondition(clause any) bool {
+	switch typedClause := clause.(type) {
+	case map[string]any:
+		for key, value := range typedClause {
+			if _, isAggregate := request.Aggregates[key]; isAggregate {
+				return true
+			}
+			if containsAggregateCondition(value) {
+				return true
+			}
+		}
+	case []any:
+		for _, value := range typedClause {
+			if containsAggregateCondition(value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// toAggregateFilter converts the given conditions on aggregate fields to a Filter using the
+// given mapping.
+//
+// Each condition applies to the first selected aggregate of the same name.
+func toAggregateFilter(conditions map[string]any, mapping *core.DocumentMapping) (*Filter, error) {
+	if len(conditions) == 0 {
+		return nil, nil
+	}
+
+	mappedConditions := make(map[connor.FilterKey]any, len(conditions))
+	for name, clause := range conditions {
+		indexes, isSelected := mapping.IndexesByName[name]
+		if !isSelected || len(indexes) == 0 {
+			return nil, NewErrFilteredAggregateNotSelected(name)
+		}
+
+		key := &PropertyIndex{
+			Index: indexes[0],
+		}
+		if typedClause, ok := clause.(map[string]any); ok {
+			mappedConditions[key] = toFilterMap(key, typedClause, mapping)
+		} else {
+			mappedConditions[key] = clause
+		}
+	}
+
+	return &Filter{
+		Conditions:         mappedConditions,
+		ExternalConditions: conditions,
+	}, nil
+}
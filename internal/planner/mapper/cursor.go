@@ -0,0 +1,79 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package mapper
+
+import (
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/internal/core"
+)
+
+// Cursor represents the cursor pagination of a select.
+//
+// The results of a select paginated with cursors are always ordered by docID last, so that
+// every result has a distinct position that its cursor can encode.
+type Cursor struct {
+	// The maximum number of results to return, counting from the start of the page.
+	First immutable.Option[uint64]
+
+	// The cursor of the result after which the page starts.
+	After immutable.Option[string]
+
+	// The maximum number of results to return, counting from the end of the page.
+	Last immutable.Option[uint64]
+
+	// The cursor of the result before which the page ends.
+	Before immutable.Option[string]
+}
+
+// toCursor returns the cursor pagination of the given select request.
+//
+// It has a value if the request has cursor arguments or if the `_cursor` field is requested.
+func toCursor(selectRequest *request.Select) immutable.Option[Cursor] {
+	if !selectRequest.HasCursorArgs() && !isCursorRequested(selectRequest) {
+		return immutable.None[Cursor]()
+	}
+	return immutable.Some(Cursor{
+		First:  selectRequest.First,
+		After:  selectRequest.After,
+		Last:   selectRequest.Last,
+		Before: selectRequest.Before,
+	})
+}
+
+func isCursorRequested(selectRequest *request.Select) bool {
+	for _, field := range selectRequest.Fields {
+		if f, ok := field.(*request.Field); ok && f.Name == request.CursorFieldName {
+			return true
+		}
+	}
+	return false
+}
+
+// withDocIDOrder returns the given order with a trailing ascending docID condition, unless
+// the order already has a docID condition.
+func withDocIDOrder(orderBy *OrderBy) *OrderBy {
+	var conditions []OrderCondition
+	if orderBy != nil {
+		for _, condition := range orderBy.Conditions {
+			if len(condition.FieldIndexes) == 1 && condition.FieldIndexes[0] == core.DocIDFieldIndex {
+				return orderBy
+			}
+		}
+		conditions = append(conditions, orderBy.Conditions...)
+	}
+	conditions = append(conditions, OrderCondition{
+		FieldIndexes: []int{core.DocIDFieldIndex},
+		Direction:    ASC,
+	})
+	return &OrderBy{Conditions: conditions}
+}
@@ -13,8 +13,15 @@ package mapper
 import "github.com/sourcenetwork/defradb/errors"
 
 const (
-	errInvalidFieldToGroupBy string = "invalid field value to groupBy"
-	errTypeNotFound          string = "type not found"
+	errInvalidFieldToGroupBy        string = "invalid field value to groupBy"
+	errInvalidFieldToDistinct       string = "invalid field value to distinct"
+	errFilteredAggregateNotSelected string = "filtered aggregate must be selected"
+	errNestedAggregateFilter        string = "aggregates can only be filtered at the top level of a select filter"
+	errInvalidOrderAggregate        string = "invalid aggregate to order by"
+	errFieldReferenceNotFound       string = "referenced field not found"
+	errInvalidParentFieldReference  string = "parent fields can only be referenced in the filter of a related object"
+	errTypeNotFound                 string = "type not found"
+	errSimilarFieldNotVector        string = "_similar field must be a vector field"
 )
 
 var (
@@ -24,12 +31,42 @@ var (
 	ErrInvalidFieldIndex        = errors.New("given field doesn't have any indexes")
 	ErrMissingSelect            = errors.New("missing target select field")
 	ErrInvalidSelect            = errors.New("select type is invalid")
+	ErrSimilarFieldNotVector    = errors.New(errSimilarFieldNotVector)
+	ErrSimilarInvalidK          = errors.New("_similar k must be greater than zero")
 )
 
 func NewErrInvalidFieldToGroupBy(field string) error {
 	return errors.New(errInvalidFieldToGroupBy, errors.NewKV("Field", field))
 }
 
+func NewErrInvalidFieldToDistinct(field string) error {
+	return errors.New(errInvalidFieldToDistinct, errors.NewKV("Field", field))
+}
+
+func NewErrFilteredAggregateNotSelected(name string) error {
+	return errors.New(errFilteredAggregateNotSelected, errors.NewKV("Name", name))
+}
+
+func NewErrNestedAggregateFilter(field string) error {
+	return errors.New(errNestedAggregateFilter, errors.NewKV("Field", field))
+}
+
+func NewErrInvalidOrderAggregate(name string) error {
+	return errors.New(errInvalidOrderAggregate, errors.NewKV("Name", name))
+}
+
+func NewErrFieldReferenceNotFound(name string) error {
+	return errors.New(errFieldReferenceNotFound, errors.NewKV("Name", name))
+}
+
+func NewErrInvalidParentFieldReference(name string) error {
+	return errors.New(errInvalidParentFieldReference, errors.NewKV("Name", name))
+}
+
 func NewErrTypeNotFound(name string) error {
 	return errors.New(errTypeNotFound, errors.NewKV("Type", name))
 }
+
+func NewErrSimilarFieldNotVector(field string) error {
+	return errors.New(errSimilarFieldNotVector, errors.NewKV("Field", field))
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package mapper
+
+import (
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/internal/connor"
+	"github.com/sourcenetwork/defradb/internal/core"
+)
+
+// toFieldReference converts the given requested field reference into a filter value.
+//
+// References to the parent document are resolved later, once the mapping of the parent
+// is known.
+func toFieldReference(ref request.FieldReference, mapping *core.DocumentMapping) connor.ValueReference {
+	if ref.IsParent {
+		return &ParentFieldReference{
+			Index: -1,
+			Name:  ref.Name,
+		}
+	}
+
+	index := -1
+	if mapping != nil && len(mapping.IndexesByName[ref.Name]) > 0 {
+		index = mapping.FirstIndexOfName(ref.Name)
+	}
+	return &FieldReference{
+		Index: index,
+		Name:  ref.Name,
+	}
+}
+
+// fieldReferenceNames returns the names of the fields of the same document referenced by
+// the operators of the given property clause, for example `publishedAt` in
+// `{_gt: {_field: "publishedAt"}}`.
+func fieldReferenceNames(clause any) []string {
+	operators, _ := clause.(map[string]any)
+
+	var names []string
+	for _, value := range operators {
+		if ref, ok := value.(request.FieldReference); ok && !ref.IsParent {
+			names = append(names, ref.Name)
+		}
+	}
+	return names
+}
+
+// walkFieldReferences calls the given function for every field reference within the
+// given conditions.
+func walkFieldReferences(condition any, visit func(connor.ValueReference) error) error {
+	switch c := condition.(type) {
+	case map[connor.FilterKey]any:
+		for _, value := range c {
+			if err := walkFieldReferences(value, visit); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for _, value := range c {
+			if err := walkFieldReferences(value, visit); err != nil {
+				return err
+			}
+		}
+	case connor.ValueReference:
+		return visit(c)
+	}
+	return nil
+}
+
+// checkFieldReferences returns an error if the given filter references a field that
+// does not exist, or a parent field that has not been resolved.
+func checkFieldReferences(filter *Filter) error {
+	if filter == nil {
+		return nil
+	}
+
+	return walkFieldReferences(filter.Conditions, func(ref connor.ValueReference) error {
+		switch r := ref.(type) {
+		case *FieldReference:
+			if r.Index < 0 {
+				return NewErrFieldReferenceNotFound(r.Name)
+			}
+		case *ParentFieldReference:
+			if r.Parent == nil {
+				return NewErrInvalidParentFieldReference(r.Name)
+			}
+		}
+		return nil
+	})
+}
+
+// resolveParentFieldReferences resolves the parent field references of the given filter
+// against the given parent mapping.
+//
+// It returns the document the references read from, which the join must set to the parent
+// document before fetching the related documents. It is nil if the filter does not reference
+// the parent.
+func resolveParentFieldReferences(filter *Filter, parentMapping *core.DocumentMapping) (*core.Doc, error) {
+	if filter == nil {
+		return nil, nil
+	}
+
+	var parentDoc *core.Doc
+	err := walkFieldReferences(filter.Conditions, func(ref connor.ValueReference) error {
+		r, ok := ref.(*ParentFieldReference)
+		if !ok {
+			return nil
+		}
+		if len(parentMapping.IndexesByName[r.Name]) == 0 {
+			return NewErrFieldReferenceNotFound(r.Name)
+		}
+		if parentDoc == nil {
+			parentDoc = &core.Doc{}
+		}
+		r.Index = parentMapping.FirstIndexOfName(r.Name)
+		r.Parent = parentDoc
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parentDoc, nil
+}
+
+// parentFieldDependencies returns the parent fields referenced by the given filter, so that
+// they can be fetched along with the parent document.
+func parentFieldDependencies(filter *Filter) []Requestable {
+	if filter == nil {
+		return nil
+	}
+
+	var fields []Requestable
+	_ = walkFieldReferences(filter.Conditions, func(ref connor.ValueReference) error {
+		if r, ok := ref.(*ParentFieldReference); ok {
+			fields = append(fields, &Field{
+				Index: r.Index,
+				Name:  r.Name,
+			})
+		}
+		return nil
+	})
+	return fields
+}
+
+// hasFieldWithIndex returns true if one of the given fields has the given index.
+func hasFieldWithIndex(fields []Requestable, index int) bool {
+	for _, field := range fields {
+		if field.GetIndex() == index {
+			return true
+		}
+	}
+	return false
+}
@@ -39,6 +39,8 @@ type SelectionType int
 const (
 	ObjectSelection SelectionType = iota
 	CommitSelection
+	ConflictSelection
+	DiffSelection
 )
 
 // ToOperation converts the given [request.OperationDefinition] into an [Operation].
@@ -65,7 +67,7 @@ func ToOperation(
 			operation.addSelection(i, t.Field, s.Select)
 
 		case *request.Select:
-			s, err := toSelect(ctx, store, ObjectSelection, i, t, "")
+			s, err := toSelect(ctx, store, ObjectSelection, i, t, "", nil)
 			if err != nil {
 				return nil, err
 			}
@@ -99,7 +101,7 @@ func ToSelect(
 	selectRequest *request.Select,
 ) (*Select, error) {
 	// the top-level select will always have index=0, and no parent collection name
-	return toSelect(ctx, store, rootSelectType, 0, selectRequest, "")
+	return toSelect(ctx, store, rootSelectType, 0, selectRequest, "", nil)
 }
 
 // toSelect converts the given [parser.Select] into a [Select].
@@ -113,11 +115,16 @@ func toSelect(
 	thisIndex int,
 	selectRequest *request.Select,
 	parentCollectionName string,
+	parentMapping *core.DocumentMapping,
 ) (*Select, error) {
 	if rootSelectType == ObjectSelection && selectRequest.Name == request.VersionFieldName {
 		// WARNING: This is a weird quirk upon which some of the mapper code is dependent upon
 		// please remove it if/when you have chance to.
 		rootSelectType = CommitSelection
+	} else if rootSelectType == ObjectSelection && selectRequest.Name == request.ConflictsFieldName {
+		rootSelectType = ConflictSelection
+	} else if rootSelectType == ObjectSelection && selectRequest.Name == request.DiffFieldName {
+		rootSelectType = DiffSelection
 	}
 
 	collectionName, err := getCollectionName(ctx, store, rootSelectType, selectRequest, parentCollectionName)
@@ -130,6 +137,11 @@ func toSelect(
 		return nil, err
 	}
 
+	aggregateConditions, err := splitAggregateFilter(selectRequest)
+	if err != nil {
+		return nil, err
+	}
+
 	fields, aggregates, err := getRequestables(ctx, rootSelectType, selectRequest, mapping, collectionName, store)
 	if err != nil {
 		return nil, err
@@ -143,6 +155,12 @@ func toSelect(
 	}
 	fields = append(fields, filterDependencies...)
 
+	orderAggregates, err := getOrderAggregates(selectRequest.OrderBy, mapping)
+	if err != nil {
+		return nil, err
+	}
+	aggregates = append(aggregates, orderAggregates...)
+
 	// Resolve order dependencies that may have been missed due to not being rendered.
 	err = resolveOrderDependencies(
 		ctx, store, rootSelectType, collectionName, selectRequest.OrderBy, mapping, &fields)
@@ -208,10 +226,51 @@ func toSelect(
 		}
 	}
 
+	distinct, err := toDistinct(selectRequest.Distinct, definition, mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregateFilter, err := toAggregateFilter(aggregateConditions, mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	similar, err := toSimilar(selectRequest.Similar, mapping, definition)
+	if err != nil {
+		return nil, err
+	}
+
+	targetable := toTargetable(thisIndex, selectRequest, mapping)
+	var parentDoc *core.Doc
+	if parentMapping != nil {
+		parentDoc, err = resolveParentFieldReferences(targetable.Filter, parentMapping)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = checkFieldReferences(targetable.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor := toCursor(selectRequest)
+	if cursor.HasValue() {
+		targetable.OrderBy = withDocIDOrder(targetable.OrderBy)
+	}
+
 	return &Select{
-		Targetable:      toTargetable(thisIndex, selectRequest, mapping),
+		Targetable:      targetable,
 		DocumentMapping: mapping,
 		Cid:             selectRequest.CID,
+		AsOf:            selectRequest.AsOf,
+		DiffFrom:        selectRequest.DiffFrom,
+		DiffTo:          selectRequest.DiffTo,
+		Similar:         similar,
+		Cursor:          cursor,
+		Distinct:        distinct,
+		AggregateFilter: aggregateFilter,
+		ParentDoc:       parentDoc,
 		CollectionName:  collectionName,
 		Fields:          fields,
 	}, nil
@@ -232,11 +291,26 @@ func resolveOrderDependencies(
 		return nil
 	}
 
+	hostMapping := mapping
 	currentExistingFields := existingFields
 	// If there is orderby, and any one of the condition fields that are join fields and have not been
 	// requested, we need to map them here.
 outer:
 	for _, condition := range source.Value().Conditions {
+		if isOrderAggregate(condition) {
+			// Aggregates ordered by are mapped by getOrderAggregates, which is only done for selects.
+			if _, isMapped := hostMapping.IndexesByName[orderAggregateName(condition)]; !isMapped {
+				return NewErrInvalidOrderAggregate(condition.Fields[0])
+			}
+			continue
+		}
+		for _, field := range condition.Fields[1:] {
+			if _, isAggregate := request.Aggregates[field]; isAggregate {
+				// Only the aggregates of the ordered documents can be ordered by.
+				return NewErrInvalidOrderAggregate(field)
+			}
+		}
+
 		fields := condition.Fields[:] // copy slice
 		for {
 			numFields := len(fields)
@@ -318,7 +392,7 @@ func resolveChildOrder(
 				Name: orderChildField,
 			},
 		}
-		innerSelect, err := toSelect(ctx, store, rootSelectType, index, &dummyJoinFieldSelect, descName)
+		innerSelect, err := toSelect(ctx, store, rootSelectType, index, &dummyJoinFieldSelect, descName, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -405,6 +479,10 @@ func resolveAggregates(
 					childObjectIndex := mapping.FirstIndexOfName(target.hostExternalName)
 					childMapping := mapping.ChildMappings[childObjectIndex]
 					convertedFilter = ToFilter(target.filter.Value(), childMapping)
+					err := checkFieldReferences(convertedFilter)
+					if err != nil {
+						return nil, err
+					}
 					host, hasHost = tryGetTarget(
 						target.hostExternalName,
 						convertedFilter,
@@ -479,6 +557,10 @@ func resolveAggregates(
 				// If the child was not mapped, the filter will not have been converted yet
 				// so we must do that now.
 				convertedFilter = ToFilter(target.filter.Value(), mapping.ChildMappings[index])
+				err = checkFieldReferences(convertedFilter)
+				if err != nil {
+					return nil, err
+				}
 
 				dummyJoin := &Select{
 					Targetable: Targetable{
@@ -554,6 +636,7 @@ func resolveAggregates(
 			Field:            aggregate.field,
 			DocumentMapping:  mapping,
 			AggregateTargets: aggregateTargets,
+			Percentile:       aggregate.percentile,
 		}
 		fields = append(fields, &newAggregate)
 		dependenciesByParentId[aggregate.field.Index] = aggregate.dependencyIndexes
@@ -760,11 +843,19 @@ func getRequestables(
 		case *request.Select:
 			index := mapping.GetNextIndex()
 
-			innerSelect, err := toSelect(ctx, store, rootSelectType, index, f, collectionName)
+			// Only the filters of related objects may reference the fields of their parent, as
+			// the parent document is provided by the join.
+			var parentMapping *core.DocumentMapping
+			if rootSelectType == ObjectSelection && !strings.HasPrefix(f.Name, "_") {
+				parentMapping = mapping
+			}
+
+			innerSelect, err := toSelect(ctx, store, rootSelectType, index, f, collectionName, parentMapping)
 			if err != nil {
 				return nil, nil, err
 			}
 			fields = append(fields, innerSelect)
+			fields = append(fields, parentFieldDependencies(innerSelect.Filter)...)
 			mapping.SetChildAt(index, innerSelect.DocumentMapping)
 
 			mapping.RenderKeys = append(mapping.RenderKeys, core.RenderKey{
@@ -817,7 +908,8 @@ func getAggregateRequests(index int, aggregate *request.Aggregate) (aggregateReq
 			Index: index,
 			Name:  aggregate.Name,
 		},
-		targets: aggregateTargets,
+		targets:    aggregateTargets,
+		percentile: aggregate.Percentile,
 	}, nil
 }
 
@@ -836,7 +928,9 @@ func getCollectionName(
 
 	if selectRequest.Name == request.GroupFieldName {
 		return parentCollectionName, nil
-	} else if rootSelectType == CommitSelection {
+	} else if rootSelectType == CommitSelection ||
+		rootSelectType == ConflictSelection ||
+		rootSelectType == DiffSelection {
 		return parentCollectionName, nil
 	}
 
@@ -929,11 +1023,30 @@ func getTopLevelInfo(
 		mapping.SetTypeName(collectionName)
 
 		mapping.Add(mapping.GetNextIndex(), request.DeletedFieldName)
+		mapping.Add(mapping.GetNextIndex(), request.ScoreFieldName)
+		mapping.Add(mapping.GetNextIndex(), request.DistanceFieldName)
+		mapping.Add(mapping.GetNextIndex(), request.CursorFieldName)
 
 		return mapping, definition, nil
 	}
 
-	if selectRequest.Name == request.LinksFieldName {
+	if rootSelectType == ConflictSelection {
+		for i, f := range request.ConflictFields {
+			mapping.Add(i, f)
+		}
+
+		// Setting the type name must be done after adding the fields, as
+		// the typeName index is dynamic, but the field indexes are not
+		mapping.SetTypeName(request.ConflictTypeName)
+	} else if rootSelectType == DiffSelection {
+		for i, f := range request.DiffFields {
+			mapping.Add(i, f)
+		}
+
+		// Setting the type name must be done after adding the fields, as
+		// the typeName index is dynamic, but the field indexes are not
+		mapping.SetTypeName(request.DiffTypeName)
+	} else if selectRequest.Name == request.LinksFieldName {
 		for i, f := range request.LinksFields {
 			mapping.Add(i, f)
 		}
@@ -1040,6 +1153,18 @@ func resolveInnerFilterDependencies(
 			continue
 		}
 
+		for _, name := range fieldReferenceNames(value) {
+			if len(mapping.IndexesByName[name]) == 0 {
+				continue
+			}
+			index := mapping.FirstIndexOfName(name)
+			if !hasFieldWithIndex(existingFields, index) &&
+				!hasFieldWithIndex(resolvedFields, index) &&
+				!hasFieldWithIndex(newFields, index) {
+				newFields = append(newFields, &Field{Index: index, Name: name})
+			}
+		}
+
 		propertyMapped := len(mapping.IndexesByName[key]) != 0
 
 		var childSelect *Select
@@ -1239,7 +1364,7 @@ func toCommitSelect(
 	selectRequest *request.CommitSelect,
 	thisIndex int,
 ) (*CommitSelect, error) {
-	underlyingSelect, err := toSelect(ctx, store, CommitSelection, thisIndex, selectRequest.ToSelect(), "")
+	underlyingSelect, err := toSelect(ctx, store, CommitSelection, thisIndex, selectRequest.ToSelect(), "", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1270,7 +1395,7 @@ func toMutation(
 	mutationRequest *request.ObjectMutation,
 	thisIndex int,
 ) (*Mutation, error) {
-	underlyingSelect, err := toSelect(ctx, store, ObjectSelection, thisIndex, mutationRequest.ToSelect(), "")
+	underlyingSelect, err := toSelect(ctx, store, ObjectSelection, thisIndex, mutationRequest.ToSelect(), "", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1279,6 +1404,9 @@ func toMutation(
 		Type:          MutationType(mutationRequest.Type),
 		CreateInput:   mutationRequest.CreateInput,
 		UpdateInput:   mutationRequest.UpdateInput,
+		Splices:       mutationRequest.Splices,
+		Heads:         mutationRequest.Heads,
+		Cid:           mutationRequest.Cid,
 		Encrypt:       mutationRequest.Encrypt,
 		EncryptFields: mutationRequest.EncryptFields,
 	}, nil
@@ -1343,6 +1471,16 @@ func toFilterKeyValue(
 		// if the operator is simple (not compound) then
 		// it does not require further expansion
 		if connor.IsOpSimple(sourceKey) {
+			if ref, ok := sourceClause.(request.FieldReference); ok {
+				return returnKey, toFieldReference(ref, mapping)
+			}
+			if sourceKey == connor.RegexOp || sourceKey == connor.NotRegexOp {
+				// the pattern is compiled once here rather than for every document, if it is
+				// invalid the error is returned when the filter is matched
+				if re, err := connor.NewRegex(sourceClause); err == nil {
+					return returnKey, re
+				}
+			}
 			return returnKey, sourceClause
 		}
 	} else if mapping != nil && len(mapping.IndexesByName[sourceKey]) > 0 {
@@ -1466,6 +1604,42 @@ func toGroupBy(source immutable.Option[request.GroupBy], mapping *core.DocumentM
 	}
 }
 
+// toDistinct returns the distinct clause of a select, remapping the relation fields to
+// their internal relation ID fields.
+func toDistinct(
+	source immutable.Option[request.Distinct],
+	definition client.CollectionDefinition,
+	mapping *core.DocumentMapping,
+) (*Distinct, error) {
+	if !source.HasValue() {
+		return nil, nil
+	}
+
+	fields := make([]Field, len(source.Value().Fields))
+	for i, fieldName := range source.Value().Fields {
+		fieldDesc, ok := definition.GetFieldByName(fieldName)
+		if ok && fieldDesc.Kind.IsObject() {
+			if fieldDesc.Kind.IsArray() {
+				return nil, NewErrInvalidFieldToDistinct(fieldName)
+			}
+			fieldName = fieldName + request.RelatedObjectID
+			_, ok = definition.GetFieldByName(fieldName)
+		}
+		if !ok && fieldName != request.DocIDFieldName {
+			return nil, NewErrInvalidFieldToDistinct(fieldName)
+		}
+
+		fields[i] = Field{
+			Index: mapping.FirstIndexOfName(fieldName),
+			Name:  fieldName,
+		}
+	}
+
+	return &Distinct{
+		Fields: fields,
+	}, nil
+}
+
 func toOrderBy(source immutable.Option[request.OrderBy], mapping *core.DocumentMapping) *OrderBy {
 	if !source.HasValue() {
 		return nil
@@ -1473,6 +1647,14 @@ func toOrderBy(source immutable.Option[request.OrderBy], mapping *core.DocumentM
 
 	conditions := make([]OrderCondition, len(source.Value().Conditions))
 	for conditionIndex, condition := range source.Value().Conditions {
+		if isOrderAggregate(condition) {
+			conditions[conditionIndex] = OrderCondition{
+				FieldIndexes: []int{mapping.FirstIndexOfName(orderAggregateName(condition))},
+				Direction:    SortDirection(condition.Direction),
+			}
+			continue
+		}
+
 		fieldIndexes := make([]int, len(condition.Fields))
 		currentMapping := mapping
 		for fieldIndex, field := range condition.Fields {
@@ -1643,6 +1825,9 @@ type aggregateRequest struct {
 	// The targets of this aggregate, as defined by the consumer.
 	targets           []*aggregateRequestTarget
 	dependencyIndexes []int
+
+	// The percentile requested by the consumer, if this is a percentile aggregate.
+	percentile immutable.Option[float64]
 }
 
 // aggregateRequestTarget contains the user defined information for an aggregate
@@ -1676,6 +1861,17 @@ func getAggregateSources(field *request.Aggregate) ([]*aggregateRequestTarget, e
 	targets := make([]*aggregateRequestTarget, len(field.Targets))
 
 	for i, target := range field.Targets {
+		if target.OrderBy.HasValue() {
+			// Aggregates ordered by are only mapped for selects.
+			for _, condition := range target.OrderBy.Value().Conditions {
+				for _, field := range condition.Fields {
+					if _, isAggregate := request.Aggregates[field]; isAggregate {
+						return nil, NewErrInvalidOrderAggregate(field)
+					}
+				}
+			}
+		}
+
 		targets[i] = &aggregateRequestTarget{
 			hostExternalName:  target.HostName,
 			childExternalName: target.ChildName.Value(),
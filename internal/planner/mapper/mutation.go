@@ -10,6 +10,8 @@
 
 package mapper
 
+import "github.com/sourcenetwork/defradb/client/request"
+
 type MutationType int
 
 const (
@@ -18,6 +20,8 @@ const (
 	UpdateObjects
 	DeleteObjects
 	UpsertObjects
+	ResolveObjects
+	RevertObjects
 )
 
 // Mutation represents a request to mutate data stored in Defra.
@@ -34,6 +38,16 @@ type Mutation struct {
 	// UpdateInput is a map of fields and values used for an update mutation.
 	UpdateInput map[string]any
 
+	// Splices is the list of text edits applied to the String fields of an update mutation
+	// after the UpdateInput.
+	Splices []request.TextSplice
+
+	// Heads is the list of hex encoded conflicting value IDs that a resolve mutation supersedes.
+	Heads []string
+
+	// Cid is the version of the document that a revert mutation restores.
+	Cid string
+
 	// Encrypt is a flag to indicate if the input data should be encrypted.
 	Encrypt bool
 
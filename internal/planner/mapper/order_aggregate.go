@@ -0,0 +1,90 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package mapper
+
+import (
+	"strings"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/internal/core"
+)
+
+// isOrderAggregate returns true if the given order condition orders by an aggregate,
+// for example `{_count: {books: DESC}}` or `{_sum: {books: {pages: ASC}}}`.
+func isOrderAggregate(condition request.OrderCondition) bool {
+	_, isAggregate := request.Aggregates[condition.Fields[0]]
+	return isAggregate
+}
+
+// orderAggregateName returns the name the aggregate ordered by in the given condition is
+// mapped to.
+//
+// The name cannot clash with the name of a field, or with the name of a requested aggregate.
+func orderAggregateName(condition request.OrderCondition) string {
+	return strings.Join(condition.Fields, ".")
+}
+
+// getOrderAggregates maps the aggregates ordered by in the given order and returns them.
+//
+// The aggregates are not rendered, they are only computed so that the documents can be
+// ordered by them.
+func getOrderAggregates(
+	source immutable.Option[request.OrderBy],
+	mapping *core.DocumentMapping,
+) ([]*aggregateRequest, error) {
+	if !source.HasValue() {
+		return nil, nil
+	}
+
+	var aggregates []*aggregateRequest
+	for _, condition := range source.Value().Conditions {
+		if !isOrderAggregate(condition) {
+			continue
+		}
+
+		// Order aggregates target a single field, with an optional child field:
+		// {_count: {books: DESC}} or {_sum: {books: {pages: DESC}}}
+		if len(condition.Fields) < 2 || len(condition.Fields) > 3 {
+			return nil, NewErrInvalidOrderAggregate(condition.Fields[0])
+		}
+
+		name := orderAggregateName(condition)
+		if _, isMapped := mapping.IndexesByName[name]; isMapped {
+			// The same aggregate may be ordered by more than once.
+			continue
+		}
+
+		target := &request.AggregateTarget{
+			HostName: condition.Fields[1],
+		}
+		if len(condition.Fields) == 3 {
+			target.ChildName = immutable.Some(condition.Fields[2])
+		}
+
+		index := mapping.GetNextIndex()
+		aggregate, err := getAggregateRequests(index, &request.Aggregate{
+			Field: request.Field{
+				Name: condition.Fields[0],
+			},
+			Targets: []*request.AggregateTarget{target},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		aggregates = append(aggregates, &aggregate)
+		mapping.Add(index, name)
+	}
+
+	return aggregates, nil
+}
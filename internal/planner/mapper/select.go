@@ -11,6 +11,8 @@
 package mapper
 
 import (
+	"time"
+
 	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/internal/core"
@@ -30,6 +32,33 @@ type Select struct {
 	// A commit identifier that can be specified to request data at a given time.
 	Cid immutable.Option[string]
 
+	// A time that can be specified to request the data of every document as it was
+	// at that time.
+	AsOf immutable.Option[time.Time]
+
+	// The versions of the document compared by a _diff selection.
+	DiffFrom immutable.Option[string]
+	DiffTo   immutable.Option[string]
+
+	// An optional k-nearest-neighbour search on a vector field.
+	Similar immutable.Option[Similar]
+
+	// An optional cursor pagination of the results.
+	Cursor immutable.Option[Cursor]
+
+	// An optional deduplication of the results.
+	Distinct *Distinct
+
+	// An optional filter on the aggregates of this Select, applied once the aggregates
+	// have been computed.
+	AggregateFilter *Filter
+
+	// The parent document read by the filter when it references the fields of the parent,
+	// set by the join before the documents of this Select are fetched.
+	//
+	// This is nil if the filter does not reference the parent.
+	ParentDoc *core.Doc
+
 	// The name of the collection that this Select selects data from.
 	CollectionName string
 
@@ -62,6 +91,14 @@ func (s *Select) cloneTo(index int) *Select {
 		Targetable:      *s.Targetable.cloneTo(index),
 		DocumentMapping: s.DocumentMapping,
 		Cid:             s.Cid,
+		AsOf:            s.AsOf,
+		DiffFrom:        s.DiffFrom,
+		DiffTo:          s.DiffTo,
+		Similar:         s.Similar,
+		Cursor:          s.Cursor,
+		Distinct:        s.Distinct,
+		AggregateFilter: s.AggregateFilter,
+		ParentDoc:       s.ParentDoc,
 		CollectionName:  s.CollectionName,
 		Fields:          s.Fields,
 	}
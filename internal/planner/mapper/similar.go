@@ -0,0 +1,72 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package mapper
+
+import (
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/internal/core"
+)
+
+// Similar represents a k-nearest-neighbour search on a vector field.
+type Similar struct {
+	// The vector field that is compared.
+	Field
+
+	// The vector that the field is compared with.
+	Vector []float64
+
+	// The number of nearest documents to return.
+	K uint64
+}
+
+// toSimilar validates the given `_similar` request against the collection definition and
+// returns its mapped form.
+func toSimilar(
+	source immutable.Option[request.Similar],
+	mapping *core.DocumentMapping,
+	definition client.CollectionDefinition,
+) (immutable.Option[Similar], error) {
+	if !source.HasValue() {
+		return immutable.None[Similar](), nil
+	}
+	similar := source.Value()
+
+	field, ok := definition.GetFieldByName(similar.Field)
+	if !ok {
+		return immutable.None[Similar](), NewErrSimilarFieldNotVector(similar.Field)
+	}
+	vectorKind, ok := field.Kind.(*client.VectorKind)
+	if !ok {
+		return immutable.None[Similar](), NewErrSimilarFieldNotVector(similar.Field)
+	}
+	if len(similar.Vector) != vectorKind.Dimension {
+		return immutable.None[Similar](), client.NewErrVectorDimensionMismatch(
+			similar.Field,
+			vectorKind.Dimension,
+			len(similar.Vector),
+		)
+	}
+	if similar.K == 0 {
+		return immutable.None[Similar](), ErrSimilarInvalidK
+	}
+
+	return immutable.Some(Similar{
+		Field: Field{
+			Index: mapping.FirstIndexOfName(similar.Field),
+			Name:  similar.Field,
+		},
+		Vector: similar.Vector,
+		K:      similar.K,
+	}), nil
+}
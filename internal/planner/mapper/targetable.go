@@ -22,6 +22,9 @@ var (
 	_ connor.FilterKey = (*PropertyIndex)(nil)
 	_ connor.FilterKey = (*Operator)(nil)
 	_ connor.FilterKey = (*ObjectProperty)(nil)
+
+	_ connor.ValueReference = (*FieldReference)(nil)
+	_ connor.ValueReference = (*ParentFieldReference)(nil)
 )
 
 // PropertyIndex is a FilterKey that represents a property in a document.
@@ -100,6 +103,52 @@ func (k *ObjectProperty) Equal(other connor.FilterKey) bool {
 	return false
 }
 
+// FieldReference is a filter value that refers to another property of the
+// document being filtered.
+type FieldReference struct {
+	// The index at which the referenced property can be found on the document.
+	//
+	// This is -1 if the referenced property could not be found.
+	Index int
+
+	// The name of the referenced property.
+	Name string
+}
+
+func (r *FieldReference) GetValue(data any) any {
+	if data == nil || r.Index < 0 {
+		return nil
+	}
+
+	return data.(core.Doc).Fields[r.Index]
+}
+
+// ParentFieldReference is a filter value that refers to a property of the parent
+// document of the related documents being filtered.
+type ParentFieldReference struct {
+	// The index at which the referenced property can be found on the parent document.
+	//
+	// This is -1 until the reference has been resolved against the parent mapping.
+	Index int
+
+	// The name of the referenced property.
+	Name string
+
+	// The parent document currently being joined.
+	//
+	// It is shared with the related [Select] and is set by the join before the related
+	// documents are fetched.
+	Parent *core.Doc
+}
+
+func (r *ParentFieldReference) GetValue(data any) any {
+	if r.Parent == nil || r.Index < 0 || r.Index >= len(r.Parent.Fields) {
+		return nil
+	}
+
+	return r.Parent.Fields[r.Index]
+}
+
 // Filter represents a series of conditions that may reduce the number of
 // records that a request returns.
 type Filter struct {
@@ -171,7 +220,16 @@ func filterObjectToMap(mapping *core.DocumentMapping, obj map[connor.FilterKey]a
 					outmap[keyType.Operation] = filterObjectToMap(mapping, itemMap)
 				}
 			default:
-				outmap[keyType.Operation] = v
+				switch ref := v.(type) {
+				case *FieldReference:
+					outmap[keyType.Operation] = request.FieldReference{Name: ref.Name}.ToMap()
+				case *connor.Regex:
+					outmap[keyType.Operation] = ref.ToMap()
+				case *ParentFieldReference:
+					outmap[keyType.Operation] = request.FieldReference{Name: ref.Name, IsParent: true}.ToMap()
+				default:
+					outmap[keyType.Operation] = v
+				}
 			}
 
 		case *ObjectProperty:
@@ -210,6 +268,12 @@ type GroupBy struct {
 	Fields []Field
 }
 
+// Distinct represents a deduplication instruction on a request.
+type Distinct struct {
+	// The fields by which documents should be deduplicated.
+	Fields []Field
+}
+
 type SortDirection string
 
 const (
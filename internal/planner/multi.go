@@ -118,7 +118,7 @@ func (p *parallelNode) Next() (bool, error) {
 		case *scanNode, *typeIndexJoin:
 			// isMerge = true
 			next, err = p.nextMerge(i, n)
-		case *dagScanNode:
+		case *dagScanNode, *conflictsNode, *diffNode:
 			next, err = p.nextAppend(i, n)
 		}
 		if err != nil {
@@ -198,7 +198,7 @@ func (s *selectNode) addSubPlan(fieldIndex int, newPlan planNode) error {
 		switch newPlan.(type) {
 		case *scanNode, *typeIndexJoin:
 			s.source = newPlan
-		case *dagScanNode:
+		case *dagScanNode, *conflictsNode, *diffNode:
 			m := &parallelNode{
 				p:         s.planner,
 				docMapper: docMapper{s.source.DocumentMap()},
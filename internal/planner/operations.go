@@ -11,11 +11,16 @@
 package planner
 
 var (
+	_ planNode = (*aggregateFilterNode)(nil)
 	_ planNode = (*averageNode)(nil)
+	_ planNode = (*conflictsNode)(nil)
 	_ planNode = (*countNode)(nil)
 	_ planNode = (*createNode)(nil)
+	_ planNode = (*cursorNode)(nil)
 	_ planNode = (*dagScanNode)(nil)
+	_ planNode = (*diffNode)(nil)
 	_ planNode = (*deleteNode)(nil)
+	_ planNode = (*distinctNode)(nil)
 	_ planNode = (*groupNode)(nil)
 	_ planNode = (*limitNode)(nil)
 	_ planNode = (*maxNode)(nil)
@@ -27,6 +32,8 @@ var (
 	_ planNode = (*scanNode)(nil)
 	_ planNode = (*selectNode)(nil)
 	_ planNode = (*selectTopNode)(nil)
+	_ planNode = (*similarNode)(nil)
+	_ planNode = (*statisticNode)(nil)
 	_ planNode = (*sumNode)(nil)
 	_ planNode = (*topLevelNode)(nil)
 	_ planNode = (*typeIndexJoin)(nil)
@@ -124,6 +124,12 @@ func (p *Planner) newObjectMutationPlan(stmt *mapper.Mutation) (planNode, error)
 	case mapper.UpsertObjects:
 		return p.UpsertDocs(stmt)
 
+	case mapper.ResolveObjects:
+		return p.UpdateDocs(stmt)
+
+	case mapper.RevertObjects:
+		return p.UpdateDocs(stmt)
+
 	default:
 		return nil, client.NewErrUnhandledType("mutation", stmt.Type)
 	}
@@ -209,6 +215,21 @@ func (p *Planner) expandSelectTopNodePlan(plan *selectTopNode, parentPlan *selec
 	// wire up source to plan
 	plan.planNode = plan.selectNode
 
+	// if similar
+	if plan.similar != nil {
+		plan.similar.plan = plan.planNode
+		plan.planNode = plan.similar
+	}
+
+	// if distinct
+	if plan.distinct != nil {
+		if parentPlan == nil {
+			plan.distinct.tryIndexScan(plan.selectNode)
+		}
+		plan.distinct.plan = plan.planNode
+		plan.planNode = plan.distinct
+	}
+
 	// if group
 	if plan.group != nil {
 		err := p.expandGroupNodePlan(plan)
@@ -220,12 +241,31 @@ func (p *Planner) expandSelectTopNodePlan(plan *selectTopNode, parentPlan *selec
 
 	p.expandAggregatePlans(plan)
 
+	// if the aggregates are filtered
+	if plan.aggregateFilter != nil {
+		plan.aggregateFilter.plan = plan.planNode
+		plan.planNode = plan.aggregateFilter
+	}
+
+	// if the cursor pagination can seek the scan, the documents are already ordered,
+	// grouped documents have no docID so they are never sought
+	if plan.cursor != nil && plan.distinct == nil && plan.group == nil && parentPlan == nil &&
+		plan.cursor.trySeek(plan.selectNode) {
+		plan.order = nil
+	}
+
 	// if order
 	if plan.order != nil {
 		plan.order.plan = plan.planNode
 		plan.planNode = plan.order
 	}
 
+	// if cursor
+	if plan.cursor != nil {
+		plan.cursor.plan = plan.planNode
+		plan.planNode = plan.cursor
+	}
+
 	if plan.limit != nil {
 		p.expandLimitPlan(plan, parentPlan)
 	}
@@ -296,6 +336,11 @@ func (p *Planner) tryOptimizeJoinDirection(node *invertibleTypeJoin, parentPlan
 		// If the relation is one sided we cannot invert the join, so return early
 		return nil
 	}
+	if node.parentDoc != nil {
+		// If the child filter references the parent the parent must be fetched first,
+		// so the join cannot be inverted
+		return nil
+	}
 
 	filteredSubFields := findFilteredByRelationFields(
 		parentPlan.selectNode.filter.Conditions,
@@ -303,8 +348,14 @@ func (p *Planner) tryOptimizeJoinDirection(node *invertibleTypeJoin, parentPlan
 	)
 	slct := node.childSide.plan.(*selectTopNode).selectNode
 	desc := slct.collection.Description()
+	// the conditions the parent filter puts on the related documents
+	relConditions, _ := parentPlan.selectNode.filter.ExternalConditions[node.parentSide.relFieldDef.Value().Name].(map[string]any)
 	for subFieldName, subFieldInd := range filteredSubFields {
-		indexes := desc.GetIndexesOnField(subFieldName)
+		indexes := filterUsableIndexes(
+			filterIndexesByType(desc.GetIndexesOnField(subFieldName), client.IndexTypeValue),
+			slct.collection.Definition(),
+			relConditions,
+		)
 		if len(indexes) > 0 && !filter.IsComplex(parentPlan.selectNode.filter) {
 			subInd := node.documentMapping.FirstIndexOfName(node.parentSide.relFieldDef.Value().Name)
 			relatedField := mapper.Field{Name: node.parentSide.relFieldDef.Value().Name, Index: subInd}
@@ -11,10 +11,14 @@
 package planner
 
 import (
+	"slices"
+	"time"
+
 	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/internal/connor"
 	"github.com/sourcenetwork/defradb/internal/core"
 	"github.com/sourcenetwork/defradb/internal/db/base"
 	"github.com/sourcenetwork/defradb/internal/db/fetcher"
@@ -48,6 +52,9 @@ type scanNode struct {
 	spans   core.Spans
 	reverse bool
 
+	// index is the index used to fetch the documents, if any.
+	index immutable.Option[client.IndexDescription]
+
 	filter *mapper.Filter
 	slct   *mapper.Select
 
@@ -155,25 +162,44 @@ func (n *scanNode) addField(field client.FieldDefinition) {
 
 func (scan *scanNode) initFetcher(
 	cid immutable.Option[string],
+	asOf immutable.Option[time.Time],
 	index immutable.Option[client.IndexDescription],
 ) {
+	scan.index = index
 	var f fetcher.Fetcher
 	if cid.HasValue() {
 		f = new(fetcher.VersionedFetcher)
+	} else if asOf.HasValue() {
+		f = fetcher.NewVersionedFetcherAsOf(asOf.Value())
 	} else {
 		f = new(fetcher.DocumentFetcher)
 
-		if index.HasValue() {
+		if index.HasValue() && index.Value().Type == client.IndexTypeVector {
+			// vector indexes do not serve filters, they find the candidates of the `_similar`
+			// argument which are then filtered as any other document.
+			f = fetcher.NewIndexFetcher(f, index.Value(), similarIndexFilter(scan.slct.Similar.Value()))
+		} else if index.HasValue() {
 			fieldsToMove := make([]mapper.Field, 0, len(index.Value().Fields))
 			fieldsToCopy := make([]mapper.Field, 0, len(index.Value().Fields))
 			for _, field := range index.Value().Fields {
-				fieldName := field.Name
+				fieldName := field.FieldName()
 				typeIndex := scan.documentMapping.FirstIndexOfName(fieldName)
 				indexField := mapper.Field{Index: typeIndex, Name: fieldName}
 				fd, _ := scan.col.Definition().Schema.GetFieldByName(fieldName)
 				// if the field is an array, we need to copy it instead of moving so that the
-				// top select node can do final filter check on the whole array of the document
-				if fd.Kind.IsArray() {
+				// top select node can do final filter check on the whole array of the document.
+				// The same goes for full-text indexes, as they only hold the terms of the field, and
+				// geohash indexes, as they only hold the cells of the field. Conditions comparing the
+				// field with other fields can also only be checked once the document is fetched.
+				// Indexes on paths inside JSON fields only hold a value of the field and may hold
+				// values of other types than the filtered ones.
+				if fd.Kind.IsArray() || index.Value().Type == client.IndexTypeFullText ||
+					index.Value().Type == client.IndexTypeGeoHash || field.JSONPath() != nil ||
+					hasFieldReference(scan.filter, typeIndex) {
+					if slices.Contains(fieldsToCopy, indexField) {
+						// several paths inside the same JSON field can be indexed
+						continue
+					}
 					fieldsToCopy = append(fieldsToCopy, indexField)
 				} else {
 					fieldsToMove = append(fieldsToMove, indexField)
@@ -194,6 +220,48 @@ func (scan *scanNode) initFetcher(
 	scan.fetcher = f
 }
 
+// hasFieldReference returns true if the condition on the property at the given index compares
+// it with the value of another field.
+func hasFieldReference(f *mapper.Filter, index int) bool {
+	if f == nil {
+		return false
+	}
+	for key, cond := range f.Conditions {
+		prop, ok := key.(*mapper.PropertyIndex)
+		if !ok || prop.Index != index {
+			continue
+		}
+		condMap, _ := cond.(map[connor.FilterKey]any)
+		for _, value := range condMap {
+			if _, isRef := value.(connor.ValueReference); isRef {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// initIndexScanFetcher makes the scan iterate through the keys of the given value index, within
+// the given range, instead of through the documents. If the index holds all the fetched fields,
+// the documents are read from the index keys alone.
+func (scan *scanNode) initIndexScanFetcher(index client.IndexDescription, scanRange fetcher.IndexScanRange) {
+	scan.index = immutable.Some(index)
+	var f fetcher.Fetcher = fetcher.NewIndexScanFetcher(new(fetcher.DocumentFetcher), index, scanRange)
+	scan.fetcher = lens.NewFetcher(f, scan.p.db.LensRegistry())
+}
+
+// similarIndexFilter returns the filter through which the given `_similar` argument is
+// handed to the index fetcher of a vector index.
+func similarIndexFilter(similar mapper.Similar) *mapper.Filter {
+	return &mapper.Filter{
+		Conditions: map[connor.FilterKey]any{
+			&mapper.PropertyIndex{Index: similar.Index}: map[connor.FilterKey]any{
+				&mapper.Operator{Operation: request.SimilarArgName}: similar,
+			},
+		},
+	}
+}
+
 // Start starts the internal logic of the scanner
 // like the DocumentFetcher, and more.
 func (n *scanNode) Start() error {
@@ -245,6 +313,10 @@ func (n *scanNode) Next() (bool, error) {
 		n.currentValue.Status.IsDeleted(),
 	)
 
+	if scoredDoc, ok := doc.(fetcher.ScoredDocument); ok && scoredDoc.Score().HasValue() {
+		n.documentMapping.SetFirstOfName(&n.currentValue, request.ScoreFieldName, scoredDoc.Score().Value())
+	}
+
 	return true, nil
 }
 
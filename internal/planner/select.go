@@ -11,14 +11,18 @@
 package planner
 
 import (
+	"strings"
+
 	cid "github.com/ipfs/go-cid"
 	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/internal/connor"
 	"github.com/sourcenetwork/defradb/internal/core"
 	"github.com/sourcenetwork/defradb/internal/db/base"
 	"github.com/sourcenetwork/defradb/internal/db/fetcher"
+	"github.com/sourcenetwork/defradb/internal/planner/filter"
 	"github.com/sourcenetwork/defradb/internal/planner/mapper"
 )
 
@@ -49,11 +53,16 @@ SELECT * From TableA as A JOIN TableB as B ON a.id = b.friend_id
 type selectTopNode struct {
 	docMapper
 
+	similar    *similarNode
+	distinct   *distinctNode
 	group      *groupNode
 	order      *orderNode
+	cursor     *cursorNode
 	limit      *limitNode
 	aggregates []aggregateNode
 
+	aggregateFilter *aggregateFilterNode
+
 	// selectNode is used pre-wiring of the plan (before expansion and all).
 	selectNode *selectNode
 
@@ -119,6 +128,10 @@ type selectNode struct {
 	selectReq    *mapper.Select
 	groupSelects []*mapper.Select
 
+	// similarIndexed is true if the `_similar` argument of the select is served by a
+	// vector index.
+	similarIndexed bool
+
 	execInfo selectExecInfo
 }
 
@@ -290,7 +303,24 @@ func (n *selectNode) initSource() ([]aggregateNode, error) {
 	}
 
 	if isScanNode {
-		origScan.initFetcher(n.selectReq.Cid, findIndexByFilteringField(origScan))
+		index := findIndexByFilteringField(origScan)
+		if n.selectReq.Similar.HasValue() {
+			similar := n.selectReq.Similar.Value()
+			origScan.tryAddFieldWithName(similar.Name)
+			// the nearest documents are best found by a vector index on the compared field
+			vectorIndex := findIndexByFieldNameAndType(origScan.col, similar.Name, client.IndexTypeVector, nil)
+			if vectorIndex.HasValue() {
+				index = vectorIndex
+				n.similarIndexed = !n.selectReq.Cid.HasValue() && !n.selectReq.AsOf.HasValue()
+			}
+		}
+		if n.selectReq.Distinct != nil {
+			// the distinct fields have to be fetched even if they are not requested
+			for _, field := range n.selectReq.Distinct.Fields {
+				origScan.tryAddFieldWithName(field.Name)
+			}
+		}
+		origScan.initFetcher(n.selectReq.Cid, n.selectReq.AsOf, index)
 	}
 
 	return aggregates, nil
@@ -303,10 +333,29 @@ func findIndexByFilteringField(scanNode *scanNode) immutable.Option[client.Index
 	colDesc := scanNode.col.Description()
 
 	for _, field := range scanNode.col.Schema().Fields {
-		if _, isFiltered := scanNode.filter.ExternalConditions[field.Name]; !isFiltered {
+		condition, isFiltered := scanNode.filter.ExternalConditions[field.Name]
+		if !isFiltered || isFieldReferenceCondition(condition) {
 			continue
 		}
-		indexes := colDesc.GetIndexesOnField(field.Name)
+		// full-text searches can only be served by full-text indexes, and full-text indexes
+		// can not serve any other condition.
+		indexType := client.IndexTypeValue
+		if isFullTextSearchCondition(condition) {
+			indexType = client.IndexTypeFullText
+		} else if field.Kind == client.FieldKind_NILLABLE_GEOPOINT {
+			// geo points can only be indexed by geohash indexes
+			indexType = client.IndexTypeGeoHash
+		}
+		fieldIndexes := colDesc.GetIndexesOnField(field.Name)
+		if field.Kind == client.FieldKind_NILLABLE_JSON {
+			// only paths inside JSON fields can be indexed
+			fieldIndexes = getIndexesOnFilteredJSONPaths(colDesc, field.Name, condition)
+		}
+		indexes := filterUsableIndexes(
+			filterIndexesByType(fieldIndexes, indexType),
+			scanNode.col.Definition(),
+			scanNode.filter.ExternalConditions,
+		)
 		if len(indexes) > 0 {
 			// we return the first found index. We will optimize it later.
 			return immutable.Some(indexes[0])
@@ -315,12 +364,29 @@ func findIndexByFilteringField(scanNode *scanNode) immutable.Option[client.Index
 	return immutable.None[client.IndexDescription]()
 }
 
-func findIndexByFieldName(col client.Collection, fieldName string) immutable.Option[client.IndexDescription] {
+func findIndexByFieldName(
+	col client.Collection,
+	fieldName string,
+	conditions map[string]any,
+) immutable.Option[client.IndexDescription] {
+	return findIndexByFieldNameAndType(col, fieldName, client.IndexTypeValue, conditions)
+}
+
+func findIndexByFieldNameAndType(
+	col client.Collection,
+	fieldName string,
+	indexType client.IndexType,
+	conditions map[string]any,
+) immutable.Option[client.IndexDescription] {
 	for _, field := range col.Schema().Fields {
 		if field.Name != fieldName {
 			continue
 		}
-		indexes := col.Description().GetIndexesOnField(field.Name)
+		indexes := filterUsableIndexes(
+			filterIndexesByType(col.Description().GetIndexesOnField(field.Name), indexType),
+			col.Definition(),
+			conditions,
+		)
 		if len(indexes) > 0 {
 			// At the moment we just take the first index, but later we want to run some kind of analysis to
 			// determine which index is best to use. https://github.com/sourcenetwork/defradb/issues/2680
@@ -330,6 +396,107 @@ func findIndexByFieldName(col client.Collection, fieldName string) immutable.Opt
 	return immutable.None[client.IndexDescription]()
 }
 
+// getIndexesOnFilteredJSONPaths returns the indexes of which the first field is a path inside
+// the given JSON field on which the given field condition has operators.
+func getIndexesOnFilteredJSONPaths(
+	desc client.CollectionDescription,
+	fieldName string,
+	condition any,
+) []client.IndexDescription {
+	result := []client.IndexDescription{}
+	for _, index := range desc.Indexes {
+		path := index.Fields[0].JSONPath()
+		if path == nil || index.Fields[0].FieldName() != fieldName {
+			continue
+		}
+		pathCondition, ok := getJSONPathCondition(condition, path)
+		if ok && !isFieldReferenceCondition(pathCondition) {
+			result = append(result, index)
+		}
+	}
+	return result
+}
+
+// getJSONPathCondition returns the condition on the value at the given path inside a JSON field
+// from the given field condition, if it has operators.
+func getJSONPathCondition(condition any, path []string) (map[string]any, bool) {
+	condMap, ok := condition.(map[string]any)
+	for _, name := range path {
+		if !ok {
+			return nil, false
+		}
+		condMap, ok = condMap[name].(map[string]any)
+	}
+	if !ok {
+		return nil, false
+	}
+	for key := range condMap {
+		if strings.HasPrefix(key, "_") {
+			return condMap, true
+		}
+	}
+	return nil, false
+}
+
+// isFullTextSearchCondition returns true if the given field condition contains a `_search` operator.
+func isFullTextSearchCondition(condition any) bool {
+	condMap, ok := condition.(map[string]any)
+	if !ok {
+		return false
+	}
+	_, ok = condMap[connor.SearchOp]
+	return ok
+}
+
+// isFieldReferenceCondition returns true if the given field condition only compares the
+// field with other fields, in which case the values to look up in an index are not known.
+func isFieldReferenceCondition(condition any) bool {
+	condMap, ok := condition.(map[string]any)
+	if !ok || len(condMap) == 0 {
+		return false
+	}
+	for _, value := range condMap {
+		if _, ok := request.ParseFieldReference(value); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// filterIndexesByType returns the indexes of the given type.
+func filterIndexesByType(indexes []client.IndexDescription, indexType client.IndexType) []client.IndexDescription {
+	result := make([]client.IndexDescription, 0, len(indexes))
+	for _, index := range indexes {
+		if index.Type == indexType {
+			result = append(result, index)
+		}
+	}
+	return result
+}
+
+// filterUsableIndexes returns the given indexes without the indexes that are still being
+// built and the partial indexes that may not hold all the documents matching the given conditions.
+func filterUsableIndexes(
+	indexes []client.IndexDescription,
+	col client.CollectionDefinition,
+	conditions map[string]any,
+) []client.IndexDescription {
+	result := make([]client.IndexDescription, 0, len(indexes))
+	for _, index := range indexes {
+		if index.IsBuilding() {
+			continue
+		}
+		if len(index.Filter) > 0 {
+			predicate, err := filter.NormalizeIndexFilter(index.Filter, col)
+			if err != nil || !filter.Implies(conditions, predicate) {
+				continue
+			}
+		}
+		result = append(result, index)
+	}
+	return result
+}
+
 func (n *selectNode) initFields(selectReq *mapper.Select) ([]aggregateNode, error) {
 	aggregates := []aggregateNode{}
 	// loop over the sub type
@@ -351,6 +518,12 @@ func (n *selectNode) initFields(selectReq *mapper.Select) ([]aggregateNode, erro
 				plan, aggregateError = n.planner.Max(f, selectReq)
 			case request.MinFieldName:
 				plan, aggregateError = n.planner.Min(f, selectReq)
+			case request.MedianFieldName,
+				request.StdDevFieldName,
+				request.VarianceFieldName,
+				request.PercentileFieldName,
+				request.CountDistinctFieldName:
+				plan, aggregateError = n.planner.Statistic(f)
 			}
 
 			if aggregateError != nil {
@@ -383,6 +556,21 @@ func (n *selectNode) initFields(selectReq *mapper.Select) ([]aggregateNode, erro
 				if err := n.addSubPlan(f.Index, commitPlan); err != nil {
 					return nil, err
 				}
+			} else if f.Name == request.ConflictsFieldName && n.collection != nil {
+				conflictsPlan := n.planner.Conflicts(n.collection, f)
+
+				if err := n.addSubPlan(f.Index, conflictsPlan); err != nil {
+					return nil, err
+				}
+			} else if f.Name == request.DiffFieldName && n.collection != nil {
+				diffPlan, err := n.planner.Diff(n.collection, f)
+				if err != nil {
+					return nil, err
+				}
+
+				if err := n.addSubPlan(f.Index, diffPlan); err != nil {
+					return nil, err
+				}
 			} else if f.Name == request.GroupFieldName {
 				if selectReq.GroupBy == nil {
 					return nil, ErrGroupOutsideOfGroupBy
@@ -475,13 +663,22 @@ func (p *Planner) SelectFromSource(
 		return nil, err
 	}
 
+	cursorPlan, err := p.Cursor(selectReq)
+	if err != nil {
+		return nil, err
+	}
+
 	top := &selectTopNode{
-		selectNode: s,
-		limit:      limitPlan,
-		order:      orderPlan,
-		group:      groupPlan,
-		aggregates: aggregates,
-		docMapper:  docMapper{selectReq.DocumentMapping},
+		selectNode:      s,
+		similar:         p.Similar(selectReq, s.similarIndexed),
+		distinct:        p.Distinct(selectReq),
+		aggregateFilter: p.AggregateFilter(selectReq),
+		limit:           limitPlan,
+		order:           orderPlan,
+		cursor:          cursorPlan,
+		group:           groupPlan,
+		aggregates:      aggregates,
+		docMapper:       docMapper{selectReq.DocumentMapping},
 	}
 	return top, nil
 }
@@ -519,13 +716,22 @@ func (p *Planner) Select(selectReq *mapper.Select) (planNode, error) {
 		return nil, err
 	}
 
+	cursorPlan, err := p.Cursor(selectReq)
+	if err != nil {
+		return nil, err
+	}
+
 	top := &selectTopNode{
-		selectNode: s,
-		limit:      limitPlan,
-		order:      orderPlan,
-		group:      groupPlan,
-		aggregates: aggregates,
-		docMapper:  docMapper{selectReq.DocumentMapping},
+		selectNode:      s,
+		similar:         p.Similar(selectReq, s.similarIndexed),
+		distinct:        p.Distinct(selectReq),
+		aggregateFilter: p.AggregateFilter(selectReq),
+		limit:           limitPlan,
+		order:           orderPlan,
+		cursor:          cursorPlan,
+		group:           groupPlan,
+		aggregates:      aggregates,
+		docMapper:       docMapper{selectReq.DocumentMapping},
 	}
 	return top, nil
 }
@@ -0,0 +1,172 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/planner/mapper"
+	"github.com/sourcenetwork/defradb/internal/vector"
+)
+
+// similarNode yields the k documents of its source whose vector field is nearest to the
+// vector of a `_similar` argument, from the nearest one.
+//
+// When the source is served by a vector index it yields the candidates of the index from
+// the nearest one, so only the first k documents are read. The exact distance of each of
+// them is still computed here.
+type similarNode struct {
+	docMapper
+
+	p    *Planner
+	plan planNode
+
+	similar mapper.Similar
+	// indexed is true if the source is served by a vector index on the compared field.
+	indexed bool
+
+	// docs holds the nearest documents once the source has been consumed.
+	docs     []core.Doc
+	consumed bool
+	current  int
+
+	execInfo similarExecInfo
+}
+
+type similarExecInfo struct {
+	// Total number of times similarNode was executed.
+	iterations uint64
+
+	// Total number of documents compared with the vector.
+	docsCompared uint64
+}
+
+// Similar creates a new similarNode for the `_similar` argument of the given select, it
+// returns nil if the select has no such argument.
+func (p *Planner) Similar(parsed *mapper.Select, indexed bool) *similarNode {
+	if !parsed.Similar.HasValue() {
+		return nil
+	}
+	return &similarNode{
+		p:         p,
+		similar:   parsed.Similar.Value(),
+		indexed:   indexed,
+		docMapper: docMapper{parsed.DocumentMapping},
+	}
+}
+
+func (n *similarNode) Kind() string {
+	return "similarNode"
+}
+
+func (n *similarNode) Init() error {
+	n.docs = nil
+	n.consumed = false
+	n.current = -1
+	return n.plan.Init()
+}
+
+func (n *similarNode) Start() error           { return n.plan.Start() }
+func (n *similarNode) Spans(spans core.Spans) { n.plan.Spans(spans) }
+func (n *similarNode) Close() error           { return n.plan.Close() }
+func (n *similarNode) Source() planNode       { return n.plan }
+
+func (n *similarNode) Value() core.Doc {
+	return n.docs[n.current]
+}
+
+func (n *similarNode) Next() (bool, error) {
+	n.execInfo.iterations++
+
+	if !n.consumed {
+		err := n.consume()
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if n.current+1 >= len(n.docs) {
+		return false, nil
+	}
+	n.current++
+	return true, nil
+}
+
+// consume reads the documents of the source and keeps the k nearest ones, ordered by
+// distance and then by docID.
+func (n *similarNode) consume() error {
+	distances := make(map[string]float64)
+	for !n.indexed || uint64(len(n.docs)) < n.similar.K {
+		next, err := n.plan.Next()
+		if err != nil {
+			return err
+		}
+		if !next {
+			break
+		}
+
+		// the document is cloned as the source may reuse it for the next one.
+		value := n.plan.Value()
+		doc := value.Clone()
+		// documents without a vector are never similar to anything.
+		docVector, ok := doc.Fields[n.similar.Index].([]float64)
+		if !ok || len(docVector) != len(n.similar.Vector) {
+			continue
+		}
+		n.execInfo.docsCompared++
+
+		distance := vector.Distance(n.similar.Vector, docVector)
+		n.documentMapping.SetFirstOfName(&doc, request.DistanceFieldName, distance)
+		distances[doc.GetID()] = distance
+		n.docs = append(n.docs, doc)
+	}
+
+	slices.SortFunc(n.docs, func(a, b core.Doc) int {
+		if c := cmp.Compare(distances[a.GetID()], distances[b.GetID()]); c != 0 {
+			return c
+		}
+		return strings.Compare(a.GetID(), b.GetID())
+	})
+	if uint64(len(n.docs)) > n.similar.K {
+		n.docs = n.docs[:n.similar.K]
+	}
+	n.consumed = true
+	return nil
+}
+
+func (n *similarNode) simpleExplain() (map[string]any, error) {
+	return map[string]any{
+		fieldNameLabel: n.similar.Name,
+		"k":            n.similar.K,
+	}, nil
+}
+
+// Explain method returns a map containing all attributes of this node that
+// are to be explained, subscribes / opts-in this node to be an explainablePlanNode.
+func (n *similarNode) Explain(explainType request.ExplainType) (map[string]any, error) {
+	switch explainType {
+	case request.SimpleExplain:
+		return n.simpleExplain()
+
+	case request.ExecuteExplain:
+		return map[string]any{
+			"iterations":   n.execInfo.iterations,
+			"docsCompared": n.execInfo.docsCompared,
+		}, nil
+
+	default:
+		return nil, ErrUnknownExplainRequestType
+	}
+}
@@ -0,0 +1,432 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"encoding/json"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/sourcenetwork/immutable/enumerable"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/encoding"
+	"github.com/sourcenetwork/defradb/internal/planner/mapper"
+)
+
+const percentileLabel = "percentile"
+
+// statisticNode computes a statistical aggregate (median, standard deviation, variance,
+// percentile or count of distinct values) over all the values of its targets.
+//
+// Unlike the other aggregates, the statistics can not be computed incrementally, so all
+// the target values of the current document are gathered before the statistic is computed.
+type statisticNode struct {
+	documentIterator
+	docMapper
+
+	plan planNode
+
+	// name is the name of the aggregate, for example `_median`.
+	name string
+
+	// percentile is the percentile to compute, from 0 to 100.
+	//
+	// It is only used by the median and percentile aggregates.
+	percentile float64
+
+	// virtualFieldIndex is the index of the field
+	// that contains the result of the aggregate.
+	virtualFieldIndex int
+	aggregateMapping  []mapper.AggregateTarget
+
+	execInfo statisticExecInfo
+}
+
+type statisticExecInfo struct {
+	// Total number of times statisticNode was executed.
+	iterations uint64
+}
+
+// Statistic creates a new statisticNode for the given statistical aggregate.
+func (p *Planner) Statistic(field *mapper.Aggregate) (*statisticNode, error) {
+	var percentile float64
+	switch field.Name {
+	case request.MedianFieldName:
+		percentile = 50
+
+	case request.PercentileFieldName:
+		percentile = field.Percentile.Value()
+		if !(percentile >= 0 && percentile <= 100) {
+			return nil, NewErrInvalidPercentile(percentile)
+		}
+	}
+
+	return &statisticNode{
+		name:              field.Name,
+		percentile:        percentile,
+		aggregateMapping:  field.AggregateTargets,
+		virtualFieldIndex: field.Index,
+		docMapper:         docMapper{field.DocumentMapping},
+	}, nil
+}
+
+func (n *statisticNode) Kind() string           { return strings.TrimPrefix(n.name, "_") + "Node" }
+func (n *statisticNode) Init() error            { return n.plan.Init() }
+func (n *statisticNode) Start() error           { return n.plan.Start() }
+func (n *statisticNode) Spans(spans core.Spans) { n.plan.Spans(spans) }
+func (n *statisticNode) Close() error           { return n.plan.Close() }
+func (n *statisticNode) Source() planNode       { return n.plan }
+func (n *statisticNode) SetPlan(p planNode)     { n.plan = p }
+
+func (n *statisticNode) simpleExplain() (map[string]any, error) {
+	sourceExplanations := make([]map[string]any, len(n.aggregateMapping))
+
+	for i, source := range n.aggregateMapping {
+		simpleExplainMap := map[string]any{}
+
+		// Add the filter attribute if it exists.
+		if source.Filter == nil {
+			simpleExplainMap[filterLabel] = nil
+		} else {
+			// get the target aggregate document mapping. Since the filters
+			// are relative to the target aggregate collection (and doc mapper).
+			var targetMap *core.DocumentMapping
+			if source.Index < len(n.documentMapping.ChildMappings) &&
+				n.documentMapping.ChildMappings[source.Index] != nil {
+				targetMap = n.documentMapping.ChildMappings[source.Index]
+			} else {
+				targetMap = n.documentMapping
+			}
+			simpleExplainMap[filterLabel] = source.Filter.ToMap(targetMap)
+		}
+
+		// Add the main field name.
+		simpleExplainMap[fieldNameLabel] = source.Field.Name
+
+		// Add the child field name if it exists.
+		if source.ChildTarget.HasValue {
+			simpleExplainMap[childFieldNameLabel] = source.ChildTarget.Name
+		} else {
+			simpleExplainMap[childFieldNameLabel] = nil
+		}
+
+		sourceExplanations[i] = simpleExplainMap
+	}
+
+	explanation := map[string]any{
+		sourcesLabel: sourceExplanations,
+	}
+	if n.name == request.PercentileFieldName {
+		explanation[percentileLabel] = n.percentile
+	}
+	return explanation, nil
+}
+
+// Explain method returns a map containing all attributes of this node that
+// are to be explained, subscribes / opts-in this node to be an explainablePlanNode.
+func (n *statisticNode) Explain(explainType request.ExplainType) (map[string]any, error) {
+	switch explainType {
+	case request.SimpleExplain:
+		return n.simpleExplain()
+
+	case request.ExecuteExplain:
+		return map[string]any{
+			"iterations": n.execInfo.iterations,
+		}, nil
+
+	default:
+		return nil, ErrUnknownExplainRequestType
+	}
+}
+
+func (n *statisticNode) Next() (bool, error) {
+	n.execInfo.iterations++
+
+	hasNext, err := n.plan.Next()
+	if err != nil || !hasNext {
+		return hasNext, err
+	}
+	n.currentValue = n.plan.Value()
+
+	if n.name == request.CountDistinctFieldName {
+		count, err := n.countDistinct()
+		if err != nil {
+			return false, err
+		}
+		n.currentValue.Fields[n.virtualFieldIndex] = count
+		return true, nil
+	}
+
+	values, err := n.collectValues()
+	if err != nil {
+		return false, err
+	}
+
+	if len(values) == 0 {
+		n.currentValue.Fields[n.virtualFieldIndex] = nil
+		return true, nil
+	}
+
+	switch n.name {
+	case request.MedianFieldName, request.PercentileFieldName:
+		n.currentValue.Fields[n.virtualFieldIndex] = percentileOf(values, n.percentile)
+	case request.VarianceFieldName:
+		n.currentValue.Fields[n.virtualFieldIndex] = varianceOf(values)
+	case request.StdDevFieldName:
+		n.currentValue.Fields[n.virtualFieldIndex] = math.Sqrt(varianceOf(values))
+	}
+	return true, nil
+}
+
+// collectValues gathers the non-nil values of all the targets of the current document.
+func (n *statisticNode) collectValues() ([]float64, error) {
+	values := []float64{}
+
+	for _, source := range n.aggregateMapping {
+		child := n.currentValue.Fields[source.Index]
+		var err error
+		switch childCollection := child.(type) {
+		case []core.Doc:
+			values = reduceDocs(
+				childCollection,
+				values,
+				func(childItem core.Doc, values []float64) []float64 {
+					switch v := childItem.Fields[source.ChildTarget.Index].(type) {
+					case int:
+						return append(values, float64(v))
+					case int64:
+						return append(values, float64(v))
+					case uint64:
+						return append(values, float64(v))
+					case float64:
+						return append(values, v)
+					default:
+						return values
+					}
+				},
+			)
+
+		case []int64:
+			values, err = reduceItems(
+				childCollection,
+				&source,
+				lessN[int64],
+				values,
+				func(childItem int64, values []float64) []float64 {
+					return append(values, float64(childItem))
+				},
+			)
+
+		case []immutable.Option[int64]:
+			values, err = reduceItems(
+				childCollection,
+				&source,
+				lessO[int64],
+				values,
+				func(childItem immutable.Option[int64], values []float64) []float64 {
+					if !childItem.HasValue() {
+						return values
+					}
+					return append(values, float64(childItem.Value()))
+				},
+			)
+
+		case []float64:
+			values, err = reduceItems(
+				childCollection,
+				&source,
+				lessN[float64],
+				values,
+				func(childItem float64, values []float64) []float64 {
+					return append(values, childItem)
+				},
+			)
+
+		case []immutable.Option[float64]:
+			values, err = reduceItems(
+				childCollection,
+				&source,
+				lessO[float64],
+				values,
+				func(childItem immutable.Option[float64], values []float64) []float64 {
+					if !childItem.HasValue() {
+						return values
+					}
+					return append(values, childItem.Value())
+				},
+			)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return values, nil
+}
+
+// countDistinct returns the number of distinct non-nil values of all the targets of the
+// current document.
+//
+// The values are compared by their encoding, so that values of any scalar kind can be counted.
+func (n *statisticNode) countDistinct() (int, error) {
+	distinct := map[string]struct{}{}
+	add := func(value any) error {
+		if value == nil {
+			return nil
+		}
+		key, err := encodeDistinctValue(value)
+		if err != nil || key == nil {
+			return err
+		}
+		distinct[string(key)] = struct{}{}
+		return nil
+	}
+
+	for _, source := range n.aggregateMapping {
+		child := n.currentValue.Fields[source.Index]
+		var err error
+		switch childCollection := child.(type) {
+		case []core.Doc:
+			if !source.ChildTarget.HasValue {
+				continue
+			}
+			for _, childItem := range childCollection {
+				// Hidden items are skipped, as in reduceDocs
+				if childItem.Hidden {
+					continue
+				}
+				err = add(childItem.Fields[source.ChildTarget.Index])
+				if err != nil {
+					break
+				}
+			}
+
+		case []bool:
+			err = addDistinctItems(childCollection, &source, add)
+
+		case []immutable.Option[bool]:
+			err = addDistinctItems(childCollection, &source, add)
+
+		case []int64:
+			err = addDistinctItems(childCollection, &source, add)
+
+		case []immutable.Option[int64]:
+			err = addDistinctItems(childCollection, &source, add)
+
+		case []float64:
+			err = addDistinctItems(childCollection, &source, add)
+
+		case []immutable.Option[float64]:
+			err = addDistinctItems(childCollection, &source, add)
+
+		case []string:
+			err = addDistinctItems(childCollection, &source, add)
+
+		case []immutable.Option[string]:
+			err = addDistinctItems(childCollection, &source, add)
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(distinct), nil
+}
+
+// addDistinctItems passes the items of an inline array that match the filter and limit of
+// the target to the given add func.
+func addDistinctItems[T any](source []T, aggregateTarget *mapper.AggregateTarget, add func(any) error) error {
+	items := enumerable.New(source)
+	if aggregateTarget.Filter != nil {
+		items = enumerable.Where(items, func(item T) (bool, error) {
+			return mapper.RunFilter(item, aggregateTarget.Filter)
+		})
+	}
+
+	if aggregateTarget.Limit != nil {
+		items = enumerable.Skip(items, aggregateTarget.Limit.Offset)
+		items = enumerable.Take(items, aggregateTarget.Limit.Limit)
+	}
+
+	for {
+		hasNext, err := items.Next()
+		if err != nil || !hasNext {
+			return err
+		}
+		item, err := items.Value()
+		if err != nil {
+			return err
+		}
+		if err := add(item); err != nil {
+			return err
+		}
+	}
+}
+
+// encodeDistinctValue returns the encoding of the given non-nil field value.
+//
+// Equal values have the same encoding, the kinds that are not supported by the field
+// value encoding are encoded separately. Nil is returned for the empty optional values.
+func encodeDistinctValue(value any) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return encoding.EncodeBytesAscending(nil, v), nil
+
+	case client.GeoPoint:
+		return encoding.EncodeFloatAscending(encoding.EncodeFloatAscending(nil, v.Lat), v.Lon), nil
+
+	case map[string]any, []any:
+		// JSON objects and arrays, the keys of the objects are sorted when marshalled.
+		return json.Marshal(v)
+	}
+
+	normalValue, err := client.NewNormalValue(value)
+	if err != nil {
+		return nil, err
+	}
+	if normalValue.IsNil() {
+		return nil, nil
+	}
+	return encoding.EncodeFieldValue(nil, normalValue, false), nil
+}
+
+// percentileOf returns the given percentile of the values, interpolating linearly
+// between the two closest ranks.
+//
+// The values are sorted in place and must not be empty.
+func percentileOf(values []float64, percentile float64) float64 {
+	slices.Sort(values)
+
+	rank := percentile / 100 * float64(len(values)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	return values[lower] + (values[upper]-values[lower])*(rank-float64(lower))
+}
+
+// varianceOf returns the population variance of the values, which must not be empty.
+func varianceOf(values []float64) float64 {
+	var mean float64
+	for _, value := range values {
+		mean += value
+	}
+	mean /= float64(len(values))
+
+	var variance float64
+	for _, value := range values {
+		variance += (value - mean) * (value - mean)
+	}
+	return variance / float64(len(values))
+}
@@ -207,6 +207,12 @@ func (p *Planner) Top(m *mapper.Select) (*topLevelNode, error) {
 				child, err = p.Max(f, m)
 			case request.MinFieldName:
 				child, err = p.Min(f, m)
+			case request.MedianFieldName,
+				request.StdDevFieldName,
+				request.VarianceFieldName,
+				request.PercentileFieldName,
+				request.CountDistinctFieldName:
+				child, err = p.Statistic(f)
 			}
 			if err != nil {
 				return nil, err
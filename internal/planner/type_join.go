@@ -11,6 +11,8 @@
 package planner
 
 import (
+	"time"
+
 	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/client"
@@ -376,6 +378,7 @@ func (p *Planner) newInvertableTypeJoin(
 		parentSide: parentSide,
 		childSide:  childSide,
 		skipChild:  skipChild,
+		parentDoc:  subSelect.ParentDoc,
 	}, nil
 }
 
@@ -471,6 +474,12 @@ type invertibleTypeJoin struct {
 
 	secondaryFetchLimit uint
 
+	// parentDoc is read by the child filter when it references the fields of the parent,
+	// it is set to each parent document before its children are fetched.
+	//
+	// It is nil if the child filter does not reference the parent.
+	parentDoc *core.Doc
+
 	// docsToYield contains documents read and ready to be yielded by this node.
 	docsToYield       []core.Doc
 	encounteredDocIDs []string
@@ -589,8 +598,12 @@ func (r *primaryObjectsRetriever) retrievePrimaryDocs() ([]core.Doc, error) {
 
 	oldFetcher := r.primaryScan.fetcher
 
-	indexOnRelation := findIndexByFieldName(r.primaryScan.col, r.relIDFieldDef.Name)
-	r.primaryScan.initFetcher(immutable.None[string](), indexOnRelation)
+	var conditions map[string]any
+	if r.primaryScan.filter != nil {
+		conditions = r.primaryScan.filter.ExternalConditions
+	}
+	indexOnRelation := findIndexByFieldName(r.primaryScan.col, r.relIDFieldDef.Name, conditions)
+	r.primaryScan.initFetcher(immutable.None[string](), immutable.None[time.Time](), indexOnRelation)
 
 	docs, err := r.collectDocs(0)
 	if err != nil {
@@ -674,6 +687,10 @@ func (join *invertibleTypeJoin) Next() (bool, error) {
 		return false, err
 	}
 
+	if firstSide.isParent && join.parentDoc != nil {
+		*join.parentDoc = firstSide.plan.Value()
+	}
+
 	if firstSide.isPrimary() {
 		return join.nextJoinedSecondaryDoc()
 	} else {
@@ -772,7 +789,7 @@ func (join *invertibleTypeJoin) invertJoinDirectionWithIndex(
 	s := getScanNode(p)
 	s.tryAddFieldWithName(join.childSide.relFieldDef.Value().Name + request.RelatedObjectID)
 	s.filter = fieldFilter
-	s.initFetcher(immutable.Option[string]{}, immutable.Some(index))
+	s.initFetcher(immutable.Option[string]{}, immutable.Option[time.Time]{}, immutable.Some(index))
 
 	join.childSide.isFirst = join.parentSide.isFirst
 	join.parentSide.isFirst = !join.parentSide.isFirst
@@ -11,9 +11,15 @@
 package planner
 
 import (
+	"encoding/hex"
+
+	"github.com/ipfs/go-cid"
+	"github.com/sourcenetwork/immutable"
+
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/internal/core"
+	"github.com/sourcenetwork/defradb/internal/core/crdt"
 	"github.com/sourcenetwork/defradb/internal/planner/mapper"
 )
 
@@ -32,6 +38,17 @@ type updateNode struct {
 	// input map of fields and values
 	input map[string]any
 
+	// splices are the text edits applied to the String fields after the input.
+	splices []request.TextSplice
+
+	// resolvedHeads are the IDs of the conflicting multi-value register values
+	// superseded by the update. Only set for resolve mutations.
+	resolvedHeads [][]byte
+
+	// revertVersion is the version that the documents are reverted to. Only set for
+	// revert mutations.
+	revertVersion immutable.Option[cid.Cid]
+
 	isUpdating bool
 
 	results planNode
@@ -52,6 +69,11 @@ func (n *updateNode) Next() (bool, error) {
 	n.execInfo.iterations++
 
 	if n.isUpdating {
+		ctx := n.p.ctx
+		if n.resolvedHeads != nil {
+			ctx = crdt.SetContextResolvedHeads(ctx, n.resolvedHeads)
+		}
+
 		for {
 			next, err := n.results.Next()
 			if err != nil {
@@ -67,6 +89,14 @@ func (n *updateNode) Next() (bool, error) {
 			if err != nil {
 				return false, err
 			}
+			if n.revertVersion.HasValue() {
+				err = n.collection.Revert(ctx, docID, n.revertVersion.Value())
+				if err != nil {
+					return false, err
+				}
+				n.execInfo.updates++
+				continue
+			}
 			doc, err := n.collection.Get(n.p.ctx, docID, false)
 			if err != nil {
 				return false, err
@@ -76,7 +106,12 @@ func (n *updateNode) Next() (bool, error) {
 					return false, err
 				}
 			}
-			err = n.collection.Update(n.p.ctx, doc)
+			for _, splice := range n.splices {
+				if err := doc.Splice(splice); err != nil {
+					return false, err
+				}
+			}
+			err = n.collection.Update(ctx, doc)
 			if err != nil {
 				return false, err
 			}
@@ -164,10 +199,30 @@ func (p *Planner) UpdateDocs(parsed *mapper.Mutation) (planNode, error) {
 		filter:     parsed.Filter,
 		docIDs:     parsed.DocIDs.Value(),
 		input:      parsed.UpdateInput,
+		splices:    parsed.Splices,
 		isUpdating: true,
 		docMapper:  docMapper{parsed.DocumentMapping},
 	}
 
+	if parsed.Type == mapper.ResolveObjects {
+		update.resolvedHeads = make([][]byte, len(parsed.Heads))
+		for i, head := range parsed.Heads {
+			id, err := hex.DecodeString(head)
+			if err != nil {
+				return nil, NewErrInvalidConflictHead(head, err)
+			}
+			update.resolvedHeads[i] = id
+		}
+	}
+
+	if parsed.Type == mapper.RevertObjects {
+		version, err := cid.Decode(parsed.Cid)
+		if err != nil {
+			return nil, err
+		}
+		update.revertVersion = immutable.Some(version)
+	}
+
 	// get collection
 	col, err := p.db.GetCollectionByName(p.ctx, parsed.Name)
 	if err != nil {
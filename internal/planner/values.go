@@ -99,19 +99,16 @@ func (n *valuesNode) docValueLess(docA, docB core.Doc) bool {
 			getDocProp(docB, order.FieldIndexes),
 		)
 
+		if compare == 0 {
+			// the documents are equal on this condition, so the next one decides
+			continue
+		}
+
 		if order.Direction == mapper.DESC {
-			if compare > 0 {
-				return true
-			} else {
-				return false
-			}
-		} else { // Otherwise assume order.Direction == mapper.ASC
-			if compare < 0 {
-				return true
-			} else {
-				return false
-			}
+			return compare > 0
 		}
+		// Otherwise assume order.Direction == mapper.ASC
+		return compare < 0
 	}
 	return false
 }
@@ -85,7 +85,7 @@ func (p *parser) ExecuteIntrospection(request string) *client.RequestResult {
 
 func (p *parser) Parse(ast *ast.Document, options *client.GQLOptions) (*request.Request, []error) {
 	schema := p.schemaManager.Schema()
-	validationResult := gql.ValidateDocument(schema, ast, nil)
+	validationResult := gql.ValidateDocument(schema, ast, validationRules)
 	if !validationResult.IsValid {
 		errors := make([]error, len(validationResult.Errors))
 		for i, err := range validationResult.Errors {
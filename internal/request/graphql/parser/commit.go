@@ -49,6 +49,11 @@ func parseCommitSelect(
 				commit.CID = immutable.Some(v)
 			}
 
+		case request.FilterClause:
+			if v, ok := value.(map[string]any); ok {
+				commit.Filter = immutable.Some(request.Filter{Conditions: v})
+			}
+
 		case request.FieldIDName:
 			if v, ok := value.(string); ok {
 				commit.FieldID = immutable.Some(v)
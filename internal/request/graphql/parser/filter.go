@@ -113,6 +113,20 @@ func parseFilterFieldsForDescriptionMap(
 				continue
 			}
 			fields = append(fields, f)
+
+			// Fields of the same document referenced by the conditions of this field
+			// are needed to evaluate them.
+			operators, _ := v.(map[string]any)
+			for _, value := range operators {
+				ref, ok := request.ParseFieldReference(value)
+				if !ok || ref.IsParent {
+					continue
+				}
+				f, found := col.GetFieldByName(ref.Name)
+				if found && !f.Kind.IsObject() {
+					fields = append(fields, f)
+				}
+			}
 		}
 	}
 	return fields, nil
@@ -96,6 +96,14 @@ func parseMutation(exe *gql.ExecutionContext, parent *gql.Object, field *ast.Fie
 		mut.Type = request.UpsertObjects
 		parseUpsertMutationArgs(mut, arguments)
 
+	case "resolve":
+		mut.Type = request.ResolveObjects
+		parseResolveMutationArgs(mut, arguments)
+
+	case "revert":
+		mut.Type = request.RevertObjects
+		parseRevertMutationArgs(mut, arguments)
+
 	default:
 		return nil, ErrUnknownMutationName
 	}
@@ -196,10 +204,45 @@ func parseUpdateMutationArgs(mut *request.ObjectMutation, args map[string]any) {
 			if v, ok := value.(map[string]any); ok {
 				mut.Filter = immutable.Some(request.Filter{Conditions: v})
 			}
+
+		case request.SpliceArgName:
+			v, ok := value.([]any)
+			if !ok {
+				continue // value is nil
+			}
+			splices := make([]request.TextSplice, len(v))
+			for i, v := range v {
+				splices[i] = parseTextSplice(v.(map[string]any))
+			}
+			mut.Splices = splices
 		}
 	}
 }
 
+func parseTextSplice(args map[string]any) request.TextSplice {
+	var splice request.TextSplice
+	for name, value := range args {
+		switch name {
+		case request.FieldName:
+			splice.Field, _ = value.(string)
+
+		case request.TextSpliceIndexFieldName:
+			if v, ok := value.(int32); ok {
+				splice.Index = int(v)
+			}
+
+		case request.TextSpliceDeleteFieldName:
+			if v, ok := value.(int32); ok {
+				splice.Delete = int(v)
+			}
+
+		case request.TextSpliceInsertFieldName:
+			splice.Insert, _ = value.(string)
+		}
+	}
+	return splice
+}
+
 func parseUpsertMutationArgs(mut *request.ObjectMutation, args map[string]any) {
 	for name, value := range args {
 		switch name {
@@ -220,3 +263,46 @@ func parseUpsertMutationArgs(mut *request.ObjectMutation, args map[string]any) {
 		}
 	}
 }
+
+func parseResolveMutationArgs(mut *request.ObjectMutation, args map[string]any) {
+	for name, value := range args {
+		switch name {
+		case request.Input:
+			if v, ok := value.(map[string]any); ok {
+				mut.UpdateInput = v
+			}
+
+		case request.DocIDArgName:
+			if v, ok := value.(string); ok {
+				mut.DocIDs = immutable.Some([]string{v})
+			}
+
+		case request.HeadsArgName:
+			v, ok := value.([]any)
+			if !ok {
+				continue // value is nil
+			}
+			heads := make([]string, len(v))
+			for i, v := range v {
+				heads[i] = v.(string)
+			}
+			mut.Heads = heads
+		}
+	}
+}
+
+func parseRevertMutationArgs(mut *request.ObjectMutation, args map[string]any) {
+	for name, value := range args {
+		switch name {
+		case request.DocIDArgName:
+			if v, ok := value.(string); ok {
+				mut.DocIDs = immutable.Some([]string{v})
+			}
+
+		case request.Cid:
+			if v, ok := value.(string); ok {
+				mut.Cid = v
+			}
+		}
+	}
+}
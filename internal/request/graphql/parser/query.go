@@ -11,6 +11,8 @@
 package parser
 
 import (
+	"time"
+
 	gql "github.com/sourcenetwork/graphql-go"
 	"github.com/sourcenetwork/graphql-go/language/ast"
 	"github.com/sourcenetwork/immutable"
@@ -125,6 +127,28 @@ func parseSelect(
 				slct.CID = immutable.Some(v)
 			}
 
+		case request.AsOfArgName:
+			if v, ok := value.(time.Time); ok {
+				slct.AsOf = immutable.Some(v)
+			}
+
+		case request.FromArgName:
+			if v, ok := value.(string); ok {
+				slct.DiffFrom = immutable.Some(v)
+			}
+
+		case request.ToArgName:
+			if v, ok := value.(string); ok {
+				slct.DiffTo = immutable.Some(v)
+			}
+
+		case request.SimilarArgName:
+			v, ok := value.(map[string]any)
+			if !ok {
+				continue // value is nil
+			}
+			slct.Similar = immutable.Some(parseSimilar(v))
+
 		case request.LimitClause: // parse limit/offset
 			if v, ok := value.(int32); ok {
 				slct.Limit = immutable.Some(uint64(v))
@@ -135,6 +159,26 @@ func parseSelect(
 				slct.Offset = immutable.Some(uint64(v))
 			}
 
+		case request.FirstClause: // parse cursor pagination
+			if v, ok := value.(int32); ok {
+				slct.First = immutable.Some(uint64(v))
+			}
+
+		case request.AfterClause:
+			if v, ok := value.(string); ok {
+				slct.After = immutable.Some(v)
+			}
+
+		case request.LastClause:
+			if v, ok := value.(int32); ok {
+				slct.Last = immutable.Some(uint64(v))
+			}
+
+		case request.BeforeClause:
+			if v, ok := value.(string); ok {
+				slct.Before = immutable.Some(v)
+			}
+
 		case request.OrderClause: // parse order by
 			v, ok := value.([]any)
 			if !ok {
@@ -161,6 +205,19 @@ func parseSelect(
 				Fields: fields,
 			})
 
+		case request.DistinctClause:
+			v, ok := value.([]any)
+			if !ok {
+				continue // value is nil
+			}
+			fields := make([]string, len(v))
+			for i, c := range v {
+				fields[i] = c.(string)
+			}
+			slct.Distinct = immutable.Some(request.Distinct{
+				Fields: fields,
+			})
+
 		case request.ShowDeleted:
 			if v, ok := value.(bool); ok {
 				slct.ShowDeleted = v
@@ -196,9 +253,17 @@ func parseAggregate(
 	arguments := gql.GetArgumentValues(fieldDef.Args, field.Arguments, exe.VariableValues)
 
 	var targets []*request.AggregateTarget
+	var percentile immutable.Option[float64]
 	for _, argument := range field.Arguments {
 		name := argument.Name.Value
 
+		if name == request.PercentileArgName {
+			if v, ok := arguments[name].(float64); ok {
+				percentile = immutable.Some(v)
+			}
+			continue
+		}
+
 		switch v := arguments[name].(type) {
 		case string:
 			targets = append(targets, &request.AggregateTarget{
@@ -219,7 +284,8 @@ func parseAggregate(
 			Name:  field.Name.Value,
 			Alias: getFieldAlias(field),
 		},
-		Targets: targets,
+		Targets:    targets,
+		Percentile: percentile,
 	}, nil
 }
 
@@ -298,3 +364,26 @@ func parseAggregateTarget(
 		},
 	}, nil
 }
+
+// parseSimilar parses the `_similar` argument of a select.
+func parseSimilar(value map[string]any) request.Similar {
+	var similar request.Similar
+	if v, ok := value[request.FieldName].(string); ok {
+		similar.Field = v
+	}
+	if v, ok := value[request.SimilarVectorFieldName].([]any); ok {
+		similar.Vector = make([]float64, 0, len(v))
+		for _, element := range v {
+			switch n := element.(type) {
+			case float64:
+				similar.Vector = append(similar.Vector, n)
+			case int32:
+				similar.Vector = append(similar.Vector, float64(n))
+			}
+		}
+	}
+	if v, ok := value[request.SimilarKFieldName].(int32); ok && v > 0 {
+		similar.K = uint64(v)
+	}
+	return similar
+}
@@ -33,6 +33,7 @@ const (
 	typeString   string = "String"
 	typeBlob     string = "Blob"
 	typeJSON     string = "JSON"
+	typeGeoPoint string = "GeoPoint"
 )
 
 // this mapping is used to check that the default prop value
@@ -264,6 +265,8 @@ func IsValidIndexName(name string) bool {
 func indexFromAST(directive *ast.Directive, fieldDef *ast.FieldDefinition) (client.IndexDescription, error) {
 	var name string
 	var unique bool
+	var indexType client.IndexType
+	var filter map[string]any
 
 	var direction *ast.EnumValue
 	var includes *ast.ListValue
@@ -301,6 +304,26 @@ func indexFromAST(directive *ast.Directive, fieldDef *ast.FieldDefinition) (clie
 			}
 			unique = uniqueVal.Value
 
+		case types.IndexDirectivePropType:
+			typeVal, ok := arg.Value.(*ast.EnumValue)
+			if !ok {
+				return client.IndexDescription{}, ErrIndexWithInvalidArg
+			}
+			indexType, ok = types.IndexTypeEnum().ParseValue(typeVal.Value).(client.IndexType)
+			if !ok {
+				return client.IndexDescription{}, ErrIndexWithInvalidArg
+			}
+
+		case types.IndexDirectivePropFilter:
+			filterVal, ok := arg.Value.(*ast.ObjectValue)
+			if !ok || len(filterVal.Fields) == 0 {
+				return client.IndexDescription{}, ErrIndexWithInvalidArg
+			}
+			filter, ok = types.JSONScalarType().ParseLiteral(filterVal, nil).(map[string]any)
+			if !ok {
+				return client.IndexDescription{}, ErrIndexWithInvalidArg
+			}
+
 		default:
 			return client.IndexDescription{}, ErrIndexWithUnknownArg
 		}
@@ -315,7 +338,9 @@ func indexFromAST(directive *ast.Directive, fieldDef *ast.FieldDefinition) (clie
 			if err != nil {
 				return client.IndexDescription{}, err
 			}
-			if fieldDef != nil && fieldDef.Name.Value == field.Name {
+			// a path inside the field, such as `meta.customerId` of a JSON field `meta`,
+			// also indexes the field
+			if fieldDef != nil && fieldDef.Name.Value == field.FieldName() {
 				containsField = true
 			}
 			fields = append(fields, field)
@@ -343,6 +368,8 @@ func indexFromAST(directive *ast.Directive, fieldDef *ast.FieldDefinition) (clie
 		Name:   name,
 		Fields: fields,
 		Unique: unique,
+		Type:   indexType,
+		Filter: filter,
 	}, nil
 }
 
@@ -447,6 +474,13 @@ func fieldsFromAST(
 		return nil, nil, err
 	}
 
+	if directive, exists := findDirective(field, types.VectorDirectiveLabel); exists {
+		kind, err = vectorKindFromAST(field, kind, directive)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	cType, err := setCRDTType(field, kind)
 	if err != nil {
 		return nil, nil, err
@@ -603,9 +637,37 @@ func setCRDTType(field *ast.FieldDefinition, kind client.FieldKind) (client.CTyp
 		return client.LWW_REGISTER, nil
 	}
 
+	if _, ok := kind.(*client.VectorKind); ok {
+		return client.LWW_REGISTER, nil
+	}
+
 	return defaultCRDTForFieldKind[kind], nil
 }
 
+// vectorKindFromAST returns the vector kind declared by the given @vector directive.
+//
+// Only [Float!] fields may be declared as vectors.
+func vectorKindFromAST(
+	field *ast.FieldDefinition,
+	kind client.FieldKind,
+	directive *ast.Directive,
+) (client.FieldKind, error) {
+	if kind != client.FieldKind_FLOAT_ARRAY {
+		return nil, NewErrVectorInvalidFieldType(field.Name.Value, kind.String())
+	}
+	for _, arg := range directive.Arguments {
+		if arg.Name.Value != types.VectorDirectivePropDimension {
+			return nil, NewErrVectorWithUnknownArg(field.Name.Value, arg.Name.Value)
+		}
+		dimension, ok := gql.Int.ParseLiteral(arg.Value, nil).(int32)
+		if !ok || dimension <= 0 {
+			return nil, NewErrVectorInvalidDimension(field.Name.Value, arg.Value.GetValue())
+		}
+		return client.NewVectorKind(int(dimension)), nil
+	}
+	return nil, NewErrVectorInvalidDimension(field.Name.Value, nil)
+}
+
 func astTypeToKind(
 	hostObjectName string,
 	field *ast.FieldDefinition,
@@ -660,6 +722,8 @@ func astTypeToKind(
 			return client.FieldKind_NILLABLE_BLOB, nil
 		case typeJSON:
 			return client.FieldKind_NILLABLE_JSON, nil
+		case typeGeoPoint:
+			return client.FieldKind_NILLABLE_GEOPOINT, nil
 		default:
 			return client.NewNamedKind(astTypeVal.Name.Value, false), nil
 		}
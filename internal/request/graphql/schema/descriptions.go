@@ -35,6 +35,7 @@ var (
 		client.FieldKind_NILLABLE_STRING_ARRAY: gql.NewList(gql.String),
 		client.FieldKind_NILLABLE_BLOB:         schemaTypes.BlobScalarType(),
 		client.FieldKind_NILLABLE_JSON:         schemaTypes.JSONScalarType(),
+		client.FieldKind_NILLABLE_GEOPOINT:     schemaTypes.GeoPointScalarType(),
 	}
 
 	defaultCRDTForFieldKind = map[client.FieldKind]client.CType{
@@ -54,6 +55,7 @@ var (
 		client.FieldKind_NILLABLE_STRING_ARRAY: client.LWW_REGISTER,
 		client.FieldKind_NILLABLE_BLOB:         client.LWW_REGISTER,
 		client.FieldKind_NILLABLE_JSON:         client.LWW_REGISTER,
+		client.FieldKind_NILLABLE_GEOPOINT:     client.LWW_REGISTER,
 	}
 )
 
@@ -78,6 +80,17 @@ An optional value that specifies the commit ID of the document to return.
  corresponds to an older version of a document the document will be returned
  at the state it was in at the time of that commit. If a matching commit is
  not found then an empty set will be returned.
+`
+	asOfArgDescription string = `
+An optional value that specifies the time at which to return the documents.
+ Every document will be returned at the state it was in at that time, including
+ documents that have since been deleted. Documents created after that time will
+ not be returned. This argument cannot be used together with the cid argument.
+`
+	similarArgDescription string = `
+An optional k-nearest-neighbour search that returns the k documents whose vector field is
+ nearest to the given vector, ordered by distance. It is served by a vector index on the
+ field if one exists, in which case the results are approximate.
 `
 	singleFieldFilterArgDescription string = `
 An optional filter for this join, if the related record does
@@ -164,9 +177,65 @@ The group field may be used to return a set of records belonging to the group.
 `
 	deletedFieldDescription string = `
 Indicates as to whether or not this document has been deleted.
+`
+	scoreFieldDescription string = `
+The relevance of this document to the full-text search of the filter. It is only set when the
+ _search operator is served by a full-text index, and may be used to order the results.
+`
+	distanceFieldDescription string = `
+The Euclidean distance between the vector field of this document and the vector of the
+ _similar argument. It is only set when the _similar argument is used.
+`
+	cursorFieldDescription string = `
+An opaque cursor locating this document within the ordered results. It may be given to the
+ after and before arguments to fetch the results following or preceding this document.
 `
 	versionFieldDescription string = `
 Returns the head commit for this document.
+`
+	updateSpliceArgDescription string = `
+An optional set of text edits applied, in order, to the String fields of the documents
+ after the input values. When used on fields with the rga CRDT type only the edited
+ characters are recorded, so that concurrent edits are interleaved.
+`
+	conflictsFieldDescription string = `
+Returns the concurrently written values of the multi-value register fields of this
+ document. Only fields currently holding more than one value are returned.
+`
+	diffFieldDescription string = `
+Returns the fields of this document that changed between the given versions, with
+ their old and new values. Unchanged fields are not returned.
+`
+	diffFromArgDescription string = `
+The cid of the document version to compare from, as returned by the _version and
+ commits fields.
+`
+	diffToArgDescription string = `
+An optional cid of the document version to compare to. If it is not provided the
+ current state of the document is compared to.
+`
+	resolveDocumentDescription string = `
+Resolves conflicting values of the multi-value register fields of the document with
+ the given docID. The values written by the provided input supersede the conflicting
+ values identified by the given heads, any conflicting value that is not listed is kept.
+`
+	resolveIDArgDescription string = `
+The docID of the document to resolve.
+`
+	resolveHeadsArgDescription string = `
+The heads of the conflicting values to supersede, as returned by the _conflicts field.
+`
+	revertDocumentDescription string = `
+Reverts the document with the given docID to the state it had at the given version.
+ The history of the document is kept, the revert is written as a new update that
+ syncs to peers like any other update.
+`
+	revertIDArgDescription string = `
+The docID of the document to revert.
+`
+	revertCidArgDescription string = `
+The cid of the document version to revert to, as returned by the _version and
+ commits fields.
 `
 
 	encryptArgDescription string = `
@@ -35,6 +35,9 @@ const (
 	errDefaultValueInvalid           string = "default value is invalid"
 	errDefaultValueOneArg            string = "default value must specify one argument"
 	errFieldTypeNotSpecified         string = "field type not specified"
+	errVectorInvalidFieldType        string = "vector field must be of type [Float!]"
+	errVectorUnknownArgument         string = "vector with unknown argument"
+	errVectorInvalidDimension        string = "vector dimension must be a positive integer"
 )
 
 var (
@@ -62,6 +65,9 @@ var (
 	ErrPolicyInvalidIDProp       = errors.New(errPolicyInvalidIDProp)
 	ErrPolicyInvalidResourceProp = errors.New(errPolicyInvalidResourceProp)
 	ErrFieldTypeNotSpecified     = errors.New(errFieldTypeNotSpecified)
+	ErrVectorInvalidFieldType    = errors.New(errVectorInvalidFieldType)
+	ErrVectorWithUnknownArg      = errors.New(errVectorUnknownArgument)
+	ErrVectorInvalidDimension    = errors.New(errVectorInvalidDimension)
 )
 
 func NewErrDuplicateField(objectName, fieldName string) error {
@@ -182,3 +188,27 @@ func NewErrFieldTypeNotSpecified(objectName, fieldName string) error {
 		errors.NewKV("Field", fieldName),
 	)
 }
+
+func NewErrVectorInvalidFieldType(name string, kind string) error {
+	return errors.New(
+		errVectorInvalidFieldType,
+		errors.NewKV("Field", name),
+		errors.NewKV("Kind", kind),
+	)
+}
+
+func NewErrVectorWithUnknownArg(name string, arg string) error {
+	return errors.New(
+		errVectorUnknownArgument,
+		errors.NewKV("Field", name),
+		errors.NewKV("Argument", arg),
+	)
+}
+
+func NewErrVectorInvalidDimension(name string, dimension any) error {
+	return errors.New(
+		errVectorInvalidDimension,
+		errors.NewKV("Field", name),
+		errors.NewKV("Dimension", dimension),
+	)
+}
@@ -182,11 +182,16 @@ func (g *Generator) generate(ctx context.Context, collections []client.Collectio
 		case *gql.Scalar:
 			if _, isAggregate := request.Aggregates[def.Name]; isAggregate {
 				for name, aggregateTarget := range def.Args {
+					targetObject, isTarget := aggregateTarget.Type.(*gql.InputObject)
+					if !isTarget {
+						// Not all aggregate arguments are targets, for example the `_percentile` percentile
+						continue
+					}
 					expandedField := &gql.InputObjectFieldConfig{
 						Description: aggregateFilterArgDescription,
 						Type:        g.manager.schema.TypeMap()[name+filterInputNameSuffix],
 					}
-					aggregateTarget.Type.(*gql.InputObject).AddFieldConfig(request.FilterClause, expandedField)
+					targetObject.AddFieldConfig(request.FilterClause, expandedField)
 				}
 			}
 		}
@@ -318,6 +323,11 @@ func (g *Generator) createExpandedFieldAggregate(
 	f *gql.FieldDefinition,
 ) error {
 	for _, aggregateTarget := range f.Args {
+		targetObject, isTarget := aggregateTarget.Type.(*gql.InputObject)
+		if !isTarget {
+			// Not all aggregate arguments are targets, for example the `_percentile` percentile
+			continue
+		}
 		target := aggregateTarget.Name()
 		var filterTypeName string
 		if target == request.GroupFieldName {
@@ -348,7 +358,7 @@ func (g *Generator) createExpandedFieldAggregate(
 				Description: aggregateFilterArgDescription,
 				Type:        filterType,
 			}
-			aggregateTarget.Type.(*gql.InputObject).AddFieldConfig("filter", expandedField)
+			targetObject.AddFieldConfig("filter", expandedField)
 		}
 	}
 
@@ -394,12 +404,20 @@ func (g *Generator) createExpandedFieldList(
 				gql.NewList(gql.NewNonNull(g.manager.schema.TypeMap()[typeName+typeFieldEnumSuffix])),
 				schemaTypes.GroupByArgDescription,
 			),
+			request.DistinctClause: schemaTypes.NewArgConfig(
+				gql.NewList(gql.NewNonNull(g.manager.schema.TypeMap()[typeName+typeFieldEnumSuffix])),
+				schemaTypes.DistinctArgDescription,
+			),
 			"order": schemaTypes.NewArgConfig(
 				gql.NewList(g.manager.schema.TypeMap()[typeName+"OrderArg"]),
 				schemaTypes.OrderArgDescription,
 			),
 			request.LimitClause:  schemaTypes.NewArgConfig(gql.Int, schemaTypes.LimitArgDescription),
 			request.OffsetClause: schemaTypes.NewArgConfig(gql.Int, schemaTypes.OffsetArgDescription),
+			request.FirstClause:  schemaTypes.NewArgConfig(gql.Int, schemaTypes.FirstArgDescription),
+			request.AfterClause:  schemaTypes.NewArgConfig(gql.String, schemaTypes.AfterArgDescription),
+			request.LastClause:   schemaTypes.NewArgConfig(gql.Int, schemaTypes.LastArgDescription),
+			request.BeforeClause: schemaTypes.NewArgConfig(gql.String, schemaTypes.BeforeArgDescription),
 		},
 	}
 
@@ -480,7 +498,7 @@ func (g *Generator) buildTypes(
 					}
 				} else {
 					var ok bool
-					ttype, ok = fieldKindToGQLType[field.Kind]
+					ttype, ok = gqlTypeForFieldKind(field.Kind)
 					if !ok {
 						return nil, NewErrTypeNotFound(field.Kind.String())
 					}
@@ -514,6 +532,43 @@ func (g *Generator) buildTypes(
 					Description: deletedFieldDescription,
 					Type:        gql.Boolean,
 				}
+
+				// add _score field
+				fields[request.ScoreFieldName] = &gql.Field{
+					Description: scoreFieldDescription,
+					Type:        gql.Float,
+				}
+
+				// add _distance field
+				fields[request.DistanceFieldName] = &gql.Field{
+					Description: distanceFieldDescription,
+					Type:        gql.Float,
+				}
+
+				// add _cursor field
+				fields[request.CursorFieldName] = &gql.Field{
+					Description: cursorFieldDescription,
+					Type:        gql.String,
+				}
+
+				// add _conflicts field
+				fields[request.ConflictsFieldName] = &gql.Field{
+					Description: conflictsFieldDescription,
+					Type:        gql.NewList(g.manager.schema.TypeMap()[request.ConflictTypeName]),
+				}
+
+				// add _diff field
+				fields[request.DiffFieldName] = &gql.Field{
+					Description: diffFieldDescription,
+					Type:        gql.NewList(g.manager.schema.TypeMap()[request.DiffTypeName]),
+					Args: gql.FieldConfigArgument{
+						request.FromArgName: schemaTypes.NewArgConfig(
+							gql.NewNonNull(gql.String),
+							diffFromArgDescription,
+						),
+						request.ToArgName: schemaTypes.NewArgConfig(gql.String, diffToArgDescription),
+					},
+				}
 			}
 
 			return fields, nil
@@ -589,7 +644,7 @@ func (g *Generator) buildMutationInputTypes(collections []client.CollectionDefin
 					}
 				} else {
 					var ok bool
-					ttype, ok = fieldKindToGQLType[field.Kind]
+					ttype, ok = gqlTypeForFieldKind(field.Kind)
 					if !ok {
 						return nil, NewErrTypeNotFound(fmt.Sprint(field.Kind))
 					}
@@ -613,6 +668,7 @@ func (g *Generator) buildMutationInputTypes(collections []client.CollectionDefin
 func (g *Generator) genAggregateFields() error {
 	topLevelCountInputs := map[string]*gql.InputObject{}
 	topLevelNumericAggInputs := map[string]*gql.InputObject{}
+	topLevelCountDistinctInputs := map[string]*gql.InputObject{}
 
 	for _, t := range g.typeDefs {
 		numArg := g.genNumericAggregateBaseArgInputs(t)
@@ -645,6 +701,13 @@ func (g *Generator) genAggregateFields() error {
 				return err
 			}
 		}
+
+		countDistinctArg := g.genCountDistinctBaseArgInputs(t)
+		topLevelCountDistinctInputs[t.Name()] = countDistinctArg
+		err = g.appendIfNotExists(countDistinctArg)
+		if err != nil {
+			return err
+		}
 	}
 
 	for _, t := range g.typeDefs {
@@ -677,6 +740,13 @@ func (g *Generator) genAggregateFields() error {
 			return err
 		}
 		t.AddFieldConfig(minField.Name, &minField)
+
+		for _, statisticField := range g.genStatisticFieldConfigs(t) {
+			t.AddFieldConfig(statisticField.Name, statisticField)
+		}
+
+		countDistinctField := g.genCountDistinctFieldConfig(t)
+		t.AddFieldConfig(countDistinctField.Name, &countDistinctField)
 	}
 
 	queryType := g.manager.schema.QueryType()
@@ -687,6 +757,9 @@ func (g *Generator) genAggregateFields() error {
 		queryType.AddFieldConfig(topLevelAgg.Name, topLevelAgg)
 	}
 
+	topLevelCountDistinctField := genTopLevelCountDistinct(topLevelCountDistinctInputs)
+	queryType.AddFieldConfig(topLevelCountDistinctField.Name, topLevelCountDistinctField)
+
 	return nil
 }
 
@@ -705,6 +778,21 @@ func genTopLevelCount(topLevelCountInputs map[string]*gql.InputObject) *gql.Fiel
 	return &topLevelCountField
 }
 
+func genTopLevelCountDistinct(topLevelCountDistinctInputs map[string]*gql.InputObject) *gql.Field {
+	topLevelCountDistinctField := gql.Field{
+		Name:        request.CountDistinctFieldName,
+		Description: schemaTypes.CountDistinctFieldDescription,
+		Type:        gql.Int,
+		Args:        gql.FieldConfigArgument{},
+	}
+
+	for name, inputObject := range topLevelCountDistinctInputs {
+		topLevelCountDistinctField.Args[name] = schemaTypes.NewArgConfig(inputObject, inputObject.Description())
+	}
+
+	return &topLevelCountDistinctField
+}
+
 func genTopLevelNumericAggregates(topLevelNumericAggInputs map[string]*gql.InputObject) []*gql.Field {
 	topLevelSumField := gql.Field{
 		Name:        request.SumFieldName,
@@ -741,11 +829,57 @@ func genTopLevelNumericAggregates(topLevelNumericAggInputs map[string]*gql.Input
 		topLevelMinimumField.Args[name] = schemaTypes.NewArgConfig(inputObject, inputObject.Description())
 	}
 
+	topLevelStatisticFields := genStatisticFields()
+	for _, field := range topLevelStatisticFields {
+		for name, inputObject := range topLevelNumericAggInputs {
+			field.Args[name] = schemaTypes.NewArgConfig(inputObject, inputObject.Description())
+		}
+	}
+
+	return append(
+		[]*gql.Field{
+			&topLevelSumField,
+			&topLevelAverageField,
+			&topLevelMaximumField,
+			&topLevelMinimumField,
+		},
+		topLevelStatisticFields...,
+	)
+}
+
+// genStatisticFields returns the statistical aggregate fields (median, percentile, etc.),
+// without any aggregate target arguments.
+func genStatisticFields() []*gql.Field {
 	return []*gql.Field{
-		&topLevelSumField,
-		&topLevelAverageField,
-		&topLevelMaximumField,
-		&topLevelMinimumField,
+		{
+			Name:        request.MedianFieldName,
+			Description: schemaTypes.MedianFieldDescription,
+			Type:        gql.Float,
+			Args:        gql.FieldConfigArgument{},
+		},
+		{
+			Name:        request.StdDevFieldName,
+			Description: schemaTypes.StdDevFieldDescription,
+			Type:        gql.Float,
+			Args:        gql.FieldConfigArgument{},
+		},
+		{
+			Name:        request.VarianceFieldName,
+			Description: schemaTypes.VarianceFieldDescription,
+			Type:        gql.Float,
+			Args:        gql.FieldConfigArgument{},
+		},
+		{
+			Name:        request.PercentileFieldName,
+			Description: schemaTypes.PercentileFieldDescription,
+			Type:        gql.Float,
+			Args: gql.FieldConfigArgument{
+				request.PercentileArgName: schemaTypes.NewArgConfig(
+					gql.NewNonNull(gql.Float),
+					schemaTypes.PercentileArgDescription,
+				),
+			},
+		},
 	}
 }
 
@@ -846,6 +980,53 @@ func (g *Generator) genAverageFieldConfig(obj *gql.Object) (gql.Field, error) {
 	return field, nil
 }
 
+func (g *Generator) genStatisticFieldConfigs(obj *gql.Object) []*gql.Field {
+	fields := genStatisticFields()
+
+	childTypesByFieldName := g.getNumericFields(obj)
+	for _, field := range fields {
+		for name, inputObject := range childTypesByFieldName {
+			field.Args[name] = schemaTypes.NewArgConfig(inputObject, inputObject.Description())
+		}
+	}
+	return fields
+}
+
+// genCountDistinctFieldConfig returns the `_countDistinct` field of the given object.
+//
+// Unlike the statistics, the distinct values of any scalar field can be counted, so
+// related objects are targeted through their count distinct selector and inline arrays
+// of any scalar through their count selector.
+func (g *Generator) genCountDistinctFieldConfig(obj *gql.Object) gql.Field {
+	field := gql.Field{
+		Name:        request.CountDistinctFieldName,
+		Description: schemaTypes.CountDistinctFieldDescription,
+		Type:        gql.Int,
+		Args:        gql.FieldConfigArgument{},
+	}
+
+	for _, objField := range obj.Fields() {
+		listType, isList := objField.Type.(*gql.List)
+		if !isList {
+			continue
+		}
+
+		var inputObjectName string
+		if gql.IsLeafType(listType.OfType) {
+			inputObjectName = genNumericInlineArrayCountName(obj.Name(), objField.Name)
+		} else {
+			inputObjectName = genCountDistinctObjectSelectorName(listType.OfType.Name())
+		}
+
+		inputObject, isCountable := g.manager.schema.TypeMap()[inputObjectName]
+		if !isCountable {
+			continue
+		}
+		field.Args[objField.Name] = schemaTypes.NewArgConfig(inputObject, inputObject.Description())
+	}
+	return field
+}
+
 func (g *Generator) getNumericFields(obj *gql.Object) map[string]gql.Type {
 	fieldTypes := map[string]gql.Type{}
 	for _, field := range obj.Fields() {
@@ -1043,6 +1224,67 @@ func (g *Generator) genNumericAggregateBaseArgInputs(obj *gql.Object) *gql.Input
 	})
 }
 
+func genCountDistinctObjectSelectorName(hostName string) string {
+	return fmt.Sprintf("%s__%s", hostName, "CountDistinctSelector")
+}
+
+// Generates the count distinct input object-type for the given gql object, declaring
+// which fields can have their distinct values counted.
+//
+// Any scalar field can be targeted, as the values are compared by their encoding.
+func (g *Generator) genCountDistinctBaseArgInputs(obj *gql.Object) *gql.InputObject {
+	var fieldThunk gql.InputObjectConfigFieldMapThunk = func() (gql.InputObjectConfigFieldMap, error) {
+		fieldsEnum, enumExists := g.manager.schema.TypeMap()[genTypeName(obj, "CountDistinctFieldsArg")]
+		if !enumExists {
+			fieldsEnumCfg := gql.EnumConfig{
+				Name:   genTypeName(obj, "CountDistinctFieldsArg"),
+				Values: gql.EnumValueConfigMap{},
+			}
+
+			for _, field := range obj.Fields() {
+				if _, isScalar := gql.GetNullable(field.Type).(*gql.Scalar); !isScalar {
+					continue
+				}
+				// The system fields and the aggregates are not counted, with the exception of the docID
+				if strings.HasPrefix(field.Name, "_") && field.Name != request.DocIDFieldName {
+					continue
+				}
+				fieldsEnumCfg.Values[field.Name] = &gql.EnumValueConfig{Value: field.Name}
+			}
+
+			fieldsEnum = gql.NewEnum(fieldsEnumCfg)
+
+			err := g.manager.schema.AppendType(fieldsEnum)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return gql.InputObjectConfigFieldMap{
+			"field": &gql.InputObjectFieldConfig{
+				Type: gql.NewNonNull(fieldsEnum),
+			},
+			request.LimitClause: &gql.InputObjectFieldConfig{
+				Type:        gql.Int,
+				Description: schemaTypes.LimitArgDescription,
+			},
+			request.OffsetClause: &gql.InputObjectFieldConfig{
+				Type:        gql.Int,
+				Description: schemaTypes.OffsetArgDescription,
+			},
+			request.OrderClause: &gql.InputObjectFieldConfig{
+				Type:        gql.NewList(g.manager.schema.TypeMap()[genTypeName(obj, "OrderArg")]),
+				Description: schemaTypes.OrderArgDescription,
+			},
+		}, nil
+	}
+
+	return gql.NewInputObject(gql.InputObjectConfig{
+		Name:   genCountDistinctObjectSelectorName(obj.Name()),
+		Fields: fieldThunk,
+	})
+}
+
 func (g *Generator) appendCommitChildGroupField() {
 	commitObject := g.manager.schema.TypeMap()[request.CommitTypeName]
 
@@ -1096,6 +1338,11 @@ func (g *Generator) GenerateMutationInputForGQLType(obj *gql.Object) ([]*gql.Fie
 		return nil, NewErrTypeNotFound(mutationInputName)
 	}
 
+	textSpliceInput, ok := g.manager.schema.TypeMap()[request.TextSpliceTypeName]
+	if !ok {
+		return nil, NewErrTypeNotFound(request.TextSpliceTypeName)
+	}
+
 	explicitUserFieldsEnum := g.genUserExplicitTypeFieldsEnum(obj)
 
 	g.manager.schema.TypeMap()[explicitUserFieldsEnum.Name()] = explicitUserFieldsEnum
@@ -1121,6 +1368,10 @@ func (g *Generator) GenerateMutationInputForGQLType(obj *gql.Object) ([]*gql.Fie
 			request.DocIDArgName: schemaTypes.NewArgConfig(gql.NewList(gql.ID), updateIDsArgDescription),
 			"filter":             schemaTypes.NewArgConfig(filterInput, updateFilterArgDescription),
 			request.Input:        schemaTypes.NewArgConfig(mutationInput, "Update field values"),
+			request.SpliceArgName: schemaTypes.NewArgConfig(
+				gql.NewList(gql.NewNonNull(textSpliceInput)),
+				updateSpliceArgDescription,
+			),
 		},
 	}
 
@@ -1145,7 +1396,31 @@ func (g *Generator) GenerateMutationInputForGQLType(obj *gql.Object) ([]*gql.Fie
 		},
 	}
 
-	return []*gql.Field{create, update, delete, upsert}, nil
+	resolve := &gql.Field{
+		Name:        "resolve_" + obj.Name(),
+		Description: resolveDocumentDescription,
+		Type:        gql.NewList(obj),
+		Args: gql.FieldConfigArgument{
+			request.DocIDArgName: schemaTypes.NewArgConfig(gql.NewNonNull(gql.ID), resolveIDArgDescription),
+			request.HeadsArgName: schemaTypes.NewArgConfig(
+				gql.NewNonNull(gql.NewList(gql.NewNonNull(gql.String))),
+				resolveHeadsArgDescription,
+			),
+			request.Input: schemaTypes.NewArgConfig(gql.NewNonNull(mutationInput), "Resolved field values"),
+		},
+	}
+
+	revert := &gql.Field{
+		Name:        "revert_" + obj.Name(),
+		Description: revertDocumentDescription,
+		Type:        gql.NewList(obj),
+		Args: gql.FieldConfigArgument{
+			request.DocIDArgName: schemaTypes.NewArgConfig(gql.NewNonNull(gql.ID), revertIDArgDescription),
+			request.Cid:          schemaTypes.NewArgConfig(gql.NewNonNull(gql.String), revertCidArgDescription),
+		},
+	}
+
+	return []*gql.Field{create, update, delete, upsert, resolve, revert}, nil
 }
 
 func (g *Generator) genTypeFieldsEnum(obj *gql.Object) *gql.Enum {
@@ -1204,7 +1479,9 @@ func (g *Generator) genTypeFilterArgInput(obj *gql.Object) *gql.InputObject {
 			// generate basic filter operator blocks
 			for f, field := range obj.Fields() {
 				_, ok := request.ReservedFields[f]
-				if ok && f != request.DocIDFieldName {
+				// aggregates can be filtered, once computed, at the top level of a select filter
+				_, isAggregate := request.Aggregates[f]
+				if ok && f != request.DocIDFieldName && !isAggregate {
 					continue
 				}
 				operatorName := genFilterOperatorName(field.Type)
@@ -1267,8 +1544,13 @@ func (g *Generator) genLeafFilterArgInput(obj gql.Type) *gql.InputObject {
 		}
 
 		for f, field := range operatorObject.Fields() {
+			fieldType := field.Type
+			if base, ok := schemaTypes.FieldReferenceScalarBase(fieldType); ok {
+				// The items of inline arrays have no fields to compare with.
+				fieldType = base
+			}
 			fields[f] = &gql.InputObjectFieldConfig{
-				Type: field.Type,
+				Type: fieldType,
 			}
 		}
 
@@ -1289,7 +1571,8 @@ func (g *Generator) genTypeOrderArgInput(obj *gql.Object) *gql.InputObject {
 			fields := gql.InputObjectConfigFieldMap{}
 
 			for f, field := range obj.Fields() {
-				if _, ok := request.ReservedFields[f]; ok && f != request.DocIDFieldName {
+				if _, ok := request.ReservedFields[f]; ok && f != request.DocIDFieldName &&
+					f != request.ScoreFieldName && f != request.DistanceFieldName {
 					continue
 				}
 				typeMap := g.manager.schema.TypeMap()
@@ -1305,6 +1588,15 @@ func (g *Generator) genTypeOrderArgInput(obj *gql.Object) *gql.InputObject {
 				}
 			}
 
+			// aggregates over lists, such as related collections, can be ordered by
+			aggregateFields, err := g.genAggregateOrderArgFields(obj)
+			if err != nil {
+				return nil, err
+			}
+			for name, field := range aggregateFields {
+				fields[name] = field
+			}
+
 			return fields, nil
 		},
 	)
@@ -1313,6 +1605,121 @@ func (g *Generator) genTypeOrderArgInput(obj *gql.Object) *gql.InputObject {
 	return gql.NewInputObject(inputCfg)
 }
 
+// genAggregateOrderArgFields generates the order input fields of the aggregates over the
+// list fields of the given object.
+//
+// Lists can be ordered by their count, i.e. `{_count: {books: DESC}}`, and numeric lists by
+// their sum, average, minimum and maximum, i.e. `{_sum: {books: {pages: DESC}}}`.
+func (g *Generator) genAggregateOrderArgFields(obj *gql.Object) (gql.InputObjectConfigFieldMap, error) {
+	typeMap := g.manager.schema.TypeMap()
+	countFields := gql.InputObjectConfigFieldMap{}
+	numericFields := gql.InputObjectConfigFieldMap{}
+
+	for name, field := range obj.Fields() {
+		if _, ok := request.ReservedFields[name]; ok {
+			continue
+		}
+		listType, isList := field.Type.(*gql.List)
+		if !isList {
+			continue
+		}
+
+		countFields[name] = &gql.InputObjectFieldConfig{
+			Type: typeMap["Ordering"],
+		}
+
+		if isNumericArray(listType) {
+			numericFields[name] = &gql.InputObjectFieldConfig{
+				Type: typeMap["Ordering"],
+			}
+			continue
+		}
+
+		childObj, isObject := listType.OfType.(*gql.Object)
+		if !isObject {
+			continue
+		}
+		numericOrderArg, err := g.genNumericOrderArgInput(childObj)
+		if err != nil {
+			return nil, err
+		}
+		if numericOrderArg != nil {
+			numericFields[name] = &gql.InputObjectFieldConfig{
+				Type: numericOrderArg,
+			}
+		}
+	}
+
+	fields := gql.InputObjectConfigFieldMap{}
+	if len(countFields) > 0 {
+		countOrderArg, err := g.getOrAppendInputObject(genTypeName(obj, "CountOrderArg"), countFields)
+		if err != nil {
+			return nil, err
+		}
+		fields[request.CountFieldName] = &gql.InputObjectFieldConfig{
+			Type: countOrderArg,
+		}
+	}
+	if len(numericFields) > 0 {
+		numericOrderArg, err := g.getOrAppendInputObject(
+			genTypeName(obj, "NumericAggregateOrderArg"),
+			numericFields,
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range []string{
+			request.SumFieldName,
+			request.AverageFieldName,
+			request.MinFieldName,
+			request.MaxFieldName,
+		} {
+			fields[name] = &gql.InputObjectFieldConfig{
+				Type: numericOrderArg,
+			}
+		}
+	}
+
+	return fields, nil
+}
+
+// genNumericOrderArgInput generates the input object selecting which numeric field of the
+// given object to aggregate and order by, it returns nil if the object has no numeric fields.
+func (g *Generator) genNumericOrderArgInput(obj *gql.Object) (*gql.InputObject, error) {
+	fields := gql.InputObjectConfigFieldMap{}
+	for name, field := range obj.Fields() {
+		if field.Type == gql.Float || field.Type == gql.Int {
+			fields[name] = &gql.InputObjectFieldConfig{
+				Type: g.manager.schema.TypeMap()["Ordering"],
+			}
+		}
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return g.getOrAppendInputObject(genTypeName(obj, "NumericOrderArg"), fields)
+}
+
+// getOrAppendInputObject returns the input object of the given name, creating it with the
+// given fields and appending it to the schema if it does not exist yet.
+func (g *Generator) getOrAppendInputObject(
+	name string,
+	fields gql.InputObjectConfigFieldMap,
+) (*gql.InputObject, error) {
+	if existing, ok := g.manager.schema.TypeMap()[name].(*gql.InputObject); ok {
+		return existing, nil
+	}
+	obj := gql.NewInputObject(gql.InputObjectConfig{
+		Name:   name,
+		Fields: fields,
+	})
+	err := g.manager.schema.AppendType(obj)
+	if err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
 type queryInputTypeConfig struct {
 	filter  *gql.InputObject
 	groupBy *gql.Enum
@@ -1337,15 +1744,28 @@ func (g *Generator) genTypeQueryableFieldList(
 		Args: gql.FieldConfigArgument{
 			request.DocIDArgName: schemaTypes.NewArgConfig(gql.NewList(gql.NewNonNull(gql.String)), docIDsArgDescription),
 			"cid":                schemaTypes.NewArgConfig(gql.String, cidArgDescription),
-			"filter":             schemaTypes.NewArgConfig(config.filter, selectFilterArgDescription),
+			request.AsOfArgName:  schemaTypes.NewArgConfig(gql.DateTime, asOfArgDescription),
+			request.SimilarArgName: schemaTypes.NewArgConfig(
+				g.manager.schema.TypeMap()[schemaTypes.SimilarArgTypeName],
+				similarArgDescription,
+			),
+			"filter": schemaTypes.NewArgConfig(config.filter, selectFilterArgDescription),
 			"groupBy": schemaTypes.NewArgConfig(
 				gql.NewList(gql.NewNonNull(config.groupBy)),
 				schemaTypes.GroupByArgDescription,
 			),
+			request.DistinctClause: schemaTypes.NewArgConfig(
+				gql.NewList(gql.NewNonNull(config.groupBy)),
+				schemaTypes.DistinctArgDescription,
+			),
 			"order":              schemaTypes.NewArgConfig(gql.NewList(config.order), schemaTypes.OrderArgDescription),
 			request.ShowDeleted:  schemaTypes.NewArgConfig(gql.Boolean, showDeletedArgDescription),
 			request.LimitClause:  schemaTypes.NewArgConfig(gql.Int, schemaTypes.LimitArgDescription),
 			request.OffsetClause: schemaTypes.NewArgConfig(gql.Int, schemaTypes.OffsetArgDescription),
+			request.FirstClause:  schemaTypes.NewArgConfig(gql.Int, schemaTypes.FirstArgDescription),
+			request.AfterClause:  schemaTypes.NewArgConfig(gql.String, schemaTypes.AfterArgDescription),
+			request.LastClause:   schemaTypes.NewArgConfig(gql.Int, schemaTypes.LastArgDescription),
+			request.BeforeClause: schemaTypes.NewArgConfig(gql.String, schemaTypes.BeforeArgDescription),
 		},
 	}
 
@@ -1369,6 +1789,15 @@ func (g *Generator) Reset() {
 	g.expandedFields = make(map[string]bool)
 }
 
+// gqlTypeForFieldKind returns the GQL type of fields of the given scalar kind.
+func gqlTypeForFieldKind(kind client.FieldKind) (gql.Type, bool) {
+	if _, ok := kind.(*client.VectorKind); ok {
+		kind = client.FieldKind_FLOAT_ARRAY
+	}
+	ttype, ok := fieldKindToGQLType[kind]
+	return ttype, ok
+}
+
 func genTypeName(obj gql.Type, name string) string {
 	return fmt.Sprintf("%s%s", obj.Name(), name)
 }
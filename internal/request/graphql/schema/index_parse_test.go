@@ -114,6 +114,20 @@ func TestParseIndexOnStruct(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "Index with a filter",
+			sdl:         `type user @index(includes: [{field: "name"}], filter: {status: {_eq: "open"}}) {}`,
+			targetDescriptions: []client.IndexDescription{
+				{
+					Fields: []client.IndexedFieldDescription{
+						{Name: "name"},
+					},
+					Filter: map[string]any{
+						"status": map[string]any{"_eq": "open"},
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range cases {
@@ -163,6 +177,11 @@ func TestParseInvalidIndexOnStruct(t *testing.T) {
 			sdl:         `type user @index(includes: [{field: "name"}], unique: "true") {}`,
 			expectedErr: `Argument "unique" has invalid value "true"`,
 		},
+		{
+			description: "invalid 'type' value",
+			sdl:         `type user @index(includes: [{field: "name"}], type: UNKNOWN) {}`,
+			expectedErr: `Argument "type" has invalid value UNKNOWN`,
+		},
 		{
 			description: "invalid 'includes' value type (not a list)",
 			sdl:         `type user @index(includes: "name") {}`,
@@ -173,6 +192,16 @@ func TestParseInvalidIndexOnStruct(t *testing.T) {
 			sdl:         `type user @index(includes: [1]) {}`,
 			expectedErr: `Argument "includes" has invalid value [1]`,
 		},
+		{
+			description: "invalid 'filter' value type (not an object)",
+			sdl:         `type user @index(includes: [{field: "name"}], filter: "open") {}`,
+			expectedErr: errIndexInvalidArgument,
+		},
+		{
+			description: "empty 'filter' value",
+			sdl:         `type user @index(includes: [{field: "name"}], filter: {}) {}`,
+			expectedErr: errIndexInvalidArgument,
+		},
 	}
 
 	for _, test := range cases {
@@ -240,6 +269,34 @@ func TestParseIndexOnField(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "full-text field index",
+			sdl: `type user {
+				name: String @index(type: FULLTEXT)
+			}`,
+			targetDescriptions: []client.IndexDescription{
+				{
+					Fields: []client.IndexedFieldDescription{
+						{Name: "name"},
+					},
+					Type: client.IndexTypeFullText,
+				},
+			},
+		},
+		{
+			description: "vector field index",
+			sdl: `type user {
+				embedding: [Float!] @vector(dimension: 3) @index(type: VECTOR)
+			}`,
+			targetDescriptions: []client.IndexDescription{
+				{
+					Fields: []client.IndexedFieldDescription{
+						{Name: "embedding"},
+					},
+					Type: client.IndexTypeVector,
+				},
+			},
+		},
 		{
 			description: "field index in ASC order",
 			sdl: `type user {
@@ -319,6 +376,21 @@ func TestParseIndexOnField(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "field index on path inside JSON field",
+			sdl: `type user {
+				meta: JSON @index(includes: [{field: "meta.customerId"}])
+			}`,
+			targetDescriptions: []client.IndexDescription{
+				{
+					Name: "",
+					Fields: []client.IndexedFieldDescription{
+						{Name: "meta.customerId", Descending: false},
+					},
+					Unique: false,
+				},
+			},
+		},
 	}
 
 	for _, test := range cases {
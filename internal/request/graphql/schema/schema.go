@@ -21,33 +21,67 @@ func defaultSchema() (gql.Schema, error) {
 	orderEnum := types.OrderingEnum()
 	crdtEnum := types.CRDTEnum()
 	explainEnum := types.ExplainEnum()
+	indexTypeEnum := types.IndexTypeEnum()
 
 	commitLinkObject := types.CommitLinkObject()
 	commitObject := types.CommitObject(commitLinkObject)
 	commitsOrderArg := types.CommitsOrderArg(orderEnum)
 
+	// The operator blocks used by the commits filter must be the same instances as the
+	// ones added to the schema types.
+	intCompareType := types.FieldReferenceScalarType(gql.Int)
+	floatCompareType := types.FieldReferenceScalarType(gql.Float)
+	stringCompareType := types.FieldReferenceScalarType(gql.String)
+	dateTimeCompareType := types.FieldReferenceScalarType(gql.DateTime)
+	intOpBlock := types.IntOperatorBlock(intCompareType)
+	regexInput := types.RegexInputObject()
+	stringOpBlock := types.StringOperatorBlock(stringCompareType, regexInput)
+	dateTimeOpBlock := types.DateTimeOperatorBlock(dateTimeCompareType)
+	commitsFilterArg := types.CommitsFilterArg(intOpBlock, stringOpBlock, dateTimeOpBlock)
+
 	indexFieldInput := types.IndexFieldInputObject(orderEnum)
 
+	jsonScalarType := types.JSONScalarType()
+	conflictObject := types.ConflictObject(jsonScalarType)
+	diffObject := types.DiffObject(jsonScalarType)
+
 	return gql.NewSchema(gql.SchemaConfig{
 		Types: defaultTypes(
 			commitObject,
 			commitLinkObject,
 			commitsOrderArg,
+			commitsFilterArg,
+			jsonScalarType,
+			conflictObject,
+			diffObject,
 			orderEnum,
 			crdtEnum,
 			explainEnum,
+			indexTypeEnum,
 			indexFieldInput,
+			intCompareType,
+			floatCompareType,
+			stringCompareType,
+			dateTimeCompareType,
+			regexInput,
+			intOpBlock,
+			stringOpBlock,
+			dateTimeOpBlock,
 		),
-		Query:        defaultQueryType(commitObject, commitsOrderArg),
+		Query:        defaultQueryType(commitObject, commitsOrderArg, commitsFilterArg),
 		Mutation:     defaultMutationType(),
-		Directives:   defaultDirectivesType(crdtEnum, explainEnum, orderEnum, indexFieldInput),
+		Directives:   defaultDirectivesType(crdtEnum, explainEnum, orderEnum, indexTypeEnum, indexFieldInput),
 		Subscription: defaultSubscriptionType(),
 	})
 }
 
 // @todo: Use a better default Query type
-func defaultQueryType(commitObject *gql.Object, commitsOrderArg *gql.InputObject) *gql.Object {
-	queryCommits := types.QueryCommits(commitObject, commitsOrderArg)
+func defaultQueryType(
+	commitObject *gql.Object,
+	commitsOrderArg *gql.InputObject,
+	commitsFilterArg *gql.InputObject,
+) *gql.Object {
+	queryCommits := types.QueryCommits(commitObject, commitsOrderArg, commitsFilterArg)
 	queryLatestCommits := types.QueryLatestCommits(commitObject)
 
 	return gql.NewObject(gql.ObjectConfig{
@@ -94,6 +128,7 @@ func defaultDirectivesType(
 	crdtEnum *gql.Enum,
 	explainEnum *gql.Enum,
 	orderEnum *gql.Enum,
+	indexTypeEnum *gql.Enum,
 	indexFieldInput *gql.InputObject,
 ) []*gql.Directive {
 	return []*gql.Directive{
@@ -101,10 +136,11 @@ func defaultDirectivesType(
 		types.DefaultDirective(),
 		types.ExplainDirective(explainEnum),
 		types.PolicyDirective(),
-		types.IndexDirective(orderEnum, indexFieldInput),
+		types.IndexDirective(orderEnum, indexTypeEnum, indexFieldInput),
 		types.PrimaryDirective(),
 		types.RelationDirective(),
 		types.MaterializedDirective(),
+		types.VectorDirective(),
 	}
 }
 
@@ -126,26 +162,39 @@ func defaultTypes(
 	commitObject *gql.Object,
 	commitLinkObject *gql.Object,
 	commitsOrderArg *gql.InputObject,
+	commitsFilterArg *gql.InputObject,
+	jsonScalarType *gql.Scalar,
+	conflictObject *gql.Object,
+	diffObject *gql.Object,
 	orderEnum *gql.Enum,
 	crdtEnum *gql.Enum,
 	explainEnum *gql.Enum,
+	indexTypeEnum *gql.Enum,
 	indexFieldInput *gql.InputObject,
+	intCompareType *gql.Scalar,
+	floatCompareType *gql.Scalar,
+	stringCompareType *gql.Scalar,
+	dateTimeCompareType *gql.Scalar,
+	regexInput *gql.InputObject,
+	intOpBlock *gql.InputObject,
+	stringOpBlock *gql.InputObject,
+	dateTimeOpBlock *gql.InputObject,
 ) []gql.Type {
 	blobScalarType := types.BlobScalarType()
-	jsonScalarType := types.JSONScalarType()
+	geoPointScalarType := types.GeoPointScalarType()
+	geoRadiusInput := types.GeoRadiusInputObject()
+	geoBoxInput := types.GeoBoxInputObject()
 
 	idOpBlock := types.IDOperatorBlock()
-	intOpBlock := types.IntOperatorBlock()
-	floatOpBlock := types.FloatOperatorBlock()
+	floatOpBlock := types.FloatOperatorBlock(floatCompareType)
 	booleanOpBlock := types.BooleanOperatorBlock()
-	stringOpBlock := types.StringOperatorBlock()
 	blobOpBlock := types.BlobOperatorBlock(blobScalarType)
-	dateTimeOpBlock := types.DateTimeOperatorBlock()
+	geoPointOpBlock := types.GeoPointOperatorBlock(geoPointScalarType, geoRadiusInput, geoBoxInput)
 
-	notNullIntOpBlock := types.NotNullIntOperatorBlock()
-	notNullFloatOpBlock := types.NotNullFloatOperatorBlock()
+	notNullIntOpBlock := types.NotNullIntOperatorBlock(intCompareType)
+	notNullFloatOpBlock := types.NotNullFloatOperatorBlock(floatCompareType)
 	notNullBooleanOpBlock := types.NotNullBooleanOperatorBlock()
-	notNullStringOpBlock := types.NotNullStringOperatorBlock()
+	notNullStringOpBlock := types.NotNullStringOperatorBlock(stringCompareType, regexInput)
 	notNullBlobOpBlock := types.NotNullBlobOperatorBlock(blobScalarType)
 
 	return []gql.Type{
@@ -160,6 +209,13 @@ func defaultTypes(
 		// Custom Scalar types
 		blobScalarType,
 		jsonScalarType,
+		geoPointScalarType,
+
+		// Filter comparison scalar types
+		intCompareType,
+		floatCompareType,
+		stringCompareType,
+		dateTimeCompareType,
 
 		// Base Query types
 
@@ -174,6 +230,7 @@ func defaultTypes(
 		stringOpBlock,
 		blobOpBlock,
 		dateTimeOpBlock,
+		geoPointOpBlock,
 
 		// Filter non null scalar blocks
 		notNullIntOpBlock,
@@ -195,11 +252,22 @@ func defaultTypes(
 		types.NotNullStringListOperatorBlock(notNullStringOpBlock),
 
 		commitsOrderArg,
+		commitsFilterArg,
 		commitLinkObject,
 		commitObject,
 
+		conflictObject,
+		diffObject,
+
+		types.TextSpliceInputObject(),
+		types.SimilarArgInputObject(),
+		geoRadiusInput,
+		geoBoxInput,
+		regexInput,
+
 		crdtEnum,
 		explainEnum,
+		indexTypeEnum,
 
 		indexFieldInput,
 	}
@@ -111,34 +111,34 @@ func NotNullBooleanListOperatorBlock(op *gql.InputObject) *gql.InputObject {
 }
 
 // DateTimeOperatorBlock filter block for DateTime types.
-func DateTimeOperatorBlock() *gql.InputObject {
+func DateTimeOperatorBlock(compareType *gql.Scalar) *gql.InputObject {
 	return gql.NewInputObject(gql.InputObjectConfig{
 		Name:        "DateTimeOperatorBlock",
 		Description: dateTimeOperatorBlockDescription,
 		Fields: gql.InputObjectConfigFieldMap{
 			"_eq": &gql.InputObjectFieldConfig{
 				Description: eqOperatorDescription,
-				Type:        gql.DateTime,
+				Type:        compareType,
 			},
 			"_ne": &gql.InputObjectFieldConfig{
 				Description: neOperatorDescription,
-				Type:        gql.DateTime,
+				Type:        compareType,
 			},
 			"_gt": &gql.InputObjectFieldConfig{
 				Description: gtOperatorDescription,
-				Type:        gql.DateTime,
+				Type:        compareType,
 			},
 			"_ge": &gql.InputObjectFieldConfig{
 				Description: geOperatorDescription,
-				Type:        gql.DateTime,
+				Type:        compareType,
 			},
 			"_lt": &gql.InputObjectFieldConfig{
 				Description: ltOperatorDescription,
-				Type:        gql.DateTime,
+				Type:        compareType,
 			},
 			"_le": &gql.InputObjectFieldConfig{
 				Description: leOperatorDescription,
-				Type:        gql.DateTime,
+				Type:        compareType,
 			},
 			"_in": &gql.InputObjectFieldConfig{
 				Description: inOperatorDescription,
@@ -153,34 +153,34 @@ func DateTimeOperatorBlock() *gql.InputObject {
 }
 
 // FloatOperatorBlock filter block for Float types.
-func FloatOperatorBlock() *gql.InputObject {
+func FloatOperatorBlock(compareType *gql.Scalar) *gql.InputObject {
 	return gql.NewInputObject(gql.InputObjectConfig{
 		Name:        "FloatOperatorBlock",
 		Description: floatOperatorBlockDescription,
 		Fields: gql.InputObjectConfigFieldMap{
 			"_eq": &gql.InputObjectFieldConfig{
 				Description: eqOperatorDescription,
-				Type:        gql.Float,
+				Type:        compareType,
 			},
 			"_ne": &gql.InputObjectFieldConfig{
 				Description: neOperatorDescription,
-				Type:        gql.Float,
+				Type:        compareType,
 			},
 			"_gt": &gql.InputObjectFieldConfig{
 				Description: gtOperatorDescription,
-				Type:        gql.Float,
+				Type:        compareType,
 			},
 			"_ge": &gql.InputObjectFieldConfig{
 				Description: geOperatorDescription,
-				Type:        gql.Float,
+				Type:        compareType,
 			},
 			"_lt": &gql.InputObjectFieldConfig{
 				Description: ltOperatorDescription,
-				Type:        gql.Float,
+				Type:        compareType,
 			},
 			"_le": &gql.InputObjectFieldConfig{
 				Description: leOperatorDescription,
-				Type:        gql.Float,
+				Type:        compareType,
 			},
 			"_in": &gql.InputObjectFieldConfig{
 				Description: inOperatorDescription,
@@ -217,34 +217,34 @@ func FloatListOperatorBlock(op *gql.InputObject) *gql.InputObject {
 }
 
 // NotNullFloatOperatorBlock filter block for Float! types.
-func NotNullFloatOperatorBlock() *gql.InputObject {
+func NotNullFloatOperatorBlock(compareType *gql.Scalar) *gql.InputObject {
 	return gql.NewInputObject(gql.InputObjectConfig{
 		Name:        "NotNullFloatOperatorBlock",
 		Description: notNullFloatOperatorBlockDescription,
 		Fields: gql.InputObjectConfigFieldMap{
 			"_eq": &gql.InputObjectFieldConfig{
 				Description: eqOperatorDescription,
-				Type:        gql.Float,
+				Type:        compareType,
 			},
 			"_ne": &gql.InputObjectFieldConfig{
 				Description: neOperatorDescription,
-				Type:        gql.Float,
+				Type:        compareType,
 			},
 			"_gt": &gql.InputObjectFieldConfig{
 				Description: gtOperatorDescription,
-				Type:        gql.Float,
+				Type:        compareType,
 			},
 			"_ge": &gql.InputObjectFieldConfig{
 				Description: geOperatorDescription,
-				Type:        gql.Float,
+				Type:        compareType,
 			},
 			"_lt": &gql.InputObjectFieldConfig{
 				Description: ltOperatorDescription,
-				Type:        gql.Float,
+				Type:        compareType,
 			},
 			"_le": &gql.InputObjectFieldConfig{
 				Description: leOperatorDescription,
-				Type:        gql.Float,
+				Type:        compareType,
 			},
 			"_in": &gql.InputObjectFieldConfig{
 				Description: inOperatorDescription,
@@ -281,34 +281,34 @@ func NotNullFloatListOperatorBlock(op *gql.InputObject) *gql.InputObject {
 }
 
 // IntOperatorBlock filter block for Int types.
-func IntOperatorBlock() *gql.InputObject {
+func IntOperatorBlock(compareType *gql.Scalar) *gql.InputObject {
 	return gql.NewInputObject(gql.InputObjectConfig{
 		Name:        "IntOperatorBlock",
 		Description: intOperatorBlockDescription,
 		Fields: gql.InputObjectConfigFieldMap{
 			"_eq": &gql.InputObjectFieldConfig{
 				Description: eqOperatorDescription,
-				Type:        gql.Int,
+				Type:        compareType,
 			},
 			"_ne": &gql.InputObjectFieldConfig{
 				Description: neOperatorDescription,
-				Type:        gql.Int,
+				Type:        compareType,
 			},
 			"_gt": &gql.InputObjectFieldConfig{
 				Description: gtOperatorDescription,
-				Type:        gql.Int,
+				Type:        compareType,
 			},
 			"_ge": &gql.InputObjectFieldConfig{
 				Description: geOperatorDescription,
-				Type:        gql.Int,
+				Type:        compareType,
 			},
 			"_lt": &gql.InputObjectFieldConfig{
 				Description: ltOperatorDescription,
-				Type:        gql.Int,
+				Type:        compareType,
 			},
 			"_le": &gql.InputObjectFieldConfig{
 				Description: leOperatorDescription,
-				Type:        gql.Int,
+				Type:        compareType,
 			},
 			"_in": &gql.InputObjectFieldConfig{
 				Description: inOperatorDescription,
@@ -345,34 +345,34 @@ func IntListOperatorBlock(op *gql.InputObject) *gql.InputObject {
 }
 
 // NotNullIntOperatorBlock filter block for Int! types.
-func NotNullIntOperatorBlock() *gql.InputObject {
+func NotNullIntOperatorBlock(compareType *gql.Scalar) *gql.InputObject {
 	return gql.NewInputObject(gql.InputObjectConfig{
 		Name:        "NotNullIntOperatorBlock",
 		Description: notNullIntOperatorBlockDescription,
 		Fields: gql.InputObjectConfigFieldMap{
 			"_eq": &gql.InputObjectFieldConfig{
 				Description: eqOperatorDescription,
-				Type:        gql.Int,
+				Type:        compareType,
 			},
 			"_ne": &gql.InputObjectFieldConfig{
 				Description: neOperatorDescription,
-				Type:        gql.Int,
+				Type:        compareType,
 			},
 			"_gt": &gql.InputObjectFieldConfig{
 				Description: gtOperatorDescription,
-				Type:        gql.Int,
+				Type:        compareType,
 			},
 			"_ge": &gql.InputObjectFieldConfig{
 				Description: geOperatorDescription,
-				Type:        gql.Int,
+				Type:        compareType,
 			},
 			"_lt": &gql.InputObjectFieldConfig{
 				Description: ltOperatorDescription,
-				Type:        gql.Int,
+				Type:        compareType,
 			},
 			"_le": &gql.InputObjectFieldConfig{
 				Description: leOperatorDescription,
-				Type:        gql.Int,
+				Type:        compareType,
 			},
 			"_in": &gql.InputObjectFieldConfig{
 				Description: inOperatorDescription,
@@ -409,18 +409,18 @@ func NotNullIntListOperatorBlock(op *gql.InputObject) *gql.InputObject {
 }
 
 // StringOperatorBlock filter block for string types.
-func StringOperatorBlock() *gql.InputObject {
+func StringOperatorBlock(compareType *gql.Scalar, regexInput *gql.InputObject) *gql.InputObject {
 	return gql.NewInputObject(gql.InputObjectConfig{
 		Name:        "StringOperatorBlock",
 		Description: stringOperatorBlockDescription,
 		Fields: gql.InputObjectConfigFieldMap{
 			"_eq": &gql.InputObjectFieldConfig{
 				Description: eqOperatorDescription,
-				Type:        gql.String,
+				Type:        compareType,
 			},
 			"_ne": &gql.InputObjectFieldConfig{
 				Description: neOperatorDescription,
-				Type:        gql.String,
+				Type:        compareType,
 			},
 			"_in": &gql.InputObjectFieldConfig{
 				Description: inOperatorDescription,
@@ -446,6 +446,18 @@ func StringOperatorBlock() *gql.InputObject {
 				Description: nilikeStringOperatorDescription,
 				Type:        gql.String,
 			},
+			"_regex": &gql.InputObjectFieldConfig{
+				Description: regexStringOperatorDescription,
+				Type:        regexInput,
+			},
+			"_nregex": &gql.InputObjectFieldConfig{
+				Description: nregexStringOperatorDescription,
+				Type:        regexInput,
+			},
+			"_search": &gql.InputObjectFieldConfig{
+				Description: searchStringOperatorDescription,
+				Type:        gql.String,
+			},
 		},
 	})
 }
@@ -473,18 +485,18 @@ func StringListOperatorBlock(op *gql.InputObject) *gql.InputObject {
 }
 
 // NotNullStringOperatorBlock filter block for string! types.
-func NotNullStringOperatorBlock() *gql.InputObject {
+func NotNullStringOperatorBlock(compareType *gql.Scalar, regexInput *gql.InputObject) *gql.InputObject {
 	return gql.NewInputObject(gql.InputObjectConfig{
 		Name:        "NotNullStringOperatorBlock",
 		Description: notNullStringOperatorBlockDescription,
 		Fields: gql.InputObjectConfigFieldMap{
 			"_eq": &gql.InputObjectFieldConfig{
 				Description: eqOperatorDescription,
-				Type:        gql.String,
+				Type:        compareType,
 			},
 			"_ne": &gql.InputObjectFieldConfig{
 				Description: neOperatorDescription,
-				Type:        gql.String,
+				Type:        compareType,
 			},
 			"_in": &gql.InputObjectFieldConfig{
 				Description: inOperatorDescription,
@@ -510,6 +522,18 @@ func NotNullStringOperatorBlock() *gql.InputObject {
 				Description: nilikeStringOperatorDescription,
 				Type:        gql.String,
 			},
+			"_regex": &gql.InputObjectFieldConfig{
+				Description: regexStringOperatorDescription,
+				Type:        regexInput,
+			},
+			"_nregex": &gql.InputObjectFieldConfig{
+				Description: nregexStringOperatorDescription,
+				Type:        regexInput,
+			},
+			"_search": &gql.InputObjectFieldConfig{
+				Description: searchStringOperatorDescription,
+				Type:        gql.String,
+			},
 		},
 	})
 }
@@ -619,6 +643,36 @@ func NotNullBlobOperatorBlock(blobScalarType *gql.Scalar) *gql.InputObject {
 	})
 }
 
+// GeoPointOperatorBlock filter block for GeoPoint types.
+func GeoPointOperatorBlock(
+	geoPointScalarType *gql.Scalar,
+	geoRadiusInput *gql.InputObject,
+	geoBoxInput *gql.InputObject,
+) *gql.InputObject {
+	return gql.NewInputObject(gql.InputObjectConfig{
+		Name:        "GeoPointOperatorBlock",
+		Description: geoPointOperatorBlockDescription,
+		Fields: gql.InputObjectConfigFieldMap{
+			"_eq": &gql.InputObjectFieldConfig{
+				Description: eqOperatorDescription,
+				Type:        geoPointScalarType,
+			},
+			"_ne": &gql.InputObjectFieldConfig{
+				Description: neOperatorDescription,
+				Type:        geoPointScalarType,
+			},
+			"_withinRadius": &gql.InputObjectFieldConfig{
+				Description: withinRadiusOperatorDescription,
+				Type:        geoRadiusInput,
+			},
+			"_withinBox": &gql.InputObjectFieldConfig{
+				Description: withinBoxOperatorDescription,
+				Type:        geoBoxInput,
+			},
+		},
+	})
+}
+
 // IDOperatorBlock filter block for ID types.
 func IDOperatorBlock() *gql.InputObject {
 	return gql.NewInputObject(gql.InputObjectConfig{
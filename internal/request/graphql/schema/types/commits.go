@@ -25,6 +25,7 @@ import (
 //		CollectionID: Int
 //		SchemaVersionID: String
 //		Delta: String
+//		Timestamp: DateTime
 //		Previous: [Commit]
 //	 Links: [Commit]
 //	}
@@ -68,6 +69,14 @@ func CommitObject(commitLinkObject *gql.Object) *gql.Object {
 				Description: commitDeltaFieldDescription,
 				Type:        gql.String,
 			},
+			request.TimestampFieldName: &gql.Field{
+				Description: commitTimestampFieldDescription,
+				Type:        gql.DateTime,
+			},
+			request.SignerFieldName: &gql.Field{
+				Description: commitSignerFieldDescription,
+				Type:        gql.String,
+			},
 			request.LinksFieldName: &gql.Field{
 				Description: commitLinksDescription,
 				Type:        gql.NewList(commitLinkObject),
@@ -135,12 +144,72 @@ func CommitsOrderArg(orderEnum *gql.Enum) *gql.InputObject {
 					Description: commitCollectionIDFieldDescription,
 					Type:        orderEnum,
 				},
+				request.TimestampFieldName: &gql.InputObjectFieldConfig{
+					Description: commitTimestampFieldDescription,
+					Type:        orderEnum,
+				},
 			},
 		},
 	)
 }
 
-func QueryCommits(commitObject *gql.Object, commitsOrderArg *gql.InputObject) *gql.Field {
+// CommitsFilterArg is the filter argument of the commits query.
+func CommitsFilterArg(
+	intOpBlock *gql.InputObject,
+	stringOpBlock *gql.InputObject,
+	dateTimeOpBlock *gql.InputObject,
+) *gql.InputObject {
+	var commitsFilterArg *gql.InputObject
+	commitsFilterArg = gql.NewInputObject(
+		gql.InputObjectConfig{
+			Name:        "commitsFilterArg",
+			Description: commitsFilterArgDescription,
+			Fields: (gql.InputObjectConfigFieldMapThunk)(func() (gql.InputObjectConfigFieldMap, error) {
+				return gql.InputObjectConfigFieldMap{
+					"_and": &gql.InputObjectFieldConfig{
+						Description: AndOperatorDescription,
+						Type:        gql.NewList(gql.NewNonNull(commitsFilterArg)),
+					},
+					"_or": &gql.InputObjectFieldConfig{
+						Description: OrOperatorDescription,
+						Type:        gql.NewList(gql.NewNonNull(commitsFilterArg)),
+					},
+					"_not": &gql.InputObjectFieldConfig{
+						Description: NotOperatorDescription,
+						Type:        commitsFilterArg,
+					},
+					request.HeightFieldName: &gql.InputObjectFieldConfig{
+						Description: commitHeightFieldDescription,
+						Type:        intOpBlock,
+					},
+					request.DocIDArgName: &gql.InputObjectFieldConfig{
+						Description: commitDocIDFieldDescription,
+						Type:        stringOpBlock,
+					},
+					request.CollectionIDFieldName: &gql.InputObjectFieldConfig{
+						Description: commitCollectionIDFieldDescription,
+						Type:        intOpBlock,
+					},
+					request.FieldNameFieldName: &gql.InputObjectFieldConfig{
+						Description: commitFieldNameFieldDescription,
+						Type:        stringOpBlock,
+					},
+					request.TimestampFieldName: &gql.InputObjectFieldConfig{
+						Description: commitTimestampFieldDescription,
+						Type:        dateTimeOpBlock,
+					},
+				}, nil
+			}),
+		},
+	)
+	return commitsFilterArg
+}
+
+func QueryCommits(
+	commitObject *gql.Object,
+	commitsOrderArg *gql.InputObject,
+	commitsFilterArg *gql.InputObject,
+) *gql.Field {
 	return &gql.Field{
 		Name:        "commits",
 		Description: commitsQueryDescription,
@@ -148,6 +217,7 @@ func QueryCommits(commitObject *gql.Object, commitsOrderArg *gql.InputObject) *g
 		Args: gql.FieldConfigArgument{
 			request.DocIDArgName: NewArgConfig(gql.ID, commitDocIDArgDescription),
 			request.FieldIDName:  NewArgConfig(gql.String, commitFieldIDArgDescription),
+			request.FilterClause: NewArgConfig(commitsFilterArg, commitsFilterArgDescription),
 			"order":              NewArgConfig(gql.NewList(commitsOrderArg), OrderArgDescription),
 			"cid":                NewArgConfig(gql.ID, commitCIDArgDescription),
 			"groupBy": NewArgConfig(
@@ -207,3 +277,52 @@ func QueryLatestCommits(commitObject *gql.Object) *gql.Field {
 		},
 	}
 }
+
+// ConflictObject represents the concurrent values of a multi-value register field.
+//
+//	type Conflict {
+//		fieldName: String
+//		values: [JSON]
+//		heads: [String]
+//	}
+func ConflictObject(jsonScalarType *gql.Scalar) *gql.Object {
+	return gql.NewObject(gql.ObjectConfig{
+		Name:        request.ConflictTypeName,
+		Description: conflictDescription,
+		Fields: gql.Fields{
+			request.FieldNameFieldName: &gql.Field{
+				Description: conflictFieldNameFieldDescription,
+				Type:        gql.String,
+			},
+			request.ConflictValuesFieldName: &gql.Field{
+				Description: conflictValuesFieldDescription,
+				Type:        gql.NewList(jsonScalarType),
+			},
+			request.ConflictHeadsFieldName: &gql.Field{
+				Description: conflictHeadsFieldDescription,
+				Type:        gql.NewList(gql.String),
+			},
+		},
+	})
+}
+
+func DiffObject(jsonScalarType *gql.Scalar) *gql.Object {
+	return gql.NewObject(gql.ObjectConfig{
+		Name:        request.DiffTypeName,
+		Description: diffDescription,
+		Fields: gql.Fields{
+			request.FieldNameFieldName: &gql.Field{
+				Description: diffFieldNameFieldDescription,
+				Type:        gql.String,
+			},
+			request.DiffOldValueFieldName: &gql.Field{
+				Description: diffOldValueFieldDescription,
+				Type:        jsonScalarType,
+			},
+			request.DiffNewValueFieldName: &gql.Field{
+				Description: diffNewValueFieldDescription,
+				Type:        jsonScalarType,
+			},
+		},
+	})
+}
@@ -22,6 +22,12 @@ An optional set of fields for which to group the contents of this field by.
  the '_group' selector within the immediate child selector. If an empty set
  is provided, the restrictions mentioned still apply, although all results
  will appear within the same group.
+`
+	DistinctArgDescription string = `
+An optional set of fields by which to deduplicate the results. If this argument
+ is provided, only the first result of each unique combination of the values of
+ the given fields is returned. Unlike 'groupBy', any field may be selected and the
+ results are not nested.
 `
 	LimitArgDescription string = `
 An optional value that caps the number of results to the number provided.
@@ -31,6 +37,23 @@ An optional value that caps the number of results to the number provided.
 An optional value that skips the given number of results that would have
  otherwise been returned.  Commonly used alongside the 'limit' argument,
  this argument will still work on its own.
+`
+	FirstArgDescription string = `
+An optional value that caps the number of results to the number provided, counting from
+ the start of the page. Commonly used alongside the 'after' argument.
+`
+	AfterArgDescription string = `
+An optional cursor, returned by the _cursor field of a result, after which the page starts.
+ Results are seeked to the cursor instead of being skipped one by one.
+`
+	LastArgDescription string = `
+An optional value that caps the number of results to the number provided, counting from
+ the end of the page. Commonly used alongside the 'before' argument. The page is read from
+ its start to find its last results, so without 'before' every result up to the end of the
+ collection is read.
+`
+	BeforeArgDescription string = `
+An optional cursor, returned by the _cursor field of a result, before which the page ends.
 `
 	commitDescription string = `
 Commit represents an individual commit to a MerkleCRDT, every mutation to a
@@ -84,10 +107,65 @@ The ID of the schema version that this commit was committed against. This ID all
 	commitFieldNameFieldDescription string = `
 The name of the field that this commit was committed against. If this is a composite field
  the value will be null.
+`
+	conflictDescription string = `
+Conflict represents the values concurrently written to a multi-value register field
+ that have not yet been superseded by a later write.
+`
+	conflictFieldNameFieldDescription string = `
+The name of the field holding the conflicting values.
+`
+	conflictValuesFieldDescription string = `
+The conflicting values, in the same order as their heads.
+`
+	conflictHeadsFieldDescription string = `
+The unique identifiers of the writes that produced the conflicting values. They can
+ be given to the resolve mutation to supersede the matching values.
+`
+	diffDescription string = `
+Diff represents a field of a document that changed between two versions of the document.
+`
+	diffFieldNameFieldDescription string = `
+The name of the field that changed.
+`
+	diffOldValueFieldDescription string = `
+The value of the field at the version the diff is from. It is null if the field had
+ no value.
+`
+	diffNewValueFieldDescription string = `
+The value of the field at the version the diff is to. It is null if the field has
+ no value.
+`
+	textSpliceDescription string = `
+TextSplice replaces a range of characters of a String field.
+`
+	textSpliceFieldDescription string = `
+The name of the String field to edit.
+`
+	textSpliceIndexDescription string = `
+The position, in characters, at which the edit starts.
+`
+	textSpliceDeleteDescription string = `
+The number of characters to remove from the index. Defaults to zero.
+`
+	textSpliceInsertDescription string = `
+The text to insert at the index.
 `
 	commitFieldIDFieldDescription string = `
 The id of the field that this commit was committed against. If this is a composite field
  the value will be "C".
+`
+	commitTimestampFieldDescription string = `
+The hybrid logical clock time at which this commit was created. It follows the wall
+ clock of the node that created the commit while always being later than the commits
+ it is based on. Commits created without a clock will have a null timestamp.
+`
+	commitSignerFieldDescription string = `
+The DID of the identity that signed this commit. Commits created without an identity
+ holding a private key are not signed and will have a null signer.
+`
+	commitsFilterArgDescription string = `
+An optional filter for the commits to return.
 `
 	commitDeltaFieldDescription string = `
 The CBOR encoded representation of the value that is saved as part of this commit.
@@ -134,6 +212,35 @@ Returns the maximum of the specified field values within the specified child set
 Returns the minimum of the specified field values within the specified child sets. If
  multiple fields/sets are specified, the combined minimum of all items within each set
  will be returned as a single value.
+`
+	MedianFieldDescription string = `
+Returns the median of the specified field values within the specified child sets. If
+ multiple fields/sets are specified, the combined median of all items within each set
+ will be returned as a single value.
+`
+	StdDevFieldDescription string = `
+Returns the population standard deviation of the specified field values within the
+ specified child sets. If multiple fields/sets are specified, the combined standard
+ deviation of all items within each set will be returned as a single value.
+`
+	VarianceFieldDescription string = `
+Returns the population variance of the specified field values within the specified
+ child sets. If multiple fields/sets are specified, the combined variance of all items
+ within each set will be returned as a single value.
+`
+	PercentileFieldDescription string = `
+Returns the given percentile of the specified field values within the specified child
+ sets, interpolating linearly between the closest ranks. If multiple fields/sets are
+ specified, the combined percentile of all items within each set will be returned as a
+ single value.
+`
+	PercentileArgDescription string = `
+The percentile to return, from 0 to 100.
+`
+	CountDistinctFieldDescription string = `
+Returns the number of distinct values of the specified field within the specified child
+ sets. If multiple fields/sets are specified, the combined number of distinct values of
+ all items within each set will be returned as a single value.
 `
 	booleanOperatorBlockDescription string = `
 These are the set of filter operators available for use when filtering on Boolean
@@ -222,6 +329,37 @@ The case insensitive like operator - if the target value contains the given case
 The case insensitive not-like operator - if the target value does not contain the given case insensitive sub-string
  the check will pass. '%' characters may be used as wildcards, for example '_nlike: "%ritchie"' would match on
  the string 'Quentin Tarantino'.
+`
+	regexStringOperatorDescription string = `
+The regex operator - if the target value matches the given regular expression the check will
+ pass, for example '_regex: {pattern: "^Rob(ert)?$"}' would match on the strings 'Rob' and
+ 'Robert'. The expression uses the RE2 syntax. If the field is indexed and the expression starts
+ with '^' followed by a literal prefix, only the index entries starting with that prefix are read.
+`
+	nregexStringOperatorDescription string = `
+The not-regex operator - if the target value does not match the given regular expression the
+ check will pass, for example '_nregex: {pattern: "^Rob(ert)?$"}' would match on the string
+ 'Roberta'.
+`
+	searchStringOperatorDescription string = `
+The full-text search operator - if the target value contains at least one of the words of the given
+ query the check will pass, for example '_search: "red shoes"' would match on the string 'Blue Shoes'.
+ Words are compared case insensitively. If the field has a full-text index, the index is used to fetch
+ the matching documents and the _score field holds the relevance of each document.
+`
+	geoPointOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on GeoPoint
+ values.
+`
+	withinRadiusOperatorDescription string = `
+The within radius operator - if the target point is within the given distance of the given point
+ the check will pass. If the field has a geohash index, the index is used to fetch the candidate
+ documents.
+`
+	withinBoxOperatorDescription string = `
+The within box operator - if the target point is within the given latitude and longitude bounds
+ the check will pass. If the field has a geohash index, the index is used to fetch the candidate
+ documents.
 `
 	AndOperatorDescription string = `
 The and operator - all checks within this clause must pass in order for this check to pass.
@@ -250,6 +388,63 @@ Sort the results in descending order, e.g. c,b,a,3,2,1,null.
 `
 	crdtDirectiveDescription string = `
 Allows the explicit definition of a field's CRDT type. By default it is defined as LWWRegister.
+`
+	vectorDirectiveDescription string = `
+Declares a [Float!] field as a vector field. Vectors stored in the field must have
+ exactly the given number of elements.
+`
+	vectorDirectiveDimensionArgDescription string = `
+The number of elements of the vectors stored in the field.
+`
+	regexDescription string = `
+A regular expression of the regex and not-regex operators.
+`
+	regexPatternDescription string = `
+The regular expression, in the RE2 syntax.
+`
+	regexCaseInsensitiveDescription string = `
+If true the regular expression matches case insensitively, for example '{pattern: "^rob",
+ caseInsensitive: true}' would match on the string 'Robert'. Defaults to false.
+`
+	geoRadiusDescription string = `
+The area within a distance of a geographic point.
+`
+	geoRadiusLatDescription string = `
+The latitude of the center of the area, in degrees.
+`
+	geoRadiusLonDescription string = `
+The longitude of the center of the area, in degrees.
+`
+	geoRadiusDistanceDescription string = `
+The distance from the center of the area, in meters.
+`
+	geoBoxDescription string = `
+The area between two latitudes and two longitudes. If minLon is greater than maxLon the area
+ crosses the antimeridian.
+`
+	geoBoxMinLatDescription string = `
+The southern bound of the area, in degrees.
+`
+	geoBoxMinLonDescription string = `
+The western bound of the area, in degrees.
+`
+	geoBoxMaxLatDescription string = `
+The northern bound of the area, in degrees.
+`
+	geoBoxMaxLonDescription string = `
+The eastern bound of the area, in degrees.
+`
+	similarArgDescription string = `
+Finds the documents whose vector field is nearest to the given vector.
+`
+	similarArgFieldDescription string = `
+The name of the vector field to compare.
+`
+	similarArgVectorDescription string = `
+The vector to compare the field with. It must have the dimension of the field.
+`
+	similarArgKDescription string = `
+The number of nearest documents to return.
 `
 	primaryDirectiveDescription string = `
 Indicate the primary side of a one-to-one relationship.
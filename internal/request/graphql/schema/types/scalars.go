@@ -16,6 +16,9 @@ import (
 
 	"github.com/sourcenetwork/graphql-go"
 	"github.com/sourcenetwork/graphql-go/language/ast"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
 )
 
 // BlobPattern is a regex for validating blob hex strings
@@ -121,3 +124,145 @@ func JSONScalarType() *graphql.Scalar {
 		ParseLiteral: parseJSON,
 	})
 }
+
+// coerceGeoPoint converts the given value into a valid geo point.
+// If the value cannot be converted nil is returned.
+func coerceGeoPoint(value any) any {
+	switch value := value.(type) {
+	case client.GeoPoint:
+		return value
+
+	case *client.GeoPoint:
+		if value == nil {
+			return nil
+		}
+		return *value
+
+	case map[string]any:
+		if len(value) != 2 {
+			return nil
+		}
+		lat, ok := coerceCoordinate(value[client.GeoPointLatFieldName])
+		if !ok {
+			return nil
+		}
+		lon, ok := coerceCoordinate(value[client.GeoPointLonFieldName])
+		if !ok {
+			return nil
+		}
+		point, err := client.NewGeoPoint(lat, lon)
+		if err != nil {
+			return nil
+		}
+		return point
+
+	default:
+		return nil
+	}
+}
+
+func coerceCoordinate(value any) (float64, bool) {
+	switch value := value.(type) {
+	case float64:
+		return value, true
+	case int32:
+		return float64(value), true
+	case int64:
+		return float64(value), true
+	case int:
+		return float64(value), true
+	default:
+		return 0, false
+	}
+}
+
+func GeoPointScalarType() *graphql.Scalar {
+	return graphql.NewScalar(graphql.ScalarConfig{
+		Name:        "GeoPoint",
+		Description: "The `GeoPoint` scalar type represents a geographic location given by its `lat` and `lon` in degrees.",
+		// Serialize converts the value to an object of its coordinates
+		Serialize: func(value any) any {
+			point, ok := coerceGeoPoint(value).(client.GeoPoint)
+			if !ok {
+				return nil
+			}
+			return point.ToMap()
+		},
+		// ParseValue converts the value to a geo point
+		ParseValue: coerceGeoPoint,
+		// ParseLiteral converts the ast value to a geo point
+		ParseLiteral: func(valueAST ast.Value, variables map[string]any) any {
+			switch valueAST := valueAST.(type) {
+			case *ast.ObjectValue:
+				return coerceGeoPoint(parseJSON(valueAST, variables))
+			case *ast.Variable:
+				return coerceGeoPoint(variables[valueAST.Name.Value])
+			default:
+				// return nil if the value cannot be parsed
+				return nil
+			}
+		},
+	})
+}
+
+// fieldReferenceScalarBases are the scalars that can be compared with the value of another field.
+var fieldReferenceScalarBases = []*graphql.Scalar{
+	graphql.Int,
+	graphql.Float,
+	graphql.String,
+	graphql.DateTime,
+}
+
+func genFieldReferenceScalarName(base *graphql.Scalar) string {
+	return base.Name() + "OrFieldReference"
+}
+
+// FieldReferenceScalarType returns the type of the comparison operators of the given scalar.
+//
+// It accepts the values of the given scalar, or a reference to another field of the document
+// or of its parent document, for example `{_field: "publishedAt"}`.
+func FieldReferenceScalarType(base *graphql.Scalar) *graphql.Scalar {
+	return graphql.NewScalar(graphql.ScalarConfig{
+		Name: genFieldReferenceScalarName(base),
+		Description: "The `" + genFieldReferenceScalarName(base) + "` scalar type represents a `" +
+			base.Name() + "` value, or the field to compare with: `{_field: \"name\"}` for a field of " +
+			"the same document, or `{_parentField: \"name\"}` for a field of the parent document " +
+			"when filtering a related collection.",
+		Serialize: base.Serialize,
+		// ParseValue converts the value to a field reference, or to a base scalar value
+		ParseValue: func(value any) any {
+			if ref, ok := request.ParseFieldReference(value); ok {
+				return ref
+			}
+			return base.ParseValue(value)
+		},
+		// ParseLiteral converts the ast value to a field reference, or to a base scalar value
+		ParseLiteral: func(valueAST ast.Value, variables map[string]any) any {
+			if _, isObject := valueAST.(*ast.ObjectValue); !isObject {
+				return base.ParseLiteral(valueAST, variables)
+			}
+			if ref, ok := request.ParseFieldReference(parseJSON(valueAST, variables)); ok {
+				return ref
+			}
+			// return nil if the value cannot be parsed
+			return nil
+		},
+	})
+}
+
+// FieldReferenceScalarBase returns the scalar whose values are accepted by the given
+// field reference scalar type.
+//
+// False is returned if the given type is not a field reference scalar type.
+func FieldReferenceScalarBase(ttype graphql.Type) (*graphql.Scalar, bool) {
+	scalar, ok := ttype.(*graphql.Scalar)
+	if !ok {
+		return nil, false
+	}
+	for _, base := range fieldReferenceScalarBases {
+		if scalar.Name() == genFieldReferenceScalarName(base) {
+			return base, true
+		}
+	}
+	return nil, false
+}
@@ -14,6 +14,9 @@ import (
 	gql "github.com/sourcenetwork/graphql-go"
 
 	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/internal/connor"
+	"github.com/sourcenetwork/defradb/internal/geo"
 )
 
 const (
@@ -38,6 +41,8 @@ const (
 	IndexDirectivePropUnique    = "unique"
 	IndexDirectivePropDirection = "direction"
 	IndexDirectivePropIncludes  = "includes"
+	IndexDirectivePropType      = "type"
+	IndexDirectivePropFilter    = "filter"
 
 	IncludesPropField     = "field"
 	IncludesPropDirection = "direction"
@@ -54,6 +59,16 @@ const (
 	MaterializedDirectiveLabel  = "materialized"
 	MaterializedDirectivePropIf = "if"
 
+	VectorDirectiveLabel         = "vector"
+	VectorDirectivePropDimension = "dimension"
+
+	SimilarArgTypeName = "SimilarArg"
+
+	GeoRadiusTypeName = "GeoRadius"
+	GeoBoxTypeName    = "GeoBox"
+
+	RegexTypeName = "Regex"
+
 	FieldOrderASC  = "ASC"
 	FieldOrderDESC = "DESC"
 )
@@ -185,7 +200,163 @@ func IndexFieldInputObject(orderingEnum *gql.Enum) *gql.InputObject {
 	})
 }
 
-func IndexDirective(orderingEnum *gql.Enum, indexFieldInputObject *gql.InputObject) *gql.Directive {
+// TextSpliceInputObject returns the input object used to edit a range of characters of a
+// String field.
+//
+//	input TextSplice {
+//		field: String!
+//		index: Int!
+//		delete: Int
+//		insert: String
+//	}
+func TextSpliceInputObject() *gql.InputObject {
+	return gql.NewInputObject(gql.InputObjectConfig{
+		Name:        request.TextSpliceTypeName,
+		Description: textSpliceDescription,
+		Fields: gql.InputObjectConfigFieldMap{
+			request.FieldName: &gql.InputObjectFieldConfig{
+				Description: textSpliceFieldDescription,
+				Type:        gql.NewNonNull(gql.String),
+			},
+			request.TextSpliceIndexFieldName: &gql.InputObjectFieldConfig{
+				Description: textSpliceIndexDescription,
+				Type:        gql.NewNonNull(gql.Int),
+			},
+			request.TextSpliceDeleteFieldName: &gql.InputObjectFieldConfig{
+				Description: textSpliceDeleteDescription,
+				Type:        gql.Int,
+			},
+			request.TextSpliceInsertFieldName: &gql.InputObjectFieldConfig{
+				Description: textSpliceInsertDescription,
+				Type:        gql.String,
+			},
+		},
+	})
+}
+
+// RegexInputObject returns the input object describing the regular expression of the
+// `_regex` and `_nregex` filter operators.
+//
+//	input Regex {
+//		pattern: String!
+//		caseInsensitive: Boolean
+//	}
+func RegexInputObject() *gql.InputObject {
+	return gql.NewInputObject(gql.InputObjectConfig{
+		Name:        RegexTypeName,
+		Description: regexDescription,
+		Fields: gql.InputObjectConfigFieldMap{
+			connor.RegexPatternFieldName: &gql.InputObjectFieldConfig{
+				Description: regexPatternDescription,
+				Type:        gql.NewNonNull(gql.String),
+			},
+			connor.RegexCaseInsensitiveFieldName: &gql.InputObjectFieldConfig{
+				Description: regexCaseInsensitiveDescription,
+				Type:        gql.Boolean,
+			},
+		},
+	})
+}
+
+// GeoRadiusInputObject returns the input object describing the area of the `_withinRadius`
+// filter operator.
+//
+//	input GeoRadius {
+//		lat: Float!
+//		lon: Float!
+//		distance: Float!
+//	}
+func GeoRadiusInputObject() *gql.InputObject {
+	return gql.NewInputObject(gql.InputObjectConfig{
+		Name:        GeoRadiusTypeName,
+		Description: geoRadiusDescription,
+		Fields: gql.InputObjectConfigFieldMap{
+			client.GeoPointLatFieldName: &gql.InputObjectFieldConfig{
+				Description: geoRadiusLatDescription,
+				Type:        gql.NewNonNull(gql.Float),
+			},
+			client.GeoPointLonFieldName: &gql.InputObjectFieldConfig{
+				Description: geoRadiusLonDescription,
+				Type:        gql.NewNonNull(gql.Float),
+			},
+			geo.DistanceFieldName: &gql.InputObjectFieldConfig{
+				Description: geoRadiusDistanceDescription,
+				Type:        gql.NewNonNull(gql.Float),
+			},
+		},
+	})
+}
+
+// GeoBoxInputObject returns the input object describing the area of the `_withinBox`
+// filter operator.
+//
+//	input GeoBox {
+//		minLat: Float!
+//		minLon: Float!
+//		maxLat: Float!
+//		maxLon: Float!
+//	}
+func GeoBoxInputObject() *gql.InputObject {
+	return gql.NewInputObject(gql.InputObjectConfig{
+		Name:        GeoBoxTypeName,
+		Description: geoBoxDescription,
+		Fields: gql.InputObjectConfigFieldMap{
+			geo.MinLatFieldName: &gql.InputObjectFieldConfig{
+				Description: geoBoxMinLatDescription,
+				Type:        gql.NewNonNull(gql.Float),
+			},
+			geo.MinLonFieldName: &gql.InputObjectFieldConfig{
+				Description: geoBoxMinLonDescription,
+				Type:        gql.NewNonNull(gql.Float),
+			},
+			geo.MaxLatFieldName: &gql.InputObjectFieldConfig{
+				Description: geoBoxMaxLatDescription,
+				Type:        gql.NewNonNull(gql.Float),
+			},
+			geo.MaxLonFieldName: &gql.InputObjectFieldConfig{
+				Description: geoBoxMaxLonDescription,
+				Type:        gql.NewNonNull(gql.Float),
+			},
+		},
+	})
+}
+
+func IndexTypeEnum() *gql.Enum {
+	return gql.NewEnum(gql.EnumConfig{
+		Name:        "IndexType",
+		Description: "One of the possible index types.",
+		Values: gql.EnumValueConfigMap{
+			string(client.IndexTypeFullText): &gql.EnumValueConfig{
+				Value: client.IndexTypeFullText,
+				Description: `Full-text index.
+
+	Can only be created on a single String field. The words of the field are indexed
+	so that documents can be searched and ranked with the _search filter operator.`,
+			},
+			string(client.IndexTypeVector): &gql.EnumValueConfig{
+				Value: client.IndexTypeVector,
+				Description: `Vector index.
+
+	Can only be created on a single vector field. The vectors of the field are indexed
+	so that the documents nearest to a given vector can be found by _similar queries.`,
+			},
+			string(client.IndexTypeGeoHash): &gql.EnumValueConfig{
+				Value: client.IndexTypeGeoHash,
+				Description: `Geohash index.
+
+	Can only be created on a single GeoPoint field. The points of the field are indexed
+	by geohash so that the _withinRadius and _withinBox filter operators do not scan
+	the whole collection.`,
+			},
+		},
+	})
+}
+
+func IndexDirective(
+	orderingEnum *gql.Enum,
+	indexTypeEnum *gql.Enum,
+	indexFieldInputObject *gql.InputObject,
+) *gql.Directive {
 	return gql.NewDirective(gql.DirectiveConfig{
 		Name:        IndexDirectiveLabel,
 		Description: "@index is a directive that can be used to create an index on a type or a field.",
@@ -212,6 +383,18 @@ func IndexDirective(orderingEnum *gql.Enum, indexFieldInputObject *gql.InputObje
 	it will be implicitly added as the first entry.`,
 				Type: gql.NewList(indexFieldInputObject),
 			},
+			IndexDirectivePropType: &gql.ArgumentConfig{
+				Description: `Sets the type of the index.
+
+	If omitted the index stores the values of the indexed fields.`,
+				Type: indexTypeEnum,
+			},
+			IndexDirectivePropFilter: &gql.ArgumentConfig{
+				Description: `Restricts the index to the documents matching the filter.
+
+	Queries only use such an index if their filter implies the filter of the index.`,
+				Type: JSONScalarType(),
+			},
 		},
 		Locations: []string{
 			gql.DirectiveLocationObject,
@@ -262,6 +445,38 @@ func CRDTEnum() *gql.Enum {
 	will cause the value to roll over to the int64 min value. Incremeting a float and
 	causing it to overflow the float64 max value will act like a no-op.`,
 			},
+			client.OR_SET.String(): &gql.EnumValueConfig{
+				Value: client.OR_SET,
+				Description: `Add-wins Observed-Remove Set.
+	
+	Can only be assigned to array fields. Concurrent element additions and removals
+	are merged per element, an element that is concurrently added and removed will
+	remain in the set. The order of the elements is not preserved.`,
+			},
+			client.MV_REGISTER.String(): &gql.EnumValueConfig{
+				Value: client.MV_REGISTER,
+				Description: `Multi-Value Register.
+	
+	Keeps every concurrently written value. The field resolves to the value that
+	a Last-Writer-Wins Register would pick, whilst all the concurrent values can be
+	queried through the _conflicts field and resolved with the resolve mutation.`,
+			},
+			client.RGA_TEXT.String(): &gql.EnumValueConfig{
+				Value: client.RGA_TEXT,
+				Description: `Replicated Growable Array text sequence.
+	
+	Can only be assigned to String fields. Edits are recorded as character insertions
+	and deletions so that concurrent edits are interleaved instead of overwriting each
+	other. The text can be edited with the splice argument of the update mutation.`,
+			},
+			client.LWW_MAP.String(): &gql.EnumValueConfig{
+				Value: client.LWW_MAP,
+				Description: `Last Write Wins map.
+	
+	Can only be assigned to JSON fields. Every key path of the JSON value is merged
+	as its own Last Write Wins register and nested objects are merged recursively,
+	so that concurrent edits to different keys are all kept.`,
+			},
 		},
 	})
 }
@@ -282,6 +497,46 @@ func CRDTFieldDirective(crdtEnum *gql.Enum) *gql.Directive {
 	})
 }
 
+// VectorDirective @vector is used to declare a `[Float!]` field as a vector field of
+// a fixed dimension.
+func VectorDirective() *gql.Directive {
+	return gql.NewDirective(gql.DirectiveConfig{
+		Name:        VectorDirectiveLabel,
+		Description: vectorDirectiveDescription,
+		Args: gql.FieldConfigArgument{
+			VectorDirectivePropDimension: &gql.ArgumentConfig{
+				Description: vectorDirectiveDimensionArgDescription,
+				Type:        gql.NewNonNull(gql.Int),
+			},
+		},
+		Locations: []string{
+			gql.DirectiveLocationFieldDefinition,
+		},
+	})
+}
+
+// SimilarArgInputObject is the input of the `_similar` argument of queries.
+func SimilarArgInputObject() *gql.InputObject {
+	return gql.NewInputObject(gql.InputObjectConfig{
+		Name:        SimilarArgTypeName,
+		Description: similarArgDescription,
+		Fields: gql.InputObjectConfigFieldMap{
+			request.FieldName: &gql.InputObjectFieldConfig{
+				Description: similarArgFieldDescription,
+				Type:        gql.NewNonNull(gql.String),
+			},
+			request.SimilarVectorFieldName: &gql.InputObjectFieldConfig{
+				Description: similarArgVectorDescription,
+				Type:        gql.NewNonNull(gql.NewList(gql.NewNonNull(gql.Float))),
+			},
+			request.SimilarKFieldName: &gql.InputObjectFieldConfig{
+				Description: similarArgKDescription,
+				Type:        gql.NewNonNull(gql.Int),
+			},
+		},
+	})
+}
+
 // PrimaryDirective @primary is used to indicate the primary
 // side of a one-to-one relationship.
 func PrimaryDirective() *gql.Directive {
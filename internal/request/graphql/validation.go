@@ -0,0 +1,173 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package graphql
+
+import (
+	"fmt"
+	"reflect"
+
+	gql "github.com/sourcenetwork/graphql-go"
+	"github.com/sourcenetwork/graphql-go/gqlerrors"
+	"github.com/sourcenetwork/graphql-go/language/ast"
+	"github.com/sourcenetwork/graphql-go/language/kinds"
+	"github.com/sourcenetwork/graphql-go/language/visitor"
+
+	"github.com/sourcenetwork/defradb/internal/request/graphql/schema/types"
+)
+
+// validationRules are the rules the requests are validated with.
+//
+// They are the rules of the GraphQL specification, with the variables in allowed position rule
+// replaced by [variablesInAllowedPositionRule].
+var validationRules = func() []gql.ValidationRuleFn {
+	specifiedRule := reflect.ValueOf(gql.VariablesInAllowedPositionRule).Pointer()
+
+	rules := make([]gql.ValidationRuleFn, len(gql.SpecifiedRules))
+	for i, rule := range gql.SpecifiedRules {
+		if reflect.ValueOf(rule).Pointer() == specifiedRule {
+			rules[i] = variablesInAllowedPositionRule
+		} else {
+			rules[i] = rule
+		}
+	}
+	return rules
+}()
+
+// variablesInAllowedPositionRule checks that the variables conform to the type of the
+// position they are used in.
+//
+// Unlike [gql.VariablesInAllowedPositionRule], it allows the variables of a scalar type to be
+// used in place of the field reference scalar type of the filter comparison operators, as
+// the values of the scalar are also values of the field reference scalar.
+func variablesInAllowedPositionRule(context *gql.ValidationContext) *gql.ValidationRuleInstance {
+	varDefMap := map[string]*ast.VariableDefinition{}
+
+	visitorOpts := &visitor.VisitorOptions{
+		KindFuncMap: map[string]visitor.NamedVisitFuncs{
+			kinds.OperationDefinition: {
+				Enter: func(p visitor.VisitFuncParams) (string, any) {
+					varDefMap = map[string]*ast.VariableDefinition{}
+					return visitor.ActionNoChange, nil
+				},
+				Leave: func(p visitor.VisitFuncParams) (string, any) {
+					operation, ok := p.Node.(*ast.OperationDefinition)
+					if !ok {
+						return visitor.ActionNoChange, nil
+					}
+					for _, usage := range context.RecursiveVariableUsages(operation) {
+						if usage == nil || usage.Node == nil || usage.Node.Name == nil || usage.Type == nil {
+							continue
+						}
+						varName := usage.Node.Name.Value
+						varDef := varDefMap[varName]
+						if varDef == nil {
+							continue
+						}
+						varType := typeFromAST(context.Schema(), varDef.Type)
+						if varType == nil {
+							continue
+						}
+						if _, isNonNull := varType.(*gql.NonNull); !isNonNull && varDef.DefaultValue != nil {
+							// If a variable definition has a default value, it's effectively non-null.
+							varType = gql.NewNonNull(varType)
+						}
+						if !isVariableTypeAllowed(varType, usage.Type) {
+							context.ReportError(gqlerrors.NewError(
+								fmt.Sprintf(
+									`Variable "$%v" of type "%v" used in position expecting type "%v".`,
+									varName,
+									varType,
+									usage.Type,
+								),
+								[]ast.Node{varDef, usage.Node},
+								"",
+								nil,
+								[]int{},
+								nil,
+							))
+						}
+					}
+					return visitor.ActionNoChange, nil
+				},
+			},
+			kinds.VariableDefinition: {
+				Kind: func(p visitor.VisitFuncParams) (string, any) {
+					varDef, ok := p.Node.(*ast.VariableDefinition)
+					if ok && varDef.Variable != nil && varDef.Variable.Name != nil {
+						varDefMap[varDef.Variable.Name.Value] = varDef
+					}
+					return visitor.ActionNoChange, nil
+				},
+			},
+		},
+	}
+	return &gql.ValidationRuleInstance{
+		VisitorOpts: visitorOpts,
+	}
+}
+
+// typeFromAST returns the schema type of the given type AST, or nil if it is not found.
+func typeFromAST(schema *gql.Schema, typeAST ast.Type) gql.Type {
+	switch typeAST := typeAST.(type) {
+	case *ast.List:
+		innerType := typeFromAST(schema, typeAST.Type)
+		if innerType == nil {
+			return nil
+		}
+		return gql.NewList(innerType)
+
+	case *ast.NonNull:
+		innerType := typeFromAST(schema, typeAST.Type)
+		if innerType == nil {
+			return nil
+		}
+		return gql.NewNonNull(innerType)
+
+	case *ast.Named:
+		if typeAST.Name == nil {
+			return nil
+		}
+		return schema.Type(typeAST.Name.Value)
+
+	default:
+		return nil
+	}
+}
+
+// isVariableTypeAllowed returns true if a variable of the given type can be used in a
+// position of the given input type.
+func isVariableTypeAllowed(varType gql.Type, inputType gql.Type) bool {
+	if varType == inputType {
+		return true
+	}
+
+	if inputType, ok := inputType.(*gql.NonNull); ok {
+		if varType, ok := varType.(*gql.NonNull); ok {
+			return isVariableTypeAllowed(varType.OfType, inputType.OfType)
+		}
+		return false
+	}
+	if varType, ok := varType.(*gql.NonNull); ok {
+		return isVariableTypeAllowed(varType.OfType, inputType)
+	}
+
+	if inputType, ok := inputType.(*gql.List); ok {
+		if varType, ok := varType.(*gql.List); ok {
+			return isVariableTypeAllowed(varType.OfType, inputType.OfType)
+		}
+		return false
+	}
+
+	if base, ok := types.FieldReferenceScalarBase(inputType); ok {
+		return varType == base
+	}
+	return false
+}
@@ -0,0 +1,37 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package vector
+
+import (
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+const (
+	errInvalidNode  string = "invalid vector index node"
+	errNodeNotFound string = "vector index node not found"
+)
+
+var (
+	ErrInvalidNode  = errors.New(errInvalidNode)
+	ErrNodeNotFound = errors.New(errNodeNotFound)
+)
+
+// NewErrInvalidNode returns a new error indicating that a vector index node could not
+// be decoded.
+func NewErrInvalidNode(inner error) error {
+	return errors.Wrap(errInvalidNode, inner)
+}
+
+// NewErrNodeNotFound returns a new error indicating that the node with the given ID
+// is not part of the vector index.
+func NewErrNodeNotFound(id string) error {
+	return errors.New(errNodeNotFound, errors.NewKV("ID", id))
+}
@@ -0,0 +1,440 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package vector
+
+import (
+	"cmp"
+	"container/heap"
+	"context"
+	"hash/fnv"
+	"math"
+	"slices"
+	"strings"
+)
+
+const (
+	// maxNeighbors is the number of neighbours a node is linked to on the upper layers.
+	maxNeighbors = 16
+	// maxNeighborsBottom is the number of neighbours a node is linked to on the bottom layer.
+	maxNeighborsBottom = 2 * maxNeighbors
+	// maxLevel is the highest layer a node can belong to.
+	maxLevel = 16
+	// efConstruction is the number of candidates considered when linking a new node.
+	efConstruction = 64
+
+	// DefaultEfSearch is the minimum number of candidates considered by a search.
+	DefaultEfSearch = 64
+)
+
+// levelMultiplier normalizes the distribution of the node levels so that every layer holds
+// about maxNeighbors times fewer nodes than the one below.
+var levelMultiplier = 1 / math.Log(maxNeighbors)
+
+// Graph is the storage of the nodes of an index.
+type Graph interface {
+	// EntryPoint returns the ID of the node searches start from, or an empty string if the
+	// graph is empty.
+	EntryPoint(ctx context.Context) (string, error)
+	// SetEntryPoint sets the ID of the node searches start from. An empty ID marks the graph
+	// as empty.
+	SetEntryPoint(ctx context.Context, id string) error
+	// GetNode returns the node with the given ID, and false if it does not exist.
+	GetNode(ctx context.Context, id string) (Node, bool, error)
+	// PutNode stores the node with the given ID.
+	PutNode(ctx context.Context, id string, node Node) error
+	// DeleteNode deletes the node with the given ID.
+	DeleteNode(ctx context.Context, id string) error
+}
+
+// Result is a node found by a search.
+type Result struct {
+	// ID is the ID of the node.
+	ID string
+	// Distance is the distance between the vector of the node and the searched vector.
+	Distance float64
+}
+
+// Index is an approximate nearest neighbour index of vectors, stored in a [Graph].
+type Index struct {
+	graph Graph
+	nodes map[string]Node
+}
+
+// NewIndex returns a new index over the given graph.
+//
+// Nodes read from the graph are cached by the returned index, so it should not be used
+// beyond the transaction the graph belongs to.
+func NewIndex(graph Graph) *Index {
+	return &Index{
+		graph: graph,
+		nodes: make(map[string]Node),
+	}
+}
+
+// Insert adds the vector with the given ID to the index.
+func (i *Index) Insert(ctx context.Context, id string, vector []float64) error {
+	level := nodeLevel(id)
+	node := Node{
+		Vector:    vector,
+		Neighbors: make([][]string, level+1),
+	}
+
+	entryID, err := i.graph.EntryPoint(ctx)
+	if err != nil {
+		return err
+	}
+	if entryID == "" {
+		err = i.putNode(ctx, id, node)
+		if err != nil {
+			return err
+		}
+		return i.graph.SetEntryPoint(ctx, id)
+	}
+	entry, err := i.getNode(ctx, entryID)
+	if err != nil {
+		return err
+	}
+
+	entryPoints := []Result{{ID: entryID, Distance: Distance(vector, entry.Vector)}}
+	for l := entry.Level(); l > level; l-- {
+		entryPoints, err = i.searchLayer(ctx, vector, entryPoints, 1, l)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the new node must be stored before being linked to, so that its vector can be
+	// compared with the other neighbours of the nodes it is linked to.
+	err = i.putNode(ctx, id, node)
+	if err != nil {
+		return err
+	}
+	for l := min(level, entry.Level()); l >= 0; l-- {
+		candidates, err := i.searchLayer(ctx, vector, entryPoints, efConstruction, l)
+		if err != nil {
+			return err
+		}
+		neighbors := candidates[:min(len(candidates), maxNeighborsAt(l))]
+		node.Neighbors[l] = resultIDs(neighbors)
+		for _, neighbor := range neighbors {
+			err = i.link(ctx, neighbor.ID, id, l)
+			if err != nil {
+				return err
+			}
+		}
+		entryPoints = candidates
+	}
+	err = i.putNode(ctx, id, node)
+	if err != nil {
+		return err
+	}
+
+	if level > entry.Level() {
+		return i.graph.SetEntryPoint(ctx, id)
+	}
+	return nil
+}
+
+// Delete removes the vector with the given ID from the index.
+//
+// The neighbours of the removed node are linked to each other to keep the graph connected.
+func (i *Index) Delete(ctx context.Context, id string) error {
+	node, err := i.getNode(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	for l, neighbors := range node.Neighbors {
+		for _, neighborID := range neighbors {
+			neighbor, found, err := i.tryGetNode(ctx, neighborID)
+			if err != nil {
+				return err
+			}
+			if !found || neighbor.Level() < l {
+				continue
+			}
+			candidates := make([]string, 0, len(neighbor.Neighbors[l])+len(neighbors))
+			for _, candidate := range append(neighbor.Neighbors[l], neighbors...) {
+				if candidate != id && candidate != neighborID && !slices.Contains(candidates, candidate) {
+					candidates = append(candidates, candidate)
+				}
+			}
+			neighbor.Neighbors[l], err = i.nearest(ctx, neighbor.Vector, candidates, maxNeighborsAt(l))
+			if err != nil {
+				return err
+			}
+			err = i.putNode(ctx, neighborID, neighbor)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = i.graph.DeleteNode(ctx, id)
+	if err != nil {
+		return err
+	}
+	delete(i.nodes, id)
+
+	entryID, err := i.graph.EntryPoint(ctx)
+	if err != nil {
+		return err
+	}
+	if entryID != id {
+		return nil
+	}
+	// the highest neighbour of the removed entry point becomes the new one.
+	newEntryID := ""
+	newEntryLevel := -1
+	for l := len(node.Neighbors) - 1; l >= 0 && newEntryID == ""; l-- {
+		for _, neighborID := range node.Neighbors[l] {
+			neighbor, found, err := i.tryGetNode(ctx, neighborID)
+			if err != nil {
+				return err
+			}
+			if found && neighbor.Level() > newEntryLevel {
+				newEntryID = neighborID
+				newEntryLevel = neighbor.Level()
+			}
+		}
+	}
+	return i.graph.SetEntryPoint(ctx, newEntryID)
+}
+
+// Search returns the (approximately) k nearest vectors to the given one, ordered by
+// distance.
+//
+// At least [DefaultEfSearch] candidates are considered, which increases the accuracy of
+// searches for small values of k.
+func (i *Index) Search(ctx context.Context, vector []float64, k int) ([]Result, error) {
+	if k <= 0 {
+		return nil, nil
+	}
+	entryID, err := i.graph.EntryPoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if entryID == "" {
+		return nil, nil
+	}
+	entry, err := i.getNode(ctx, entryID)
+	if err != nil {
+		return nil, err
+	}
+
+	entryPoints := []Result{{ID: entryID, Distance: Distance(vector, entry.Vector)}}
+	for l := entry.Level(); l > 0; l-- {
+		entryPoints, err = i.searchLayer(ctx, vector, entryPoints, 1, l)
+		if err != nil {
+			return nil, err
+		}
+	}
+	results, err := i.searchLayer(ctx, vector, entryPoints, max(k, DefaultEfSearch), 0)
+	if err != nil {
+		return nil, err
+	}
+	return results[:min(k, len(results))], nil
+}
+
+// searchLayer returns the ef nearest nodes to the given vector found on the given layer by
+// a best-first traversal from the given entry points, ordered by distance.
+func (i *Index) searchLayer(
+	ctx context.Context,
+	vector []float64,
+	entryPoints []Result,
+	ef int,
+	layer int,
+) ([]Result, error) {
+	visited := make(map[string]struct{}, len(entryPoints))
+	candidates := &resultHeap{}
+	found := &resultHeap{farthestFirst: true}
+	for _, entryPoint := range entryPoints {
+		visited[entryPoint.ID] = struct{}{}
+		heap.Push(candidates, entryPoint)
+		heap.Push(found, entryPoint)
+		if found.Len() > ef {
+			heap.Pop(found)
+		}
+	}
+
+	for candidates.Len() > 0 {
+		candidate := heap.Pop(candidates).(Result)
+		if found.Len() >= ef && candidate.Distance > found.peek().Distance {
+			break
+		}
+		node, ok, err := i.tryGetNode(ctx, candidate.ID)
+		if err != nil {
+			return nil, err
+		}
+		if !ok || node.Level() < layer {
+			continue
+		}
+		for _, neighborID := range node.Neighbors[layer] {
+			if _, ok := visited[neighborID]; ok {
+				continue
+			}
+			visited[neighborID] = struct{}{}
+			neighbor, ok, err := i.tryGetNode(ctx, neighborID)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			result := Result{ID: neighborID, Distance: Distance(vector, neighbor.Vector)}
+			if found.Len() < ef || result.Distance < found.peek().Distance {
+				heap.Push(candidates, result)
+				heap.Push(found, result)
+				if found.Len() > ef {
+					heap.Pop(found)
+				}
+			}
+		}
+	}
+
+	results := found.results
+	slices.SortFunc(results, compareResults)
+	return results, nil
+}
+
+// link adds a link from the node with the given ID to the target node on the given layer,
+// keeping only the nearest neighbours if the node has too many.
+func (i *Index) link(ctx context.Context, id string, targetID string, layer int) error {
+	node, err := i.getNode(ctx, id)
+	if err != nil {
+		return err
+	}
+	neighbors := append(node.Neighbors[layer], targetID)
+	if len(neighbors) > maxNeighborsAt(layer) {
+		neighbors, err = i.nearest(ctx, node.Vector, neighbors, maxNeighborsAt(layer))
+		if err != nil {
+			return err
+		}
+	}
+	node.Neighbors[layer] = neighbors
+	return i.putNode(ctx, id, node)
+}
+
+// nearest returns the IDs of the (at most) n nodes nearest to the given vector amongst
+// the given ones.
+func (i *Index) nearest(ctx context.Context, vector []float64, ids []string, n int) ([]string, error) {
+	results := make([]Result, 0, len(ids))
+	for _, id := range ids {
+		node, ok, err := i.tryGetNode(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			results = append(results, Result{ID: id, Distance: Distance(vector, node.Vector)})
+		}
+	}
+	slices.SortFunc(results, compareResults)
+	return resultIDs(results[:min(n, len(results))]), nil
+}
+
+func (i *Index) getNode(ctx context.Context, id string) (Node, error) {
+	node, ok, err := i.tryGetNode(ctx, id)
+	if err != nil {
+		return Node{}, err
+	}
+	if !ok {
+		return Node{}, NewErrNodeNotFound(id)
+	}
+	return node, nil
+}
+
+// tryGetNode returns the node with the given ID, and false if it does not exist.
+//
+// Links to removed nodes may remain in the graph, so they are skipped by the callers.
+func (i *Index) tryGetNode(ctx context.Context, id string) (Node, bool, error) {
+	if node, ok := i.nodes[id]; ok {
+		return node, true, nil
+	}
+	node, ok, err := i.graph.GetNode(ctx, id)
+	if err != nil || !ok {
+		return Node{}, false, err
+	}
+	i.nodes[id] = node
+	return node, true, nil
+}
+
+func (i *Index) putNode(ctx context.Context, id string, node Node) error {
+	err := i.graph.PutNode(ctx, id, node)
+	if err != nil {
+		return err
+	}
+	i.nodes[id] = node
+	return nil
+}
+
+// nodeLevel returns the top layer of the node with the given ID.
+//
+// The level is derived from the ID so that all the replicas of a document are indexed
+// on the same layers.
+func nodeLevel(id string) int {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(id))
+	// the 53 most significant bits give a uniformly distributed float in (0, 1]
+	u := float64(h.Sum64()>>11+1) / (1 << 53)
+	return min(int(-math.Log(u)*levelMultiplier), maxLevel)
+}
+
+func maxNeighborsAt(layer int) int {
+	if layer == 0 {
+		return maxNeighborsBottom
+	}
+	return maxNeighbors
+}
+
+func resultIDs(results []Result) []string {
+	ids := make([]string, len(results))
+	for i, result := range results {
+		ids[i] = result.ID
+	}
+	return ids
+}
+
+// compareResults orders results by distance, and then by ID so that the order is stable.
+func compareResults(a, b Result) int {
+	if c := cmp.Compare(a.Distance, b.Distance); c != 0 {
+		return c
+	}
+	return strings.Compare(a.ID, b.ID)
+}
+
+// resultHeap is a heap of results, with the nearest one on top unless farthestFirst is set.
+type resultHeap struct {
+	results       []Result
+	farthestFirst bool
+}
+
+var _ heap.Interface = (*resultHeap)(nil)
+
+func (h *resultHeap) Len() int { return len(h.results) }
+
+func (h *resultHeap) Less(i, j int) bool {
+	if h.farthestFirst {
+		return compareResults(h.results[i], h.results[j]) > 0
+	}
+	return compareResults(h.results[i], h.results[j]) < 0
+}
+
+func (h *resultHeap) Swap(i, j int) { h.results[i], h.results[j] = h.results[j], h.results[i] }
+
+func (h *resultHeap) Push(x any) { h.results = append(h.results, x.(Result)) }
+
+func (h *resultHeap) Pop() any {
+	last := h.results[len(h.results)-1]
+	h.results = h.results[:len(h.results)-1]
+	return last
+}
+
+func (h *resultHeap) peek() Result { return h.results[0] }
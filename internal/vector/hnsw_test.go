@@ -0,0 +1,210 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package vector
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memGraph struct {
+	entryPoint string
+	nodes      map[string][]byte
+}
+
+var _ Graph = (*memGraph)(nil)
+
+func newMemGraph() *memGraph {
+	return &memGraph{nodes: make(map[string][]byte)}
+}
+
+func (g *memGraph) EntryPoint(context.Context) (string, error) {
+	return g.entryPoint, nil
+}
+
+func (g *memGraph) SetEntryPoint(_ context.Context, id string) error {
+	g.entryPoint = id
+	return nil
+}
+
+func (g *memGraph) GetNode(_ context.Context, id string) (Node, bool, error) {
+	b, ok := g.nodes[id]
+	if !ok {
+		return Node{}, false, nil
+	}
+	node, err := DecodeNode(b)
+	return node, err == nil, err
+}
+
+func (g *memGraph) PutNode(_ context.Context, id string, node Node) error {
+	b, err := node.Bytes()
+	g.nodes[id] = b
+	return err
+}
+
+func (g *memGraph) DeleteNode(_ context.Context, id string) error {
+	delete(g.nodes, id)
+	return nil
+}
+
+func randomVectors(r *rand.Rand, count int, dimension int) map[string][]float64 {
+	vectors := make(map[string][]float64, count)
+	for i := 0; i < count; i++ {
+		vector := make([]float64, dimension)
+		for j := range vector {
+			vector[j] = r.Float64()
+		}
+		vectors[fmt.Sprintf("doc-%d", i)] = vector
+	}
+	return vectors
+}
+
+func bruteForce(vectors map[string][]float64, query []float64, k int) []string {
+	results := make([]Result, 0, len(vectors))
+	for id, vector := range vectors {
+		results = append(results, Result{ID: id, Distance: Distance(query, vector)})
+	}
+	slices.SortFunc(results, compareResults)
+	return resultIDs(results[:min(k, len(results))])
+}
+
+// insertAll inserts the vectors in a fixed order, using a new index for each vector as
+// it would happen for documents written by different transactions.
+func insertAll(t *testing.T, graph Graph, vectors map[string][]float64) {
+	ids := make([]string, 0, len(vectors))
+	for id := range vectors {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	for _, id := range ids {
+		require.NoError(t, NewIndex(graph).Insert(context.Background(), id, vectors[id]))
+	}
+}
+
+func recall(t *testing.T, graph Graph, vectors map[string][]float64, queries map[string][]float64, k int) float64 {
+	found := 0
+	for _, query := range queries {
+		results, err := NewIndex(graph).Search(context.Background(), query, k)
+		require.NoError(t, err)
+		expected := bruteForce(vectors, query, k)
+		for _, result := range results {
+			if slices.Contains(expected, result.ID) {
+				found++
+			}
+		}
+	}
+	return float64(found) / float64(len(queries)*k)
+}
+
+func TestDistance(t *testing.T) {
+	assert.Equal(t, 5.0, Distance([]float64{1, 2}, []float64{4, 6}))
+	assert.Equal(t, 0.0, Distance([]float64{1, 2}, []float64{1, 2}))
+}
+
+func TestNode_EncodeDecode(t *testing.T) {
+	node := Node{Vector: []float64{0.5, -1.25}, Neighbors: [][]string{{"a", "b"}, {"a"}}}
+	b, err := node.Bytes()
+	require.NoError(t, err)
+	decoded, err := DecodeNode(b)
+	require.NoError(t, err)
+	assert.Equal(t, node, decoded)
+	assert.Equal(t, 1, decoded.Level())
+}
+
+func TestDecodeNode_WithInvalidBytes_Error(t *testing.T) {
+	_, err := DecodeNode([]byte{0xff})
+	assert.ErrorIs(t, err, ErrInvalidNode)
+}
+
+func TestSearch_WithEmptyIndex_ReturnsNothing(t *testing.T) {
+	results, err := NewIndex(newMemGraph()).Search(context.Background(), []float64{1, 2}, 3)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestSearch_ReturnsNearestByDistance(t *testing.T) {
+	graph := newMemGraph()
+	insertAll(t, graph, map[string][]float64{
+		"a": {0, 0},
+		"b": {1, 1},
+		"c": {5, 5},
+		"d": {2, 2},
+	})
+
+	results, err := NewIndex(graph).Search(context.Background(), []float64{1.2, 1.2}, 2)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, "b", results[0].ID)
+	assert.InDelta(t, Distance([]float64{1.2, 1.2}, []float64{1, 1}), results[0].Distance, 1e-9)
+	assert.Equal(t, "d", results[1].ID)
+}
+
+func TestSearch_WithManyVectors_ShouldFindMostOfTheNearest(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	vectors := randomVectors(r, 1000, 8)
+	graph := newMemGraph()
+	insertAll(t, graph, vectors)
+
+	assert.GreaterOrEqual(t, recall(t, graph, vectors, randomVectors(r, 50, 8), 10), 0.9)
+}
+
+func TestDelete_ShouldNotReturnDeletedVectors(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	vectors := randomVectors(r, 500, 4)
+	graph := newMemGraph()
+	insertAll(t, graph, vectors)
+
+	ids := make([]string, 0, len(vectors))
+	for id := range vectors {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	for _, id := range ids[:250] {
+		require.NoError(t, NewIndex(graph).Delete(context.Background(), id))
+		delete(vectors, id)
+	}
+
+	assert.Len(t, graph.nodes, 250)
+	queries := randomVectors(r, 50, 4)
+	for _, query := range queries {
+		results, err := NewIndex(graph).Search(context.Background(), query, 10)
+		require.NoError(t, err)
+		require.Len(t, results, 10)
+		for _, result := range results {
+			assert.Contains(t, vectors, result.ID)
+		}
+	}
+	assert.GreaterOrEqual(t, recall(t, graph, vectors, queries, 10), 0.9)
+}
+
+func TestDelete_WithAllVectors_ShouldEmptyIndex(t *testing.T) {
+	vectors := randomVectors(rand.New(rand.NewSource(3)), 50, 2)
+	graph := newMemGraph()
+	insertAll(t, graph, vectors)
+
+	for id := range vectors {
+		require.NoError(t, NewIndex(graph).Delete(context.Background(), id))
+	}
+
+	assert.Empty(t, graph.nodes)
+	assert.Equal(t, "", graph.entryPoint)
+}
+
+func TestDelete_WithUnknownID_Error(t *testing.T) {
+	err := NewIndex(newMemGraph()).Delete(context.Background(), "unknown")
+	assert.ErrorIs(t, err, ErrNodeNotFound)
+}
@@ -0,0 +1,98 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package vector
+
+import (
+	"context"
+	"errors"
+
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/internal/core"
+)
+
+// storeGraph is a [Graph] persisted in the index key space of a collection index.
+//
+// Every node is stored under a key holding its ID, and the ID of the entry point is stored
+// under a key holding an empty string, which is never a valid document ID.
+type storeGraph struct {
+	store        datastore.DSReaderWriter
+	collectionID uint32
+	indexID      uint32
+}
+
+var _ Graph = (*storeGraph)(nil)
+
+// NewStoreGraph returns a graph stored in the given store under the keys of the given
+// collection index.
+func NewStoreGraph(store datastore.DSReaderWriter, collectionID uint32, indexID uint32) Graph {
+	return &storeGraph{
+		store:        store,
+		collectionID: collectionID,
+		indexID:      indexID,
+	}
+}
+
+func (g *storeGraph) key(id string) ds.Key {
+	key := core.NewIndexDataStoreKey(
+		g.collectionID,
+		g.indexID,
+		[]core.IndexedField{{Value: client.NewNormalString(id)}},
+	)
+	return key.ToDS()
+}
+
+func (g *storeGraph) EntryPoint(ctx context.Context) (string, error) {
+	val, err := g.store.Get(ctx, g.key(""))
+	if err != nil {
+		if errors.Is(err, ds.ErrNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return string(val), nil
+}
+
+func (g *storeGraph) SetEntryPoint(ctx context.Context, id string) error {
+	if id == "" {
+		return g.store.Delete(ctx, g.key(""))
+	}
+	return g.store.Put(ctx, g.key(""), []byte(id))
+}
+
+func (g *storeGraph) GetNode(ctx context.Context, id string) (Node, bool, error) {
+	val, err := g.store.Get(ctx, g.key(id))
+	if err != nil {
+		if errors.Is(err, ds.ErrNotFound) {
+			return Node{}, false, nil
+		}
+		return Node{}, false, err
+	}
+	node, err := DecodeNode(val)
+	if err != nil {
+		return Node{}, false, err
+	}
+	return node, true, nil
+}
+
+func (g *storeGraph) PutNode(ctx context.Context, id string, node Node) error {
+	val, err := node.Bytes()
+	if err != nil {
+		return err
+	}
+	return g.store.Put(ctx, g.key(id), val)
+}
+
+func (g *storeGraph) DeleteNode(ctx context.Context, id string) error {
+	return g.store.Delete(ctx, g.key(id))
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+/*
+Package vector provides the approximate nearest neighbour index used by vector indexes and
+`_similar` queries.
+
+The index is a Hierarchical Navigable Small World (HNSW) graph. Every indexed vector is a
+node of the graph, linked to its nearest neighbours on each of the layers it belongs to. The
+graph is persisted through the [Graph] interface, one entry per node, so that it can be
+maintained within the transaction that writes the indexed document.
+*/
+package vector
+
+import (
+	"math"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// Distance returns the Euclidean distance between the two given vectors.
+//
+// The vectors are expected to have the same dimension.
+func Distance(a, b []float64) float64 {
+	var sum float64
+	for i := range a {
+		d := a[i] - b[i]
+		sum += d * d
+	}
+	return math.Sqrt(sum)
+}
+
+// Node is a vector of the index along with its links to other nodes.
+type Node struct {
+	// Vector is the indexed vector.
+	Vector []float64
+	// Neighbors holds the IDs of the nodes linked to this node on each layer, starting
+	// from the bottom layer.
+	Neighbors [][]string
+}
+
+// Level returns the top layer the node belongs to.
+func (n Node) Level() int {
+	return len(n.Neighbors) - 1
+}
+
+// Bytes returns the encoded node.
+func (n Node) Bytes() ([]byte, error) {
+	return cbor.Marshal(n)
+}
+
+// DecodeNode decodes a node from the given bytes.
+func DecodeNode(b []byte) (Node, error) {
+	var node Node
+	err := cbor.Unmarshal(b, &node)
+	if err != nil {
+		return Node{}, NewErrInvalidNode(err)
+	}
+	return node, nil
+}
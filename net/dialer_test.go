@@ -28,6 +28,7 @@ func TestDial_WithConnectedPeer_NoError(t *testing.T) {
 		ctx,
 		db1.Blockstore(),
 		db1.Encstore(),
+		db1.Headstore(),
 		db1.Events(),
 		WithListenAddresses("/ip4/127.0.0.1/tcp/0"),
 	)
@@ -37,6 +38,7 @@ func TestDial_WithConnectedPeer_NoError(t *testing.T) {
 		ctx,
 		db2.Blockstore(),
 		db1.Encstore(),
+		db2.Headstore(),
 		db2.Events(),
 		WithListenAddresses("/ip4/127.0.0.1/tcp/0"),
 	)
@@ -60,6 +62,7 @@ func TestDial_WithConnectedPeerAndSecondConnection_NoError(t *testing.T) {
 		ctx,
 		db1.Blockstore(),
 		db1.Encstore(),
+		db1.Headstore(),
 		db1.Events(),
 		WithListenAddresses("/ip4/127.0.0.1/tcp/0"),
 	)
@@ -69,6 +72,7 @@ func TestDial_WithConnectedPeerAndSecondConnection_NoError(t *testing.T) {
 		ctx,
 		db2.Blockstore(),
 		db1.Encstore(),
+		db2.Headstore(),
 		db2.Events(),
 		WithListenAddresses("/ip4/127.0.0.1/tcp/0"),
 	)
@@ -95,6 +99,7 @@ func TestDial_WithConnectedPeerAndSecondConnectionWithConnectionShutdown_Closing
 		ctx,
 		db1.Blockstore(),
 		db1.Encstore(),
+		db1.Headstore(),
 		db1.Events(),
 		WithListenAddresses("/ip4/127.0.0.1/tcp/0"),
 	)
@@ -104,6 +109,7 @@ func TestDial_WithConnectedPeerAndSecondConnectionWithConnectionShutdown_Closing
 		ctx,
 		db2.Blockstore(),
 		db1.Encstore(),
+		db2.Headstore(),
 		db2.Events(),
 		WithListenAddresses("/ip4/127.0.0.1/tcp/0"),
 	)
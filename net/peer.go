@@ -22,6 +22,7 @@ import (
 	"github.com/ipfs/boxo/blockservice"
 	"github.com/ipfs/boxo/bootstrap"
 	blocks "github.com/ipfs/go-block-format"
+	ds "github.com/ipfs/go-datastore"
 	gostream "github.com/libp2p/go-libp2p-gostream"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/host"
@@ -44,6 +45,8 @@ import (
 type Peer struct {
 	blockstore datastore.Blockstore
 	encstore   datastore.Blockstore
+	// headstore holds the markers of the blocks removed by DAG compactions.
+	headstore ds.Read
 
 	bus       *event.Bus
 	updateSub *event.Subscription
@@ -69,6 +72,7 @@ func NewPeer(
 	ctx context.Context,
 	blockstore datastore.Blockstore,
 	encstore datastore.Blockstore,
+	headstore ds.Read,
 	bus *event.Bus,
 	opts ...NodeOpt,
 ) (p *Peer, err error) {
@@ -81,7 +85,7 @@ func NewPeer(
 		}
 	}()
 
-	if blockstore == nil || encstore == nil {
+	if blockstore == nil || encstore == nil || headstore == nil {
 		return nil, ErrNilDB
 	}
 
@@ -119,6 +123,7 @@ func NewPeer(
 		dht:        ddht,
 		blockstore: blockstore,
 		encstore:   encstore,
+		headstore:  headstore,
 		ctx:        ctx,
 		cancel:     cancel,
 		bus:        bus,
@@ -81,6 +81,7 @@ func newTestPeer(ctx context.Context, t *testing.T) (client.DB, *Peer) {
 		ctx,
 		db.Blockstore(),
 		db.Encstore(),
+		db.Headstore(),
 		db.Events(),
 		WithListenAddresses(randomMultiaddr),
 	)
@@ -95,14 +96,14 @@ func TestNewPeer_NoError(t *testing.T) {
 	db, err := db.NewDB(ctx, store, acp.NoACP, nil)
 	require.NoError(t, err)
 	defer db.Close()
-	p, err := NewPeer(ctx, db.Blockstore(), db.Encstore(), db.Events())
+	p, err := NewPeer(ctx, db.Blockstore(), db.Encstore(), db.Headstore(), db.Events())
 	require.NoError(t, err)
 	p.Close()
 }
 
 func TestNewPeer_NoDB_NilDBError(t *testing.T) {
 	ctx := context.Background()
-	_, err := NewPeer(ctx, nil, nil, nil, nil)
+	_, err := NewPeer(ctx, nil, nil, nil, nil, nil)
 	require.ErrorIs(t, err, ErrNilDB)
 }
 
@@ -122,6 +123,7 @@ func TestStart_WithKnownPeer_NoError(t *testing.T) {
 		ctx,
 		db1.Blockstore(),
 		db1.Encstore(),
+		db1.Headstore(),
 		db1.Events(),
 		WithListenAddresses("/ip4/127.0.0.1/tcp/0"),
 	)
@@ -131,6 +133,7 @@ func TestStart_WithKnownPeer_NoError(t *testing.T) {
 		ctx,
 		db2.Blockstore(),
 		db1.Encstore(),
+		db2.Headstore(),
 		db2.Events(),
 		WithListenAddresses("/ip4/127.0.0.1/tcp/0"),
 	)
@@ -270,6 +273,7 @@ func TestNewPeer_WithEnableRelay_NoError(t *testing.T) {
 		context.Background(),
 		db.Blockstore(),
 		db.Encstore(),
+		db.Headstore(),
 		db.Events(),
 		WithEnableRelay(true),
 	)
@@ -288,6 +292,7 @@ func TestNewPeer_NoPubSub_NoError(t *testing.T) {
 		context.Background(),
 		db.Blockstore(),
 		db.Encstore(),
+		db.Headstore(),
 		db.Events(),
 		WithEnablePubSub(false),
 	)
@@ -307,6 +312,7 @@ func TestNewPeer_WithEnablePubSub_NoError(t *testing.T) {
 		ctx,
 		db.Blockstore(),
 		db.Encstore(),
+		db.Headstore(),
 		db.Events(),
 		WithEnablePubSub(true),
 	)
@@ -327,6 +333,7 @@ func TestNodeClose_NoError(t *testing.T) {
 		context.Background(),
 		db.Blockstore(),
 		db.Encstore(),
+		db.Headstore(),
 		db.Events(),
 	)
 	require.NoError(t, err)
@@ -344,6 +351,7 @@ func TestListenAddrs_WithListenAddresses_NoError(t *testing.T) {
 		context.Background(),
 		db.Blockstore(),
 		db.Encstore(),
+		db.Headstore(),
 		db.Events(),
 		WithListenAddresses("/ip4/127.0.0.1/tcp/0"),
 	)
@@ -363,6 +371,7 @@ func TestPeer_WithBootstrapPeers_NoError(t *testing.T) {
 		context.Background(),
 		db.Blockstore(),
 		db.Encstore(),
+		db.Headstore(),
 		db.Events(),
 		WithBootstrapPeers("/ip4/127.0.0.1/tcp/6666/p2p/QmaCpDMGvV2BGHeYERUEnRQAwe3N8SzbUtfsmvsqQLuvuJ"),
 	)
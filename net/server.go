@@ -132,7 +132,7 @@ func (s *server) PushLog(ctx context.Context, req *pushLogRequest) (*pushLogRepl
 		corelog.Any("PeerID", pid.String()),
 		corelog.Any("DocID", docID.String()))
 
-	err = syncDAG(ctx, s.peer.bserv, block)
+	err = syncDAG(ctx, s.peer.bserv, s.peer.headstore, block)
 	if err != nil {
 		return nil, err
 	}
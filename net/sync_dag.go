@@ -16,11 +16,13 @@ import (
 	"time"
 
 	"github.com/ipfs/boxo/blockservice"
+	ds "github.com/ipfs/go-datastore"
 	"github.com/ipld/go-ipld-prime/linking"
 	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
 	"github.com/ipld/go-ipld-prime/storage/bsrvadapter"
 
 	coreblock "github.com/sourcenetwork/defradb/internal/core/block"
+	"github.com/sourcenetwork/defradb/internal/merkle/clock"
 )
 
 // syncDAGTimeout is the maximum amount of time
@@ -30,9 +32,16 @@ var syncDAGTimeout = 60 * time.Second
 // syncDAG synchronizes the DAG starting with the given block
 // using the blockservice to fetch remote blocks.
 //
+// The headstore is used to find the blocks that have been removed by a DAG compaction.
+//
 // This process walks the entire DAG until the issue below is resolved.
 // https://github.com/sourcenetwork/defradb/issues/2722
-func syncDAG(ctx context.Context, bserv blockservice.BlockService, block *coreblock.Block) error {
+func syncDAG(
+	ctx context.Context,
+	bserv blockservice.BlockService,
+	headstore ds.Read,
+	block *coreblock.Block,
+) error {
 	// use a session to make remote fetches more efficient
 	ctx = blockservice.ContextWithSession(ctx, bserv)
 	store := &bsrvadapter.Adapter{Wrapped: bserv}
@@ -48,7 +57,7 @@ func syncDAG(ctx context.Context, bserv blockservice.BlockService, block *corebl
 		return err
 	}
 
-	err = loadBlockLinks(ctx, lsys, block)
+	err = loadBlockLinks(ctx, lsys, headstore, block)
 	if err != nil {
 		return err
 	}
@@ -57,9 +66,17 @@ func syncDAG(ctx context.Context, bserv blockservice.BlockService, block *corebl
 
 // loadBlockLinks loads the links of a block recursively.
 //
+// Links to blocks that have been removed by a DAG compaction are not loaded, so that the
+// pruned history is not fetched back from the peers that still hold it.
+//
 // If it encounters errors in the concurrent loading of links, it will return
 // the first error it encountered.
-func loadBlockLinks(ctx context.Context, lsys linking.LinkSystem, block *coreblock.Block) error {
+func loadBlockLinks(
+	ctx context.Context,
+	lsys linking.LinkSystem,
+	headstore ds.Read,
+	block *coreblock.Block,
+) error {
 	ctx, cancel := context.WithTimeout(ctx, syncDAGTimeout)
 	defer cancel()
 
@@ -79,6 +96,14 @@ func loadBlockLinks(ctx context.Context, lsys linking.LinkSystem, block *coreblo
 			if ctx.Err() != nil {
 				return
 			}
+			isPruned, err := clock.IsPruned(ctx, headstore, lnk.Cid)
+			if err != nil {
+				asyncErrOnce.Do(func() { setAsyncErr(err) })
+				return
+			}
+			if isPruned {
+				return
+			}
 			nd, err := lsys.Load(linking.LinkContext{Ctx: ctx}, lnk, coreblock.SchemaPrototype)
 			if err != nil {
 				asyncErrOnce.Do(func() { setAsyncErr(err) })
@@ -89,7 +114,7 @@ func loadBlockLinks(ctx context.Context, lsys linking.LinkSystem, block *coreblo
 				asyncErrOnce.Do(func() { setAsyncErr(err) })
 				return
 			}
-			err = loadBlockLinks(ctx, lsys, linkBlock)
+			err = loadBlockLinks(ctx, lsys, headstore, linkBlock)
 			if err != nil {
 				asyncErrOnce.Do(func() { setAsyncErr(err) })
 				return
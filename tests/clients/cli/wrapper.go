@@ -175,6 +175,30 @@ func (w *Wrapper) BasicExport(ctx context.Context, config *client.BackupConfig)
 	return err
 }
 
+func (w *Wrapper) CompactDAG(ctx context.Context, config client.CompactionConfig) (client.CompactionResult, error) {
+	args := []string{"client", "dag", "compact"}
+
+	if config.KeepHeight > 0 {
+		args = append(args, "--keep-height", strconv.FormatUint(config.KeepHeight, 10))
+	}
+	if config.MaxAge > 0 {
+		args = append(args, "--max-age", config.MaxAge.String())
+	}
+	if len(config.Collections) > 0 {
+		args = append(args, "--collections", strings.Join(config.Collections, ","))
+	}
+
+	data, err := w.cmd.execute(ctx, args)
+	if err != nil {
+		return client.CompactionResult{}, err
+	}
+	var result client.CompactionResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return client.CompactionResult{}, err
+	}
+	return result, nil
+}
+
 func (w *Wrapper) AddSchema(ctx context.Context, schema string) ([]client.CollectionDescription, error) {
 	args := []string{"client", "schema", "add"}
 	args = append(args, schema)
@@ -15,6 +15,7 @@ import (
 	"encoding/json"
 	"strings"
 
+	"github.com/ipfs/go-cid"
 	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/client"
@@ -183,6 +184,20 @@ func (c *Collection) Delete(
 	return true, nil
 }
 
+func (c *Collection) Revert(
+	ctx context.Context,
+	docID client.DocID,
+	version cid.Cid,
+) error {
+	args := []string{"client", "collection", "revert"}
+	args = append(args, "--name", c.Description().Name.Value())
+	args = append(args, "--docID", docID.String())
+	args = append(args, "--cid", version.String())
+
+	_, err := c.cmd.execute(ctx, args)
+	return err
+}
+
 func (c *Collection) Exists(
 	ctx context.Context,
 	docID client.DocID,
@@ -346,6 +361,19 @@ func (c *Collection) CreateIndex(
 	if indexDesc.Unique {
 		args = append(args, "--unique")
 	}
+	if indexDesc.Type != client.IndexTypeValue {
+		args = append(args, "--type", string(indexDesc.Type))
+	}
+	if len(indexDesc.Filter) > 0 {
+		filter, err := json.Marshal(indexDesc.Filter)
+		if err != nil {
+			return index, err
+		}
+		args = append(args, "--filter", string(filter))
+	}
+	if indexDesc.Background {
+		args = append(args, "--background")
+	}
 
 	fields := make([]string, len(indexDesc.Fields))
 	for i := range indexDesc.Fields {
@@ -394,3 +422,30 @@ func (c *Collection) GetIndexes(ctx context.Context) ([]client.IndexDescription,
 	}
 	return indexes, nil
 }
+
+func (c *Collection) VerifyIndexes(
+	ctx context.Context,
+	opts client.IndexVerifyOptions,
+) ([]client.IndexVerifyResult, error) {
+	if !c.Description().Name.HasValue() {
+		return nil, client.ErrOperationNotPermittedOnNamelessCols
+	}
+
+	args := []string{"client", "index", "verify", c.Description().Name.Value()}
+	if opts.IndexName != "" {
+		args = append(args, opts.IndexName)
+	}
+	if opts.Repair {
+		args = append(args, "--repair")
+	}
+
+	data, err := c.cmd.execute(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	var results []client.IndexVerifyResult
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
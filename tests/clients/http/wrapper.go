@@ -94,6 +94,10 @@ func (w *Wrapper) BasicExport(ctx context.Context, config *client.BackupConfig)
 	return w.client.BasicExport(ctx, config)
 }
 
+func (w *Wrapper) CompactDAG(ctx context.Context, config client.CompactionConfig) (client.CompactionResult, error) {
+	return w.client.CompactDAG(ctx, config)
+}
+
 func (w *Wrapper) AddSchema(ctx context.Context, schema string) ([]client.CollectionDescription, error) {
 	return w.client.AddSchema(ctx, schema)
 }
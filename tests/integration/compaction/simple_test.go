@@ -0,0 +1,391 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package compaction
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestCompactDAG_WithKeepHeight_KeepsCurrentState(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Compacting the DAG keeps the current state of the documents",
+		Actions: append(
+			compactionTestActions(),
+			testUtils.CompactDAG{
+				Config: client.CompactionConfig{
+					KeepHeight: 2,
+				},
+				ExpectedResult: immutable.Some(client.CompactionResult{
+					Documents:    1,
+					PrunedBlocks: 4,
+				}),
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"age":  int64(24),
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactDAG_WithKeepHeight_PrunesOlderCommits(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Compacting the DAG removes the older commits",
+		Actions: append(
+			compactionTestActions(),
+			testUtils.CompactDAG{
+				Config: client.CompactionConfig{
+					KeepHeight: 2,
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					commits(fieldId: "C") {
+						cid
+						height
+					}
+				}`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"cid":    "bafyreie2julzein6u5oomz3kyctazxr6lhgs53lzjxxrhh3kxodpdaljyq",
+							"height": int64(4),
+						},
+						{
+							"cid":    "bafyreih7g3j3og66qp5m443bpatx7bsinz6z4x2jqmmco5mqqdl2g5qwfi",
+							"height": int64(3),
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactDAG_WithKeepHeight_KeepsFieldHeads(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Compacting the DAG keeps the current heads of every field",
+		Actions: append(
+			compactionTestActions(),
+			testUtils.CompactDAG{
+				Config: client.CompactionConfig{
+					KeepHeight: 1,
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					commits(fieldId: "2") {
+						height
+						fieldName
+					}
+				}`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"height":    int64(1),
+							"fieldName": "name",
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactDAG_QueryKeptVersion(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "The versions kept by a compaction can still be queried",
+		Actions: append(
+			compactionTestActions(),
+			testUtils.CompactDAG{
+				Config: client.CompactionConfig{
+					KeepHeight: 2,
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(
+						docID: "bae-0b2f15e5-bfe7-5cb7-8045-471318d7dbc3",
+						cid: "bafyreih7g3j3og66qp5m443bpatx7bsinz6z4x2jqmmco5mqqdl2g5qwfi"
+					) {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"age":  int64(23),
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactDAG_QueryPrunedVersion_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "The versions pruned by a compaction can no longer be queried",
+		Actions: append(
+			compactionTestActions(),
+			testUtils.CompactDAG{
+				Config: client.CompactionConfig{
+					KeepHeight: 2,
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(
+						docID: "bae-0b2f15e5-bfe7-5cb7-8045-471318d7dbc3",
+						cid: "bafyreiewqnlnfzgtyhz2xg55k7oj3nvswx6scr4plhw6hmczizr2jqoe3m"
+					) {
+						age
+					}
+				}`,
+				ExpectedError: "the version has been pruned by a DAG compaction",
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactDAG_UpdateAfterCompaction(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Documents can be updated after a compaction",
+		Actions: append(
+			compactionTestActions(),
+			testUtils.CompactDAG{
+				Config: client.CompactionConfig{
+					KeepHeight: 1,
+				},
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": 25
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						age
+						_version {
+							height
+						}
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"age":  int64(25),
+							"_version": []map[string]any{
+								{
+									"height": int64(5),
+								},
+								{
+									"height": int64(4),
+								},
+							},
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactDAG_Twice(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Compacting an already compacted DAG squashes the previous snapshot",
+		Actions: append(
+			compactionTestActions(),
+			testUtils.CompactDAG{
+				Config: client.CompactionConfig{
+					KeepHeight: 2,
+				},
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": 25
+				}`,
+			},
+			testUtils.CompactDAG{
+				Config: client.CompactionConfig{
+					KeepHeight: 2,
+				},
+				ExpectedResult: immutable.Some(client.CompactionResult{
+					Documents:    1,
+					PrunedBlocks: 2,
+				}),
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(
+						docID: "bae-0b2f15e5-bfe7-5cb7-8045-471318d7dbc3",
+						cid: "bafyreie2julzein6u5oomz3kyctazxr6lhgs53lzjxxrhh3kxodpdaljyq"
+					) {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"age":  int64(24),
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"age":  int64(25),
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactDAG_NothingToCompact(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Compacting documents with fewer versions than the keep height does nothing",
+		Actions: append(
+			compactionTestActions(),
+			testUtils.CompactDAG{
+				Config: client.CompactionConfig{
+					KeepHeight: 10,
+				},
+				ExpectedResult: immutable.Some(client.CompactionResult{}),
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(
+						docID: "bae-0b2f15e5-bfe7-5cb7-8045-471318d7dbc3",
+						cid: "bafyreibestqolaaumunpbo4qlorstwfxfqzrddlqb3rsvfyxscuyymktte"
+					) {
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"age": int64(21),
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactDAG_WithCollectionFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Only the documents of the given collections are compacted",
+		Actions: append(
+			compactionTestActions(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Books {
+						title: String
+					}
+				`,
+			},
+			testUtils.CompactDAG{
+				Config: client.CompactionConfig{
+					KeepHeight:  1,
+					Collections: []string{"Books"},
+				},
+				ExpectedResult: immutable.Some(client.CompactionResult{}),
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactDAG_WithUnknownCollection_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Compacting an unknown collection returns an error",
+		Actions: append(
+			compactionTestActions(),
+			testUtils.CompactDAG{
+				Config: client.CompactionConfig{
+					KeepHeight:  1,
+					Collections: []string{"Books"},
+				},
+				ExpectedError: "failed to get collection",
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactDAG_WithoutPolicy_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Compacting without a keep height or a max age returns an error",
+		Actions: append(
+			compactionTestActions(),
+			testUtils.CompactDAG{
+				Config:        client.CompactionConfig{},
+				ExpectedError: "a keep height or a max age is required to compact the DAG",
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
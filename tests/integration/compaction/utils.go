@@ -0,0 +1,56 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package compaction
+
+import (
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+// compactionTestActions creates a document with four versions.
+//
+// The composite block CIDs of the versions are:
+//   - v1: bafyreibestqolaaumunpbo4qlorstwfxfqzrddlqb3rsvfyxscuyymktte
+//   - v2: bafyreiewqnlnfzgtyhz2xg55k7oj3nvswx6scr4plhw6hmczizr2jqoe3m
+//   - v3: bafyreih7g3j3og66qp5m443bpatx7bsinz6z4x2jqmmco5mqqdl2g5qwfi
+//   - v4: bafyreie2julzein6u5oomz3kyctazxr6lhgs53lzjxxrhh3kxodpdaljyq
+func compactionTestActions() []any {
+	return []any{
+		testUtils.SchemaUpdate{
+			Schema: `
+				type Users {
+					name: String
+					age: Int
+				}
+			`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"name": "John",
+				"age": 21
+			}`,
+		},
+		testUtils.UpdateDoc{
+			Doc: `{
+				"age": 22
+			}`,
+		},
+		testUtils.UpdateDoc{
+			Doc: `{
+				"age": 23
+			}`,
+		},
+		testUtils.UpdateDoc{
+			Doc: `{
+				"age": 24
+			}`,
+		},
+	}
+}
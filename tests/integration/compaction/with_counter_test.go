@@ -0,0 +1,174 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package compaction
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+// counterTestActions creates a document with a pn counter field and four versions.
+//
+// Counter blocks hold a random nonce so their CIDs are not stable, the versions are queried
+// by time instead. With timestamps enabled the composite blocks are created at:
+// - 00:00:02 the creation of John with 10 points
+// - 00:00:04 the first increment to 15 points
+// - 00:00:06 the second increment to 20 points
+// - 00:00:08 the third increment to 25 points
+func counterTestActions() []any {
+	return []any{
+		testUtils.SchemaUpdate{
+			Schema: `
+				type Users {
+					name: String
+					points: Int @crdt(type: pncounter)
+				}
+			`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"name": "John",
+				"points": 10
+			}`,
+		},
+		testUtils.UpdateDoc{
+			Doc: `{
+				"points": 5
+			}`,
+		},
+		testUtils.UpdateDoc{
+			Doc: `{
+				"points": 5
+			}`,
+		},
+		testUtils.UpdateDoc{
+			Doc: `{
+				"points": 5
+			}`,
+		},
+	}
+}
+
+func TestCompactDAG_WithPNCounter_QueryKeptVersion(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "The state of the counters at the kept versions includes the pruned increments",
+		Actions: append(
+			counterTestActions(),
+			testUtils.CompactDAG{
+				Config: client.CompactionConfig{
+					KeepHeight: 2,
+				},
+				ExpectedResult: immutable.Some(client.CompactionResult{
+					Documents:    1,
+					PrunedBlocks: 4,
+				}),
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(asOf: "2024-01-01T00:00:06Z") {
+						name
+						points
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":   "John",
+							"points": int64(20),
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						points
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":   "John",
+							"points": int64(25),
+						},
+					},
+				},
+			},
+		),
+		EnableTimestamps: true,
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactDAG_WithPNCounter_Twice(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Compacting a counter twice keeps the increments squashed by the first compaction",
+		Actions: append(
+			counterTestActions(),
+			testUtils.CompactDAG{
+				Config: client.CompactionConfig{
+					KeepHeight: 2,
+				},
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"points": 5
+				}`,
+			},
+			testUtils.CompactDAG{
+				Config: client.CompactionConfig{
+					KeepHeight: 2,
+				},
+				ExpectedResult: immutable.Some(client.CompactionResult{
+					Documents:    1,
+					PrunedBlocks: 2,
+				}),
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(asOf: "2024-01-01T00:00:08Z") {
+						points
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"points": int64(25),
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						points
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"points": int64(30),
+						},
+					},
+				},
+			},
+		),
+		EnableTimestamps: true,
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
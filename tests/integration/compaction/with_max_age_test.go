@@ -0,0 +1,101 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package compaction
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestCompactDAG_WithMaxAge(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Compacting the DAG prunes the versions older than the max age",
+		Actions: append(
+			counterTestActions(),
+			testUtils.CompactDAG{
+				Config: client.CompactionConfig{
+					MaxAge: 4 * time.Second,
+				},
+				ExpectedResult: immutable.Some(client.CompactionResult{
+					Documents:    1,
+					PrunedBlocks: 4,
+				}),
+			},
+			testUtils.Request{
+				Request: `query {
+					commits(fieldId: "C") {
+						height
+						timestamp
+					}
+				}`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"height":    int64(4),
+							"timestamp": testUtils.TestClockStart.Add(8 * time.Second),
+						},
+						{
+							"height":    int64(3),
+							"timestamp": testUtils.TestClockStart.Add(6 * time.Second),
+						},
+					},
+				},
+			},
+		),
+		EnableTimestamps: true,
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactDAG_WithMaxAgeAndKeepHeight(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Compacting the DAG only prunes the versions that satisfy both the max age and the keep height",
+		Actions: append(
+			counterTestActions(),
+			testUtils.CompactDAG{
+				Config: client.CompactionConfig{
+					KeepHeight: 3,
+					MaxAge:     4 * time.Second,
+				},
+				ExpectedResult: immutable.Some(client.CompactionResult{
+					Documents:    1,
+					PrunedBlocks: 2,
+				}),
+			},
+		),
+		EnableTimestamps: true,
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactDAG_WithMaxAgeWithoutTimestamps_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Compacting by age without block timestamps returns an error",
+		Actions: append(
+			compactionTestActions(),
+			testUtils.CompactDAG{
+				Config: client.CompactionConfig{
+					MaxAge: time.Nanosecond,
+				},
+				ExpectedError: "compacting the DAG by age requires the block timestamps to be enabled",
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -15,12 +15,15 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/crypto"
+	"github.com/sourcenetwork/defradb/internal/db"
 	"github.com/sourcenetwork/defradb/internal/kms"
 	"github.com/sourcenetwork/defradb/node"
 	changeDetector "github.com/sourcenetwork/defradb/tests/change_detector"
@@ -72,6 +75,24 @@ func init() {
 	}
 }
 
+// TestClockStart is the time at which the wall clock of the nodes starts in tests
+// with timestamps enabled.
+var TestClockStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// newTestClock returns a wall clock that starts at [TestClockStart] and advances
+// by one second every time it is read.
+func newTestClock() func() time.Time {
+	var mutex sync.Mutex
+	next := TestClockStart
+	return func() time.Time {
+		mutex.Lock()
+		defer mutex.Unlock()
+		now := next
+		next = next.Add(time.Second)
+		return now
+	}
+}
+
 func NewBadgerMemoryDB(ctx context.Context) (client.DB, error) {
 	opts := []node.Option{
 		node.WithDisableP2P(true),
@@ -196,6 +217,10 @@ func setupNode(s *state, opts ...node.Option) (*node.Node, string, error) {
 		opts = append(opts, node.WithKMS(kms.PubSubServiceType))
 	}
 
+	if s.testCase.EnableTimestamps {
+		opts = append(opts, db.WithEnableTimestamps(true), db.WithPhysicalClock(newTestClock()))
+	}
+
 	node, err := node.New(s.ctx, opts...)
 	if err != nil {
 		return nil, "", err
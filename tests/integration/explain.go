@@ -34,33 +34,42 @@ var (
 		"subType": {},
 
 		// These are all valid nodes.
-		"averageNode":   {},
-		"countNode":     {},
-		"createNode":    {},
-		"dagScanNode":   {},
-		"deleteNode":    {},
-		"groupNode":     {},
-		"limitNode":     {},
-		"maxNode":       {},
-		"minNode":       {},
-		"multiScanNode": {},
-		"orderNode":     {},
-		"parallelNode":  {},
-		"pipeNode":      {},
-		"scanNode":      {},
-		"selectNode":    {},
-		"selectTopNode": {},
-		"sumNode":       {},
-		"topLevelNode":  {},
-		"typeIndexJoin": {},
-		"typeJoinMany":  {},
-		"typeJoinOne":   {},
-		"updateNode":    {},
-		"upsertNode":    {},
-		"valuesNode":    {},
-		"viewNode":      {},
-		"lensNode":      {},
-		"operationNode": {},
+		"aggregateFilterNode": {},
+		"averageNode":         {},
+		"countNode":           {},
+		"countDistinctNode":   {},
+		"cursorNode":          {},
+		"createNode":          {},
+		"dagScanNode":         {},
+		"deleteNode":          {},
+		"distinctNode":        {},
+		"groupNode":           {},
+		"limitNode":           {},
+		"maxNode":             {},
+		"medianNode":          {},
+		"minNode":             {},
+		"multiScanNode":       {},
+		"orderNode":           {},
+		"parallelNode":        {},
+		"percentileNode":      {},
+		"pipeNode":            {},
+		"scanNode":            {},
+		"selectNode":          {},
+		"selectTopNode":       {},
+		"similarNode":         {},
+		"stddevNode":          {},
+		"sumNode":             {},
+		"topLevelNode":        {},
+		"typeIndexJoin":       {},
+		"typeJoinMany":        {},
+		"typeJoinOne":         {},
+		"updateNode":          {},
+		"upsertNode":          {},
+		"valuesNode":          {},
+		"varianceNode":        {},
+		"viewNode":            {},
+		"lensNode":            {},
+		"operationNode":       {},
 	}
 )
 
@@ -0,0 +1,96 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package test_explain_default
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+	explainUtils "github.com/sourcenetwork/defradb/tests/integration/explain"
+)
+
+var groupAggregateFilterPattern = dataMap{
+	"explain": dataMap{
+		"operationNode": []dataMap{
+			{
+				"selectTopNode": dataMap{
+					"aggregateFilterNode": dataMap{
+						"countNode": dataMap{
+							"groupNode": dataMap{
+								"selectNode": dataMap{
+									"scanNode": dataMap{},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func TestDefaultExplainRequestWithGroupByWithCountFilter(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Explain (default) request with group-by, filtered by the count of each group.",
+
+		Actions: []any{
+			explainUtils.SchemaForExplainTests,
+
+			testUtils.ExplainRequest{
+
+				Request: `query @explain {
+					Author(groupBy: [age], filter: {verified: {_eq: true}, _count: {_gt: 1}}) {
+						age
+						_count(_group: {})
+					}
+				}`,
+
+				ExpectedPatterns: groupAggregateFilterPattern,
+
+				ExpectedTargets: []testUtils.PlanNodeTargetCase{
+					{
+						TargetNodeName:    "aggregateFilterNode",
+						IncludeChildNodes: false,
+						ExpectedAttributes: dataMap{
+							"filter": dataMap{
+								"_count": dataMap{
+									"_gt": int32(1),
+								},
+							},
+						},
+					},
+					{
+						TargetNodeName:    "scanNode",
+						IncludeChildNodes: true,
+						ExpectedAttributes: dataMap{
+							"filter": dataMap{
+								"verified": dataMap{
+									"_eq": true,
+								},
+							},
+							"collectionID":   "3",
+							"collectionName": "Author",
+							"spans": []dataMap{
+								{
+									"start": "/3",
+									"end":   "/4",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	explainUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,183 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package test_explain_default
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+	explainUtils "github.com/sourcenetwork/defradb/tests/integration/explain"
+)
+
+var cursorPattern = dataMap{
+	"explain": dataMap{
+		"operationNode": []dataMap{
+			{
+				"selectTopNode": dataMap{
+					"cursorNode": dataMap{
+						"selectNode": dataMap{
+							"scanNode": dataMap{},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func TestDefaultExplainRequestWithFirst(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Explain (default) request with first.",
+
+		Actions: []any{
+			explainUtils.SchemaForExplainTests,
+
+			testUtils.ExplainRequest{
+
+				Request: `query @explain {
+					Author(first: 2) {
+						name
+					}
+				}`,
+
+				ExpectedPatterns: cursorPattern,
+
+				ExpectedTargets: []testUtils.PlanNodeTargetCase{
+					{
+						TargetNodeName:    "cursorNode",
+						IncludeChildNodes: false,
+						ExpectedAttributes: dataMap{
+							"first":  uint64(2),
+							"after":  nil,
+							"last":   nil,
+							"before": nil,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	explainUtils.ExecuteTestCase(t, test)
+}
+
+func TestDefaultExplainRequestWithFirstAndAfter_SeeksScan(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Explain (default) request with first and after.",
+
+		Actions: []any{
+			explainUtils.SchemaForExplainTests,
+
+			testUtils.ExplainRequest{
+
+				// the cursor of the document with docID bae-0b9a1e9b-0b1d-5b4c-8b3c-9b1e0b1d5b4c
+				Request: `query @explain {
+					Author(first: 2, after: "gXgoYmFlLTBiOWExZTliLTBiMWQtNWI0Yy04YjNjLTliMWUwYjFkNWI0Yw") {
+						name
+					}
+				}`,
+
+				ExpectedPatterns: cursorPattern,
+
+				ExpectedTargets: []testUtils.PlanNodeTargetCase{
+					{
+						TargetNodeName:    "cursorNode",
+						IncludeChildNodes: false,
+						ExpectedAttributes: dataMap{
+							"first":  uint64(2),
+							"after":  "gXgoYmFlLTBiOWExZTliLTBiMWQtNWI0Yy04YjNjLTliMWUwYjFkNWI0Yw",
+							"last":   nil,
+							"before": nil,
+						},
+					},
+					{
+						TargetNodeName:    "scanNode",
+						IncludeChildNodes: true,
+						ExpectedAttributes: dataMap{
+							"filter":         nil,
+							"collectionID":   "3",
+							"collectionName": "Author",
+							"spans": []dataMap{
+								{
+									"start": "/3/bae-0b9a1e9b-0b1d-5b4c-8b3c-9b1e0b1d5b4d",
+									"end":   "/4",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	explainUtils.ExecuteTestCase(t, test)
+}
+
+func TestDefaultExplainRequestWithLastAndOrder(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Explain (default) request with last and order.",
+
+		Actions: []any{
+			explainUtils.SchemaForExplainTests,
+
+			testUtils.ExplainRequest{
+
+				Request: `query @explain {
+					Author(last: 1, order: {age: ASC}) {
+						name
+					}
+				}`,
+
+				ExpectedPatterns: dataMap{
+					"explain": dataMap{
+						"operationNode": []dataMap{
+							{
+								"selectTopNode": dataMap{
+									"cursorNode": dataMap{
+										"orderNode": dataMap{
+											"selectNode": dataMap{
+												"scanNode": dataMap{},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+
+				ExpectedTargets: []testUtils.PlanNodeTargetCase{
+					{
+						TargetNodeName:    "orderNode",
+						IncludeChildNodes: false,
+						ExpectedAttributes: dataMap{
+							"orderings": []dataMap{
+								{
+									"direction": "ASC",
+									"fields":    []string{"age"},
+								},
+								{
+									"direction": "ASC",
+									"fields":    []string{"_docID"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	explainUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package test_explain_default
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+	explainUtils "github.com/sourcenetwork/defradb/tests/integration/explain"
+)
+
+var distinctPattern = dataMap{
+	"explain": dataMap{
+		"operationNode": []dataMap{
+			{
+				"selectTopNode": dataMap{
+					"distinctNode": dataMap{
+						"selectNode": dataMap{
+							"scanNode": dataMap{},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func TestDefaultExplainRequestWithDistinct(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Explain (default) request with distinct.",
+
+		Actions: []any{
+			explainUtils.SchemaForExplainTests,
+
+			testUtils.ExplainRequest{
+
+				Request: `query @explain {
+					Author(distinct: [age, verified]) {
+						name
+					}
+				}`,
+
+				ExpectedPatterns: distinctPattern,
+
+				ExpectedTargets: []testUtils.PlanNodeTargetCase{
+					{
+						TargetNodeName:    "distinctNode",
+						IncludeChildNodes: false,
+						ExpectedAttributes: dataMap{
+							"distinctFields": []string{"age", "verified"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	explainUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package test_explain_default
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+	explainUtils "github.com/sourcenetwork/defradb/tests/integration/explain"
+)
+
+var similarPattern = dataMap{
+	"explain": dataMap{
+		"operationNode": []dataMap{
+			{
+				"selectTopNode": dataMap{
+					"similarNode": dataMap{
+						"selectNode": dataMap{
+							"scanNode": dataMap{},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func TestDefaultExplainRequestWithSimilar(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Explain (default) request with _similar.",
+
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Product {
+						name: String
+						embedding: [Float!] @vector(dimension: 2)
+					}
+				`,
+			},
+
+			testUtils.ExplainRequest{
+
+				Request: `query @explain {
+					Product(_similar: {field: "embedding", vector: [1, 0], k: 3}) {
+						name
+					}
+				}`,
+
+				ExpectedPatterns: similarPattern,
+
+				ExpectedTargets: []testUtils.PlanNodeTargetCase{
+					{
+						TargetNodeName:    "similarNode",
+						IncludeChildNodes: false,
+						ExpectedAttributes: dataMap{
+							"fieldName": "embedding",
+							"k":         uint64(3),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	explainUtils.ExecuteTestCase(t, test)
+}
@@ -55,6 +55,22 @@ func readNumberProp(t testing.TB, val any, prop string) uint64 {
 	return 0
 }
 
+// findSelectNode returns the selectNode of the given selectTopNode, walking through the
+// nodes wrapping it such as the similarNode.
+func findSelectNode(node dataMap) (dataMap, bool) {
+	if selectNode, ok := node["selectNode"].(dataMap); ok {
+		return selectNode, true
+	}
+	for _, child := range node {
+		if childNode, ok := child.(dataMap); ok {
+			if selectNode, ok := findSelectNode(childNode); ok {
+				return selectNode, true
+			}
+		}
+	}
+	return nil, false
+}
+
 func (a *ExplainResultAsserter) Assert(t testing.TB, result map[string]any) {
 	explainNode, ok := result["explain"].(dataMap)
 	require.True(t, ok, "Expected explain none")
@@ -73,7 +89,7 @@ func (a *ExplainResultAsserter) Assert(t testing.TB, result map[string]any) {
 	require.Len(t, operationNode, 1)
 	selectTopNode, ok := operationNode[0]["selectTopNode"].(dataMap)
 	require.True(t, ok, "Expected selectTopNode")
-	selectNode, ok := selectTopNode["selectNode"].(dataMap)
+	selectNode, ok := findSelectNode(selectTopNode)
 	require.True(t, ok, "Expected selectNode")
 
 	if a.filterMatches.HasValue() {
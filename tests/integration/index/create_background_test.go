@@ -0,0 +1,316 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/internal/db"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestCreateIndexInBackground_ShouldIndexExistingDocs(t *testing.T) {
+	req := `query {
+		User(filter: {age: {_eq: 21}}) {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Creating an index in the background indexes the existing documents",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age":	21
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"Andy",
+					"age":	22
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"Shahzad",
+					"age":	30
+				}`,
+			},
+			testUtils.CreateIndex{
+				IndexName:  "userByAge",
+				FieldName:  "age",
+				Background: true,
+			},
+			testUtils.WaitForIndexBuilds{},
+			testUtils.GetIndexes{
+				ExpectedIndexes: []client.IndexDescription{
+					{
+						Name: "userByAge",
+						ID:   1,
+						Fields: []client.IndexedFieldDescription{
+							{Name: "age"},
+						},
+						Build: &client.IndexBuildStatus{
+							State:         client.IndexBuildStateReady,
+							DocsProcessed: 3,
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"User": []map[string]any{
+						{"name": "John"},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithIndexFetches(1),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCreateIndexInBackground_WithMoreDocsThanBatch_ShouldIndexAllDocs(t *testing.T) {
+	const docsCount = 250
+
+	req := `query {
+		User(filter: {age: {_ge: 0}}, order: {age: ASC}) {
+			age
+		}
+	}`
+	actions := []any{
+		testUtils.SchemaUpdate{
+			Schema: `
+				type User {
+					age: Int
+				}
+			`,
+		},
+	}
+	expectedUsers := make([]map[string]any, 0, docsCount)
+	for i := 0; i < docsCount; i++ {
+		actions = append(actions, testUtils.CreateDoc{
+			Doc: fmt.Sprintf(`{"age": %d}`, i),
+		})
+		expectedUsers = append(expectedUsers, map[string]any{"age": int64(i)})
+	}
+	actions = append(
+		actions,
+		testUtils.CreateIndex{
+			IndexName:  "userByAge",
+			FieldName:  "age",
+			Background: true,
+		},
+		testUtils.WaitForIndexBuilds{},
+		testUtils.GetIndexes{
+			ExpectedIndexes: []client.IndexDescription{
+				{
+					Name: "userByAge",
+					ID:   1,
+					Fields: []client.IndexedFieldDescription{
+						{Name: "age"},
+					},
+					Build: &client.IndexBuildStatus{
+						State:         client.IndexBuildStateReady,
+						DocsProcessed: docsCount,
+					},
+				},
+			},
+		},
+		testUtils.Request{
+			Request: req,
+			Results: map[string]any{
+				"User": expectedUsers,
+			},
+		},
+		testUtils.Request{
+			Request:  makeExplainQuery(req),
+			Asserter: testUtils.NewExplainAsserter().WithIndexFetches(docsCount),
+		},
+	)
+
+	test := testUtils.TestCase{
+		Description: "Creating an index in the background indexes the documents of all the batches",
+		Actions:     actions,
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCreateIndexInBackground_WithDocsChangedDuringBuild_ShouldKeepIndexCurrent(t *testing.T) {
+	req := `query {
+		User(filter: {age: {_gt: 20}}, order: {age: ASC}) {
+			name
+			age
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Documents changed while an index is built in the background are indexed",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age":	21
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"Andy",
+					"age":	22
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"Fred",
+					"age":	23
+				}`,
+			},
+			testUtils.CreateIndex{
+				IndexName:  "userByAge",
+				FieldName:  "age",
+				Background: true,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"Shahzad",
+					"age":	30
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"age":	40
+				}`,
+			},
+			testUtils.DeleteDoc{
+				DocID: 1,
+			},
+			testUtils.WaitForIndexBuilds{},
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"User": []map[string]any{
+						{"name": "Fred", "age": int64(23)},
+						{"name": "Shahzad", "age": int64(30)},
+						{"name": "John", "age": int64(40)},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithIndexFetches(3),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCreateIndexInBackground_IfFieldValuesAreNotUnique_ShouldFailBuild(t *testing.T) {
+	req := `query {
+		User(filter: {age: {_eq: 21}}, order: {name: ASC}) {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "A unique index built in the background fails on duplicated values and is not used",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age":	21
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"Andy",
+					"age":	22
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"Shahzad",
+					"age":	21
+				}`,
+			},
+			testUtils.CreateIndex{
+				IndexName:  "userByAge",
+				FieldName:  "age",
+				Unique:     true,
+				Background: true,
+			},
+			testUtils.WaitForIndexBuilds{},
+			testUtils.GetIndexes{
+				ExpectedIndexes: []client.IndexDescription{
+					{
+						Name: "userByAge",
+						ID:   1,
+						Fields: []client.IndexedFieldDescription{
+							{Name: "age"},
+						},
+						Build: &client.IndexBuildStatus{
+							State: client.IndexBuildStateFailed,
+							Error: db.NewErrCanNotIndexNonUniqueFields(
+								johnDocID, errors.NewKV("age", 21)).Error(),
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"User": []map[string]any{
+						{"name": "John"},
+						{"name": "Shahzad"},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithIndexFetches(0),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
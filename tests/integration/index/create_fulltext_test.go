@@ -0,0 +1,96 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/db"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestCreateFullTextIndex_OnNonStringField_ReturnError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Product {
+						price: Int
+					}
+				`,
+			},
+			testUtils.CreateIndex{
+				FieldName:     "price",
+				Type:          client.IndexTypeFullText,
+				ExpectedError: db.NewErrUnsupportedFullTextIndexFieldType(client.FieldKind_NILLABLE_INT).Error(),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCreateFullTextIndex_WithUnique_ReturnError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Product {
+						description: String @index(type: FULLTEXT, unique: true)
+					}
+				`,
+				ExpectedError: db.ErrFullTextIndexUnique.Error(),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCreateFullTextIndex_WithMultipleFields_ReturnError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Product @index(type: FULLTEXT, includes: [{field: "name"}, {field: "description"}]) {
+						name: String
+						description: String
+					}
+				`,
+				ExpectedError: db.ErrFullTextIndexMultipleFields.Error(),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCreateIndex_WithUnknownType_ReturnError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Product {
+						description: String
+					}
+				`,
+			},
+			testUtils.CreateIndex{
+				FieldName:     "description",
+				Type:          client.IndexType("SPATIAL"),
+				ExpectedError: db.NewErrUnknownIndexType("SPATIAL").Error(),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
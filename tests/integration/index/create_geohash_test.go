@@ -0,0 +1,95 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/db"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestCreateGeoHashIndex_OnNonGeoPointField_ReturnError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Shop {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateIndex{
+				FieldName:     "name",
+				Type:          client.IndexTypeGeoHash,
+				ExpectedError: db.NewErrUnsupportedGeoHashIndexFieldType(client.FieldKind_NILLABLE_STRING).Error(),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCreateGeoHashIndex_WithUnique_ReturnError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Shop {
+						location: GeoPoint @index(type: GEOHASH, unique: true)
+					}
+				`,
+				ExpectedError: db.ErrGeoHashIndexUnique.Error(),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCreateGeoHashIndex_WithMultipleFields_ReturnError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Shop @index(type: GEOHASH, includes: [{field: "location"}, {field: "entrance"}]) {
+						location: GeoPoint
+						entrance: GeoPoint
+					}
+				`,
+				ExpectedError: db.ErrGeoHashIndexMultipleFields.Error(),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCreateIndex_OnGeoPointFieldWithoutGeoHashType_ReturnError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Shop {
+						location: GeoPoint
+					}
+				`,
+			},
+			testUtils.CreateIndex{
+				FieldName:     "location",
+				ExpectedError: db.NewErrUnsupportedIndexFieldType(client.FieldKind_NILLABLE_GEOPOINT).Error(),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
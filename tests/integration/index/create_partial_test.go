@@ -0,0 +1,170 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/db"
+	"github.com/sourcenetwork/defradb/internal/planner/filter"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestCreatePartialIndex_WithFilter_ShouldReturnIndexWithFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Order {
+						customer: String
+						status: String
+					}
+				`,
+			},
+			testUtils.CreateIndex{
+				IndexName: "openOrdersByCustomer",
+				FieldName: "customer",
+				Filter: map[string]any{
+					"status": map[string]any{"_eq": "open"},
+				},
+			},
+			testUtils.GetIndexes{
+				ExpectedIndexes: []client.IndexDescription{
+					{
+						Name: "openOrdersByCustomer",
+						ID:   1,
+						Fields: []client.IndexedFieldDescription{
+							{Name: "customer"},
+						},
+						Filter: map[string]any{
+							"status": map[string]any{"_eq": "open"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCreatePartialIndex_WithFilterInSchema_ShouldReturnIndexWithFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Order {
+						customer: String @index(name: "openOrdersByCustomer", filter: {status: {_eq: "open"}})
+						status: String
+					}
+				`,
+			},
+			testUtils.GetIndexes{
+				ExpectedIndexes: []client.IndexDescription{
+					{
+						Name: "openOrdersByCustomer",
+						ID:   1,
+						Fields: []client.IndexedFieldDescription{
+							{Name: "customer"},
+						},
+						Filter: map[string]any{
+							"status": map[string]any{"_eq": "open"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCreatePartialIndex_WithFilterOnUnknownField_ReturnError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Order {
+						customer: String
+					}
+				`,
+			},
+			testUtils.CreateIndex{
+				FieldName: "customer",
+				Filter: map[string]any{
+					"status": map[string]any{"_eq": "open"},
+				},
+				ExpectedError: client.NewErrFieldNotExist("status").Error(),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCreatePartialIndex_WithFilterOnRelation_ReturnError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Customer {
+						name: String
+					}
+
+					type Order {
+						item: String @index(filter: {customer: {name: {_eq: "Shahzad"}}})
+						customer: Customer
+					}
+				`,
+				ExpectedError: filter.NewErrIndexFilterOnRelation("customer").Error(),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCreatePartialIndex_WithInvalidFilterValue_ReturnError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Order {
+						customer: String @index(filter: {amount: {_gt: "ten"}})
+						amount: Int
+					}
+				`,
+				ExpectedError: filter.NewErrInvalidIndexFilterValue("amount", "ten").Error(),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCreatePartialIndex_WithFullTextType_ReturnError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Order {
+						notes: String @index(type: FULLTEXT, filter: {status: {_eq: "open"}})
+						status: String
+					}
+				`,
+				ExpectedError: db.ErrFilteredIndexNotValueType.Error(),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,77 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/db"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestCreateVectorIndex_OnNonVectorField_ReturnError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Product {
+						prices: [Float!]
+					}
+				`,
+			},
+			testUtils.CreateIndex{
+				FieldName:     "prices",
+				Type:          client.IndexTypeVector,
+				ExpectedError: db.NewErrUnsupportedVectorIndexFieldType(client.FieldKind_FLOAT_ARRAY).Error(),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCreateVectorIndex_WithUnique_ReturnError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Product {
+						embedding: [Float!] @vector(dimension: 2) @index(type: VECTOR, unique: true)
+					}
+				`,
+				ExpectedError: db.ErrVectorIndexUnique.Error(),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCreateIndex_OnVectorField_ReturnError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Product {
+						embedding: [Float!] @vector(dimension: 2)
+					}
+				`,
+			},
+			testUtils.CreateIndex{
+				FieldName:     "embedding",
+				ExpectedError: db.NewErrUnsupportedIndexFieldType(client.NewVectorKind(2)).Error(),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,431 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+const productsWithFullTextIndexSchema = `
+	type Product {
+		name: String
+		description: String @index(type: FULLTEXT)
+	}
+`
+
+func createProductDocs() []any {
+	return []any{
+		testUtils.CreateDoc{
+			Doc: `{
+				"name": "Sprint",
+				"description": "Red running shoes"
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"name": "Trail",
+				"description": "Blue running shoes for trail running"
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"name": "Beanie",
+				"description": "Red wool hat"
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"name": "Chelsea",
+				"description": "Leather boots"
+			}`,
+		},
+	}
+}
+
+// Both "red" and "shoes" are found in two of the three matched products.
+var redShoesTermWeight = math.Log(1 + 3.0/2)
+
+func TestQueryWithFullTextIndex_WithSearchFilter_ShouldReturnByRelevance(t *testing.T) {
+	req := `query {
+		Product(filter: {description: {_search: "red shoes"}}) {
+			name
+			_score
+		}
+	}`
+	test := testUtils.TestCase{
+		Actions: append(
+			append(
+				[]any{testUtils.SchemaUpdate{Schema: productsWithFullTextIndexSchema}},
+				createProductDocs()...,
+			),
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{
+							"name":   "Sprint",
+							"_score": 1.0/3*redShoesTermWeight + 1.0/3*redShoesTermWeight,
+						},
+						{
+							"name":   "Beanie",
+							"_score": 1.0 / 3 * redShoesTermWeight,
+						},
+						{
+							"name":   "Trail",
+							"_score": 1.0 / 6 * redShoesTermWeight,
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithIndexFetches(4),
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithFullTextIndex_WithOrderByScore_ShouldReturnOrdered(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			append(
+				[]any{testUtils.SchemaUpdate{Schema: productsWithFullTextIndexSchema}},
+				createProductDocs()...,
+			),
+			testUtils.Request{
+				Request: `query {
+					Product(filter: {description: {_search: "red shoes"}}, order: {_score: ASC}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{"name": "Trail"},
+						{"name": "Beanie"},
+						{"name": "Sprint"},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithFullTextIndex_WithSearchFilterAndLimit_ShouldReturnMostRelevant(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			append(
+				[]any{testUtils.SchemaUpdate{Schema: productsWithFullTextIndexSchema}},
+				createProductDocs()...,
+			),
+			testUtils.Request{
+				Request: `query {
+					Product(filter: {description: {_search: "Running TRAIL"}}, limit: 1) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{"name": "Trail"},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithFullTextIndex_WithOtherConditionOnSameField_ShouldFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			append(
+				[]any{testUtils.SchemaUpdate{Schema: productsWithFullTextIndexSchema}},
+				createProductDocs()...,
+			),
+			testUtils.Request{
+				Request: `query {
+					Product(filter: {description: {_search: "shoes", _nlike: "%trail%"}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{"name": "Sprint"},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithFullTextIndex_WithNoMatchingTerm_ShouldReturnNothing(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			append(
+				[]any{testUtils.SchemaUpdate{Schema: productsWithFullTextIndexSchema}},
+				createProductDocs()...,
+			),
+			testUtils.Request{
+				Request: `query {
+					Product(filter: {description: {_search: "run shoe"}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithFullTextIndex_WithSearchInOrFilter_ShouldNotUseIndex(t *testing.T) {
+	req := `query {
+		Product(filter: {_or: [{description: {_search: "hat"}}, {name: {_eq: "Chelsea"}}]}) {
+			name
+			_score
+		}
+	}`
+	test := testUtils.TestCase{
+		Actions: append(
+			append(
+				[]any{testUtils.SchemaUpdate{Schema: productsWithFullTextIndexSchema}},
+				createProductDocs()...,
+			),
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{
+							"name":   "Chelsea",
+							"_score": nil,
+						},
+						{
+							"name":   "Beanie",
+							"_score": nil,
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithIndexFetches(0),
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithFullTextIndex_AfterUpdate_ShouldSearchNewValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			append(
+				[]any{testUtils.SchemaUpdate{Schema: productsWithFullTextIndexSchema}},
+				createProductDocs()...,
+			),
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"description": "Green running shoes"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Product(filter: {description: {_search: "red green"}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{"name": "Sprint"},
+						{"name": "Beanie"},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Product(filter: {description: {_search: "red"}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{"name": "Beanie"},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithFullTextIndex_AfterDelete_ShouldNotReturnDeletedDoc(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			append(
+				[]any{testUtils.SchemaUpdate{Schema: productsWithFullTextIndexSchema}},
+				createProductDocs()...,
+			),
+			testUtils.DeleteDoc{
+				DocID: 0,
+			},
+			testUtils.Request{
+				Request: `query {
+					Product(filter: {description: {_search: "red"}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{"name": "Beanie"},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithFullTextIndex_CreatedOnExistingDocs_ShouldSearch(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			append(
+				[]any{testUtils.SchemaUpdate{
+					Schema: `
+						type Product {
+							name: String
+							description: String
+						}
+					`,
+				}},
+				createProductDocs()...,
+			),
+			testUtils.CreateIndex{
+				FieldName: "description",
+				Type:      client.IndexTypeFullText,
+			},
+			testUtils.GetIndexes{
+				ExpectedIndexes: []client.IndexDescription{
+					{
+						Name: "Product_description_ASC",
+						ID:   1,
+						Fields: []client.IndexedFieldDescription{
+							{Name: "description"},
+						},
+						Type: client.IndexTypeFullText,
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Product(filter: {description: {_search: "boots"}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{"name": "Chelsea"},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithFullTextIndex_WithNilValue_ShouldNotIndex(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{Schema: productsWithFullTextIndexSchema},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Mystery"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Sprint",
+					"description": "Red running shoes"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Product(filter: {description: {_search: "red"}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{"name": "Sprint"},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithSearchFilter_WithoutFullTextIndex_ShouldFilterWithoutScore(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			append(
+				[]any{testUtils.SchemaUpdate{
+					Schema: `
+						type Product {
+							name: String
+							description: String @index
+						}
+					`,
+				}},
+				createProductDocs()...,
+			),
+			testUtils.Request{
+				Request: `query {
+					Product(filter: {description: {_search: "wool boots"}}) {
+						name
+						_score
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{
+							"name":   "Chelsea",
+							"_score": nil,
+						},
+						{
+							"name":   "Beanie",
+							"_score": nil,
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,215 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+// The cursors of the documents created by createCursorTestDocs, ordered by age.
+const (
+	shahzadAgeCursor = "ghR4KGJhZS03ZDI4NzQ1My1kMzZhLTUxODUtOTJjNC0zNjg3ZmQwOWNhNWM"
+	islamAgeCursor   = "ghggeChiYWUtMWNhMWRhYWItNGU1My01Yjg0LTgwN2UtZTM5YjQ2OWU1YWZl"
+	fredAgeCursor    = "ghggeChiYWUtNDBiOWJiMzYtZjMzMi01MjhlLTllMjktNDA2MzIyNmE2NmNj"
+)
+
+func createCursorTestDocs() []any {
+	return []any{
+		testUtils.CreateDoc{
+			Doc: `{"name": "Islam", "age": 32}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{"name": "Shahzad", "age": 20}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{"name": "Fred", "age": 32}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{"name": "Andy", "age": 41}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{"name": "John", "age": 20}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{"name": "Keenan"}`,
+		},
+	}
+}
+
+func TestQueryWithIndex_WithCursorOnIndexedFieldOrder_ShouldSeekIndex(t *testing.T) {
+	req := `query {
+		User(order: {age: ASC}, first: 2, after: "` + shahzadAgeCursor + `") {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test cursor pagination ordered by an indexed field seeks the index to the cursor",
+		Actions: append(
+			[]any{
+				testUtils.SchemaUpdate{
+					Schema: `
+						type User {
+							name: String
+							age: Int @index
+						}`,
+				},
+			},
+			append(
+				createCursorTestDocs(),
+				testUtils.Request{
+					Request: req,
+					Results: map[string]any{
+						"User": []map[string]any{
+							{"name": "John"},
+							{"name": "Islam"},
+						},
+					},
+				},
+				testUtils.Request{
+					// the keys of Shahzad and John have the value of the cursor, the key of Islam
+					// completes the page
+					Request:  makeExplainQuery(req),
+					Asserter: testUtils.NewExplainAsserter().WithIndexFetches(3),
+				},
+			)...,
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithIndex_WithCursorsOnIndexedFieldOrder_ShouldStopAtEndCursor(t *testing.T) {
+	req := `query {
+		User(order: {age: ASC}, after: "` + shahzadAgeCursor + `", before: "` + fredAgeCursor + `") {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test cursor pagination ordered by an indexed field stops at the key of the end cursor",
+		Actions: append(
+			[]any{
+				testUtils.SchemaUpdate{
+					Schema: `
+						type User {
+							name: String
+							age: Int @index
+						}`,
+				},
+			},
+			append(
+				createCursorTestDocs(),
+				testUtils.Request{
+					Request: req,
+					Results: map[string]any{
+						"User": []map[string]any{
+							{"name": "John"},
+							{"name": "Islam"},
+						},
+					},
+				},
+				testUtils.Request{
+					// the key of Andy is after the value of the end cursor
+					Request:  makeExplainQuery(req),
+					Asserter: testUtils.NewExplainAsserter().WithIndexFetches(4),
+				},
+			)...,
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithIndex_WithCursorOnDescIndexedFieldOrder_ShouldSeekIndex(t *testing.T) {
+	req := `query {
+		User(order: {age: DESC}, after: "` + islamAgeCursor + `") {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test cursor pagination ordered by a field indexed in descending order seeks the index",
+		Actions: append(
+			[]any{
+				testUtils.SchemaUpdate{
+					Schema: `
+						type User {
+							name: String
+							age: Int @index(direction: DESC)
+						}`,
+				},
+			},
+			append(
+				createCursorTestDocs(),
+				testUtils.Request{
+					Request: req,
+					Results: map[string]any{
+						"User": []map[string]any{
+							{"name": "Fred"},
+							{"name": "Shahzad"},
+							{"name": "John"},
+							{"name": "Keenan"},
+						},
+					},
+				},
+				testUtils.Request{
+					// the key of Andy is before the value of the cursor
+					Request:  makeExplainQuery(req),
+					Asserter: testUtils.NewExplainAsserter().WithIndexFetches(5),
+				},
+			)...,
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithIndex_WithCursorOnFieldIndexedInOtherDirection_ShouldNotUseIndex(t *testing.T) {
+	req := `query {
+		User(order: {age: DESC}, after: "` + islamAgeCursor + `") {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test cursor pagination ordered by a field indexed in the other direction orders the documents",
+		Actions: append(
+			[]any{
+				testUtils.SchemaUpdate{
+					Schema: `
+						type User {
+							name: String
+							age: Int @index
+						}`,
+				},
+			},
+			append(
+				createCursorTestDocs(),
+				testUtils.Request{
+					Request: req,
+					Results: map[string]any{
+						"User": []map[string]any{
+							{"name": "Fred"},
+							{"name": "Shahzad"},
+							{"name": "John"},
+							{"name": "Keenan"},
+						},
+					},
+				},
+				testUtils.Request{
+					Request:  makeExplainQuery(req),
+					Asserter: testUtils.NewExplainAsserter().WithIndexFetches(0),
+				},
+			)...,
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,251 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func createDistinctTestDocs() []any {
+	return []any{
+		testUtils.CreateDoc{
+			Doc: `{"name": "Islam", "age": 32, "city": "Berlin"}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{"name": "Shahzad", "age": 20, "city": "Vienna"}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{"name": "Fred", "age": 32, "city": "Berlin"}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{"name": "Andy", "age": 41, "city": "Amsterdam"}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{"name": "John", "age": 20, "city": "Vienna"}`,
+		},
+	}
+}
+
+func TestQueryWithIndex_WithDistinctOnIndexedField_ShouldScanIndexOnly(t *testing.T) {
+	req := `query {
+		User(distinct: [city]) {
+			city
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test distinct on an indexed field reads the index keys only",
+		Actions: append(
+			[]any{
+				testUtils.SchemaUpdate{
+					Schema: `
+						type User {
+							name: String
+							age: Int
+							city: String @index
+						}`,
+				},
+			},
+			append(
+				createDistinctTestDocs(),
+				testUtils.Request{
+					Request: req,
+					Results: map[string]any{
+						"User": []map[string]any{
+							{"city": "Amsterdam"},
+							{"city": "Berlin"},
+							{"city": "Vienna"},
+						},
+					},
+				},
+				testUtils.Request{
+					Request:  makeExplainQuery(req),
+					Asserter: testUtils.NewExplainAsserter().WithFieldFetches(0).WithIndexFetches(5),
+				},
+			)...,
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithIndex_WithDistinctOnIndexedFieldAndNonIndexedFieldSelected_ShouldFetchDocs(t *testing.T) {
+	req := `query {
+		User(distinct: [city]) {
+			city
+			age
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test distinct on an indexed field with a non-indexed field selected fetches the documents",
+		Actions: append(
+			[]any{
+				testUtils.SchemaUpdate{
+					Schema: `
+						type User {
+							name: String
+							age: Int
+							city: String @index
+						}`,
+				},
+			},
+			append(
+				createDistinctTestDocs(),
+				testUtils.Request{
+					Request: req,
+					Results: map[string]any{
+						"User": []map[string]any{
+							{"city": "Vienna", "age": int64(20)},
+							{"city": "Amsterdam", "age": int64(41)},
+							{"city": "Berlin", "age": int64(32)},
+						},
+					},
+				},
+				testUtils.Request{
+					Request:  makeExplainQuery(req),
+					Asserter: testUtils.NewExplainAsserter().WithFieldFetches(10).WithIndexFetches(0),
+				},
+			)...,
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithCompositeIndex_WithDistinctOnIndexedFields_ShouldScanIndexOnly(t *testing.T) {
+	req := `query {
+		User(distinct: [city, age]) {
+			city
+			age
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test distinct on the fields of a composite index reads the index keys only",
+		Actions: append(
+			[]any{
+				testUtils.SchemaUpdate{
+					Schema: `
+						type User @index(includes: [{field: "city"}, {field: "age"}]) {
+							name: String
+							age: Int
+							city: String
+						}`,
+				},
+			},
+			append(
+				createDistinctTestDocs(),
+				testUtils.CreateDoc{
+					Doc: `{"name": "Keenan", "age": 41, "city": "Berlin"}`,
+				},
+				testUtils.Request{
+					Request: req,
+					Results: map[string]any{
+						"User": []map[string]any{
+							{"city": "Amsterdam", "age": int64(41)},
+							{"city": "Berlin", "age": int64(32)},
+							{"city": "Berlin", "age": int64(41)},
+							{"city": "Vienna", "age": int64(20)},
+						},
+					},
+				},
+				testUtils.Request{
+					Request:  makeExplainQuery(req),
+					Asserter: testUtils.NewExplainAsserter().WithFieldFetches(0).WithIndexFetches(6),
+				},
+			)...,
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithIndex_WithDistinctAndFilterOnIndexedField_ShouldScanIndexOnly(t *testing.T) {
+	req := `query {
+		User(distinct: [city], filter: {city: {_in: ["Berlin", "Vienna"]}}) {
+			city
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test distinct with a filter on the indexed field reads the index keys only",
+		Actions: append(
+			[]any{
+				testUtils.SchemaUpdate{
+					Schema: `
+						type User {
+							name: String
+							age: Int
+							city: String @index
+						}`,
+				},
+			},
+			append(
+				createDistinctTestDocs(),
+				testUtils.Request{
+					Request: req,
+					Results: map[string]any{
+						"User": []map[string]any{
+							{"city": "Berlin"},
+							{"city": "Vienna"},
+						},
+					},
+				},
+				testUtils.Request{
+					Request:  makeExplainQuery(req),
+					Asserter: testUtils.NewExplainAsserter().WithFieldFetches(0).WithIndexFetches(4),
+				},
+			)...,
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithIndex_WithDistinctAndFilterNotServedByIndex_ShouldFilterDocs(t *testing.T) {
+	req := `query {
+		User(distinct: [city], filter: {_or: [{city: {_eq: "Berlin"}}, {city: {_eq: "Vienna"}}]}) {
+			city
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test distinct with a filter the index does not serve fetches and filters the documents",
+		Actions: append(
+			[]any{
+				testUtils.SchemaUpdate{
+					Schema: `
+						type User {
+							name: String
+							age: Int
+							city: String @index
+						}`,
+				},
+			},
+			append(
+				createDistinctTestDocs(),
+				testUtils.Request{
+					Request: req,
+					Results: map[string]any{
+						"User": []map[string]any{
+							{"city": "Vienna"},
+							{"city": "Berlin"},
+						},
+					},
+				},
+				testUtils.Request{
+					Request:  makeExplainQuery(req),
+					Asserter: testUtils.NewExplainAsserter().WithFieldFetches(5).WithIndexFetches(0),
+				},
+			)...,
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
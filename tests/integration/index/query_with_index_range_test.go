@@ -0,0 +1,259 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryWithIndex_WithGreaterAndLessThanFilter_ShouldOnlyFetchKeysWithinRange(t *testing.T) {
+	req := `query {
+		User(filter: {age: {_gt: 28, _lt: 42}}) {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test index filtering with _gt and _lt filters on the same field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int @index
+					}`,
+			},
+			testUtils.CreatePredefinedDocs{
+				Docs: getUserDocs(),
+			},
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"User": []map[string]any{
+						{"name": "John"},
+						{"name": "Islam"},
+						{"name": "Andy"},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithFieldFetches(3).WithIndexFetches(3),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithIndex_WithOverlappingRangeFilters_ShouldUseTightestBounds(t *testing.T) {
+	req := `query {
+		User(filter: {age: {_ge: 30, _gt: 30, _le: 42, _lt: 50}}) {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test index filtering with several range filters on the same field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int @index
+					}`,
+			},
+			testUtils.CreatePredefinedDocs{
+				Docs: getUserDocs(),
+			},
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"User": []map[string]any{
+						{"name": "Islam"},
+						{"name": "Andy"},
+						{"name": "Addo"},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithFieldFetches(3).WithIndexFetches(3),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithDescendingIndex_WithRangeFilter_ShouldOnlyFetchKeysWithinRange(t *testing.T) {
+	req := `query {
+		User(filter: {age: {_ge: 42, _le: 48}}) {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test descending index filtering with _ge and _le filters",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int @index(direction: DESC)
+					}`,
+			},
+			testUtils.CreatePredefinedDocs{
+				Docs: getUserDocs(),
+			},
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"User": []map[string]any{
+						{"name": "Keenan"},
+						{"name": "Roy"},
+						{"name": "Addo"},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithFieldFetches(3).WithIndexFetches(3),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithIndex_WithLessThanFilterAndNilValues_ShouldNotFetchNilKeys(t *testing.T) {
+	req := `query {
+		User(filter: {age: {_lt: 30}}) {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test index filtering with _lt filter skips the keys of nil values",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int @index
+					}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Alice"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Bob",
+					"age": 25
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Carol",
+					"age": 35
+				}`,
+			},
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"User": []map[string]any{
+						{"name": "Bob"},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithFieldFetches(1).WithIndexFetches(1),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithCompositeIndex_WithEqualFilterAndRangeFilterOnSecondField_ShouldOnlyFetchKeysWithinRange(t *testing.T) {
+	req := `query {
+		Event(filter: {
+			kind: {_eq: "login"},
+			at: {_ge: "2024-02-01T00:00:00Z", _lt: "2024-04-01T00:00:00Z"}
+		}) {
+			user
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test composite index filtering with _eq filter on the first field and a time range on the second",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Event @index(includes: [{field: "kind"}, {field: "at"}]) {
+						kind: String
+						at: DateTime
+						user: String
+					}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"kind": "login",
+					"at": "2024-01-15T00:00:00Z",
+					"user": "Alice"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"kind": "login",
+					"at": "2024-02-01T00:00:00Z",
+					"user": "Bob"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"kind": "login",
+					"at": "2024-03-10T00:00:00Z",
+					"user": "Carol"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"kind": "login",
+					"at": "2024-04-01T00:00:00Z",
+					"user": "Dave"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"kind": "logout",
+					"at": "2024-03-01T00:00:00Z",
+					"user": "Alice"
+				}`,
+			},
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"Event": []map[string]any{
+						{"user": "Bob"},
+						{"user": "Carol"},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithFieldFetches(2).WithIndexFetches(2),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
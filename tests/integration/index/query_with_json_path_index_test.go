@@ -0,0 +1,486 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/db"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func getOrderWithMetaDocs() []any {
+	return []any{
+		testUtils.CreateDoc{
+			Doc: `{
+				"number": 1,
+				"meta": {"customerId": 10, "channel": "web"}
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"number": 2,
+				"meta": {"customerId": 20, "channel": "store"}
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"number": 3,
+				"meta": {"customerId": 10.5, "channel": "web"}
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"number": 4,
+				"meta": {"customerId": "10"}
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"number": 5,
+				"meta": {"channel": "web"}
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"number": 6
+			}`,
+		},
+	}
+}
+
+func TestQueryWithJSONPathIndex_WithEqualFilter_ShouldUseIndex(t *testing.T) {
+	req := `query {
+		Order(filter: {meta: {customerId: {_eq: 10}}}) {
+			number
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test filtering on a path inside a JSON field uses the index on that path",
+		Actions: append(
+			[]any{
+				testUtils.SchemaUpdate{
+					Schema: `
+						type Order {
+							number: Int
+							meta: JSON @index(includes: [{field: "meta.customerId"}])
+						}`,
+				},
+			},
+			append(
+				getOrderWithMetaDocs(),
+				testUtils.Request{
+					Request: req,
+					Results: map[string]any{
+						"Order": []map[string]any{
+							{"number": int64(1)},
+						},
+					},
+				},
+				testUtils.Request{
+					Request:  makeExplainQuery(req),
+					Asserter: testUtils.NewExplainAsserter().WithIndexFetches(1),
+				},
+			)...,
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithJSONPathIndex_WithStringFilter_ShouldUseIndex(t *testing.T) {
+	req := `query {
+		Order(filter: {meta: {customerId: {_eq: "10"}}}) {
+			number
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test filtering on a string at a path inside a JSON field uses the index on that path",
+		Actions: append(
+			[]any{
+				testUtils.SchemaUpdate{
+					Schema: `
+						type Order @index(includes: [{field: "meta.customerId"}]) {
+							number: Int
+							meta: JSON
+						}`,
+				},
+			},
+			append(
+				getOrderWithMetaDocs(),
+				testUtils.Request{
+					Request: req,
+					Results: map[string]any{
+						"Order": []map[string]any{
+							{"number": int64(4)},
+						},
+					},
+				},
+				testUtils.Request{
+					Request:  makeExplainQuery(req),
+					Asserter: testUtils.NewExplainAsserter().WithIndexFetches(1),
+				},
+			)...,
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithJSONPathIndex_WithRangeFilter_ShouldUseIndex(t *testing.T) {
+	req := `query {
+		Order(filter: {meta: {customerId: {_gt: 10}}}) {
+			number
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test range filter on a path inside a JSON field only reads the index keys of the range",
+		Actions: append(
+			[]any{
+				testUtils.SchemaUpdate{
+					Schema: `
+						type Order {
+							number: Int
+							meta: JSON @index(includes: [{field: "meta.customerId"}])
+						}`,
+				},
+			},
+			append(
+				getOrderWithMetaDocs(),
+				testUtils.Request{
+					Request: req,
+					Results: map[string]any{
+						"Order": []map[string]any{
+							{"number": int64(3)},
+							{"number": int64(2)},
+						},
+					},
+				},
+				testUtils.Request{
+					Request: makeExplainQuery(req),
+					// the string value is not compared with the number by the index, so its
+					// document is fetched and filtered out
+					Asserter: testUtils.NewExplainAsserter().WithIndexFetches(3),
+				},
+			)...,
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithJSONPathIndex_WithNilFilter_ShouldUseIndex(t *testing.T) {
+	req := `query {
+		Order(filter: {meta: {customerId: {_eq: null}}}, order: {number: ASC}) {
+			number
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test filtering on a missing value at a path inside a JSON field uses the index on that path",
+		Actions: append(
+			[]any{
+				testUtils.SchemaUpdate{
+					Schema: `
+						type Order {
+							number: Int
+							meta: JSON @index(includes: [{field: "meta.customerId"}])
+						}`,
+				},
+			},
+			append(
+				getOrderWithMetaDocs(),
+				testUtils.Request{
+					Request: req,
+					Results: map[string]any{
+						"Order": []map[string]any{
+							{"number": int64(5)},
+							{"number": int64(6)},
+						},
+					},
+				},
+				testUtils.Request{
+					Request:  makeExplainQuery(req),
+					Asserter: testUtils.NewExplainAsserter().WithIndexFetches(2),
+				},
+			)...,
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithJSONPathIndex_WithFilterOnOtherPath_ShouldNotUseIndex(t *testing.T) {
+	req := `query {
+		Order(filter: {meta: {channel: {_eq: "store"}}}) {
+			number
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test filtering on another path inside a JSON field does not use the index",
+		Actions: append(
+			[]any{
+				testUtils.SchemaUpdate{
+					Schema: `
+						type Order {
+							number: Int
+							meta: JSON @index(includes: [{field: "meta.customerId"}])
+						}`,
+				},
+			},
+			append(
+				getOrderWithMetaDocs(),
+				testUtils.Request{
+					Request: req,
+					Results: map[string]any{
+						"Order": []map[string]any{
+							{"number": int64(2)},
+						},
+					},
+				},
+				testUtils.Request{
+					Request:  makeExplainQuery(req),
+					Asserter: testUtils.NewExplainAsserter().WithIndexFetches(0),
+				},
+			)...,
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithJSONPathIndex_WithNestedPath_ShouldUseIndex(t *testing.T) {
+	req := `query {
+		Order(filter: {meta: {customer: {id: {_in: [1, 3]}}}}) {
+			number
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test filtering on a nested path inside a JSON field uses the index on that path",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Order {
+						number: Int
+						meta: JSON @index(includes: [{field: "meta.customer.id"}])
+					}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"number": 1,
+					"meta": {"customer": {"id": 1}}
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"number": 2,
+					"meta": {"customer": {"id": 2}}
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"number": 3,
+					"meta": {"customer": {"id": 3}}
+				}`,
+			},
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"Order": []map[string]any{
+						{"number": int64(1)},
+						{"number": int64(3)},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithIndexFetches(2),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithJSONPathIndex_AfterUpdateAndDelete_ShouldReturnMatchingDocs(t *testing.T) {
+	req := `query {
+		Order(filter: {meta: {customerId: {_eq: 10}}}) {
+			number
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test index on a path inside a JSON field is kept up to date",
+		Actions: append(
+			[]any{
+				testUtils.SchemaUpdate{
+					Schema: `
+						type Order {
+							number: Int
+							meta: JSON @index(includes: [{field: "meta.customerId"}])
+						}`,
+				},
+			},
+			append(
+				getOrderWithMetaDocs(),
+				testUtils.UpdateDoc{
+					DocID: 0,
+					Doc: `{
+						"meta": {"customerId": 30}
+					}`,
+				},
+				testUtils.UpdateDoc{
+					DocID: 1,
+					Doc: `{
+						"meta": {"customerId": 10, "channel": "store"}
+					}`,
+				},
+				testUtils.UpdateDoc{
+					DocID: 4,
+					Doc: `{
+						"meta": {"customerId": 10}
+					}`,
+				},
+				testUtils.DeleteDoc{
+					DocID: 4,
+				},
+				testUtils.Request{
+					Request: req,
+					Results: map[string]any{
+						"Order": []map[string]any{
+							{"number": int64(2)},
+						},
+					},
+				},
+				testUtils.Request{
+					Request:  makeExplainQuery(req),
+					Asserter: testUtils.NewExplainAsserter().WithIndexFetches(1),
+				},
+			)...,
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithJSONPathIndex_CreatedOnExistingDocs_ShouldUseIndex(t *testing.T) {
+	req := `query {
+		Order(filter: {meta: {customerId: {_eq: 20}}}) {
+			number
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test index on a path inside a JSON field created on existing documents",
+		Actions: append(
+			append(
+				[]any{
+					testUtils.SchemaUpdate{
+						Schema: `
+							type Order {
+								number: Int
+								meta: JSON
+							}`,
+					},
+				},
+				getOrderWithMetaDocs()...,
+			),
+			testUtils.CreateIndex{
+				FieldName: "meta.customerId",
+			},
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"Order": []map[string]any{
+						{"number": int64(2)},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithIndexFetches(1),
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithUniqueJSONPathIndex_WithDuplicateValue_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test unique index on a path inside a JSON field rejects duplicate values",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Order {
+						number: Int
+						meta: JSON @index(unique: true, includes: [{field: "meta.customerId"}])
+					}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"number": 1,
+					"meta": {"customerId": 10}
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"number": 2,
+					"meta": {"customerId": 20}
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 1,
+				Doc: `{
+					"meta": {"customerId": 10}
+				}`,
+				ExpectedError: "can not index a doc's field(s) that violates unique index",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCreateJSONPathIndex_OnNonJSONField_ReturnError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Order {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateIndex{
+				FieldName:     "name.first",
+				ExpectedError: db.NewErrIndexPathOnNonJSONField("name.first", client.FieldKind_NILLABLE_STRING).Error(),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCreateJSONPathIndex_WithFullTextType_ReturnError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Order {
+						meta: JSON @index(type: FULLTEXT, includes: [{field: "meta.notes"}])
+					}
+				`,
+				ExpectedError: db.ErrIndexPathNotValueType.Error(),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
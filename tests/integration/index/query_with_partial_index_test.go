@@ -0,0 +1,331 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func getOrderDocs() []any {
+	return []any{
+		testUtils.CreateDoc{
+			Doc: `{
+				"customer": "Alice",
+				"status": "open",
+				"amount": 10
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"customer": "Bob",
+				"status": "open",
+				"amount": 20
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"customer": "Alice",
+				"status": "archived",
+				"amount": 30
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"customer": "Carol",
+				"status": "archived",
+				"amount": 40
+			}`,
+		},
+	}
+}
+
+func TestQueryWithPartialIndex_WithFilterImplyingIndexFilter_ShouldUseIndex(t *testing.T) {
+	req := `query {
+		Order(filter: {customer: {_eq: "Alice"}, status: {_eq: "open"}}) {
+			amount
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test partial index is used if the query filter implies the index filter",
+		Actions: append(
+			[]any{
+				testUtils.SchemaUpdate{
+					Schema: `
+						type Order {
+							customer: String @index(filter: {status: {_eq: "open"}})
+							status: String
+							amount: Int
+						}`,
+				},
+			},
+			append(
+				getOrderDocs(),
+				testUtils.Request{
+					Request: req,
+					Results: map[string]any{
+						"Order": []map[string]any{
+							{"amount": int64(10)},
+						},
+					},
+				},
+				testUtils.Request{
+					Request:  makeExplainQuery(req),
+					Asserter: testUtils.NewExplainAsserter().WithIndexFetches(1),
+				},
+			)...,
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithPartialIndex_WithFilterNotImplyingIndexFilter_ShouldNotUseIndex(t *testing.T) {
+	req := `query {
+		Order(filter: {customer: {_eq: "Alice"}}) {
+			amount
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test partial index is not used if the query filter does not imply the index filter",
+		Actions: append(
+			[]any{
+				testUtils.SchemaUpdate{
+					Schema: `
+						type Order {
+							customer: String @index(filter: {status: {_eq: "open"}})
+							status: String
+							amount: Int
+						}`,
+				},
+			},
+			append(
+				getOrderDocs(),
+				testUtils.Request{
+					Request: req,
+					Results: map[string]any{
+						"Order": []map[string]any{
+							{"amount": int64(30)},
+							{"amount": int64(10)},
+						},
+					},
+				},
+				testUtils.Request{
+					Request:  makeExplainQuery(req),
+					Asserter: testUtils.NewExplainAsserter().WithIndexFetches(0),
+				},
+			)...,
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithPartialIndex_WithRangeFilterImplyingIndexRange_ShouldUseIndex(t *testing.T) {
+	req := `query {
+		Order(filter: {amount: {_gt: 25}}) {
+			customer
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test partial index is used if the query range is within the range of the index filter",
+		Actions: append(
+			[]any{
+				testUtils.SchemaUpdate{
+					Schema: `
+						type Order {
+							customer: String
+							status: String
+							amount: Int @index(filter: {amount: {_ge: 15}})
+						}`,
+				},
+			},
+			append(
+				getOrderDocs(),
+				testUtils.Request{
+					Request: req,
+					Results: map[string]any{
+						"Order": []map[string]any{
+							{"customer": "Alice"},
+							{"customer": "Carol"},
+						},
+					},
+				},
+				testUtils.Request{
+					Request:  makeExplainQuery(req),
+					Asserter: testUtils.NewExplainAsserter().WithIndexFetches(2),
+				},
+			)...,
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithPartialIndex_AfterUpdatesMovingDocsInAndOut_ShouldReturnMatchingDocs(t *testing.T) {
+	req := `query {
+		Order(filter: {customer: {_eq: "Alice"}, status: {_eq: "open"}}) {
+			amount
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test partial index is updated when documents start or stop matching its filter",
+		Actions: append(
+			[]any{
+				testUtils.SchemaUpdate{
+					Schema: `
+						type Order {
+							customer: String @index(filter: {status: {_eq: "open"}})
+							status: String
+							amount: Int
+						}`,
+				},
+			},
+			append(
+				getOrderDocs(),
+				testUtils.UpdateDoc{
+					DocID: 0,
+					Doc: `{
+						"status": "archived"
+					}`,
+				},
+				testUtils.UpdateDoc{
+					DocID: 2,
+					Doc: `{
+						"status": "open"
+					}`,
+				},
+				testUtils.Request{
+					Request: req,
+					Results: map[string]any{
+						"Order": []map[string]any{
+							{"amount": int64(30)},
+						},
+					},
+				},
+				testUtils.Request{
+					Request:  makeExplainQuery(req),
+					Asserter: testUtils.NewExplainAsserter().WithIndexFetches(1),
+				},
+				testUtils.DeleteDoc{
+					DocID: 2,
+				},
+				testUtils.Request{
+					Request: req,
+					Results: map[string]any{
+						"Order": []map[string]any{},
+					},
+				},
+				testUtils.Request{
+					Request:  makeExplainQuery(req),
+					Asserter: testUtils.NewExplainAsserter().WithIndexFetches(0),
+				},
+			)...,
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithPartialIndex_CreatedOnExistingDocs_ShouldOnlyIndexMatchingDocs(t *testing.T) {
+	req := `query {
+		Order(filter: {customer: {_in: ["Alice", "Carol"]}, status: {_eq: "archived"}}) {
+			amount
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test partial index created on existing documents only indexes the matching ones",
+		Actions: append(
+			append(
+				[]any{
+					testUtils.SchemaUpdate{
+						Schema: `
+							type Order {
+								customer: String
+								status: String
+								amount: Int
+							}`,
+					},
+				},
+				getOrderDocs()...,
+			),
+			testUtils.CreateIndex{
+				FieldName: "customer",
+				Filter: map[string]any{
+					"status": map[string]any{"_ne": "open"},
+				},
+			},
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"Order": []map[string]any{
+						{"amount": int64(30)},
+						{"amount": int64(40)},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithIndexFetches(2),
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithPartialUniqueIndex_WithDuplicatesOutsideOfFilter_ShouldNotError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test partial unique index only enforces uniqueness of the documents matching its filter",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Order {
+						customer: String @index(unique: true, filter: {status: {_eq: "open"}})
+						status: String
+						amount: Int
+					}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"customer": "Alice",
+					"status": "archived",
+					"amount": 10
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"customer": "Alice",
+					"status": "archived",
+					"amount": 20
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"customer": "Alice",
+					"status": "open",
+					"amount": 30
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"customer": "Alice",
+					"status": "open",
+					"amount": 40
+				}`,
+				ExpectedError: "can not index a doc's field(s) that violates unique index",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,325 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+const productsWithVectorIndexSchema = `
+	type Product {
+		name: String
+		embedding: [Float!] @vector(dimension: 2) @index(type: VECTOR)
+	}
+`
+
+// createProductVectorDocs returns the actions creating the products queried by the vector
+// index tests.
+//
+// The distances of the embeddings to [1, 0] are 0 for Sprint, 1 for Trail, 5 for Beanie
+// and 2 for Chelsea.
+func createProductVectorDocs() []any {
+	return []any{
+		testUtils.CreateDoc{
+			Doc: `{
+				"name": "Sprint",
+				"embedding": [1, 0]
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"name": "Trail",
+				"embedding": [1, 1]
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"name": "Beanie",
+				"embedding": [4, 4]
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"name": "Chelsea",
+				"embedding": [-1, 0]
+			}`,
+		},
+	}
+}
+
+func TestQueryWithVectorIndex_WithSimilar_ShouldReturnNearest(t *testing.T) {
+	req := `query {
+		Product(_similar: {field: "embedding", vector: [1, 0], k: 2}) {
+			name
+			_distance
+		}
+	}`
+	test := testUtils.TestCase{
+		Actions: append(
+			append(
+				[]any{testUtils.SchemaUpdate{Schema: productsWithVectorIndexSchema}},
+				createProductVectorDocs()...,
+			),
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{"name": "Sprint", "_distance": float64(0)},
+						{"name": "Trail", "_distance": float64(1)},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithIndexFetches(2),
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithVectorIndex_WithFilter_ShouldSearchFurtherForMatchingDocs(t *testing.T) {
+	req := `query {
+		Product(
+			_similar: {field: "embedding", vector: [1, 0], k: 2},
+			filter: {name: {_ne: "Sprint"}}
+		) {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Actions: append(
+			append(
+				[]any{testUtils.SchemaUpdate{Schema: productsWithVectorIndexSchema}},
+				createProductVectorDocs()...,
+			),
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{"name": "Trail"},
+						{"name": "Chelsea"},
+					},
+				},
+			},
+			testUtils.Request{
+				// Sprint and Trail are found by the first search, Chelsea by the second one.
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithIndexFetches(3),
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithVectorIndex_WithOrderByDistanceDesc_ShouldReturnFarthestFirst(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			append(
+				[]any{testUtils.SchemaUpdate{Schema: productsWithVectorIndexSchema}},
+				createProductVectorDocs()...,
+			),
+			testUtils.Request{
+				Request: `query {
+					Product(_similar: {field: "embedding", vector: [1, 0], k: 3}, order: {_distance: DESC}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{"name": "Chelsea"},
+						{"name": "Trail"},
+						{"name": "Sprint"},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithVectorIndex_AfterUpdate_ShouldSearchNewValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			append(
+				[]any{testUtils.SchemaUpdate{Schema: productsWithVectorIndexSchema}},
+				createProductVectorDocs()...,
+			),
+			testUtils.UpdateDoc{
+				DocID: 2,
+				Doc: `{
+					"embedding": [1, 0.5]
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Product(_similar: {field: "embedding", vector: [1, 0], k: 2}) {
+						name
+						_distance
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{"name": "Sprint", "_distance": float64(0)},
+						{"name": "Beanie", "_distance": 0.5},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithVectorIndex_AfterDelete_ShouldNotReturnDeletedDoc(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			append(
+				[]any{testUtils.SchemaUpdate{Schema: productsWithVectorIndexSchema}},
+				createProductVectorDocs()...,
+			),
+			testUtils.DeleteDoc{
+				DocID: 0,
+			},
+			testUtils.Request{
+				Request: `query {
+					Product(_similar: {field: "embedding", vector: [1, 0], k: 2}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{"name": "Trail"},
+						{"name": "Chelsea"},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithVectorIndex_WithNilVector_ShouldNotIndex(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			append(
+				[]any{testUtils.SchemaUpdate{Schema: productsWithVectorIndexSchema}},
+				createProductVectorDocs()...,
+			),
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Gift card"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Product(_similar: {field: "embedding", vector: [1, 0], k: 10}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{"name": "Sprint"},
+						{"name": "Trail"},
+						{"name": "Chelsea"},
+						{"name": "Beanie"},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithVectorIndex_CreatedOnExistingDocs_ShouldSearch(t *testing.T) {
+	req := `query {
+		Product(_similar: {field: "embedding", vector: [1, 0], k: 2}) {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Actions: append(
+			append(
+				[]any{testUtils.SchemaUpdate{
+					Schema: `
+						type Product {
+							name: String
+							embedding: [Float!] @vector(dimension: 2)
+						}
+					`,
+				}},
+				createProductVectorDocs()...,
+			),
+			testUtils.CreateIndex{
+				FieldName: "embedding",
+				Type:      client.IndexTypeVector,
+			},
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{"name": "Sprint"},
+						{"name": "Trail"},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithIndexFetches(2),
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithVectorIndex_AfterIndexDropped_ShouldSearchWithoutIndex(t *testing.T) {
+	req := `query {
+		Product(_similar: {field: "embedding", vector: [1, 0], k: 2}) {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Actions: append(
+			append(
+				[]any{testUtils.SchemaUpdate{Schema: productsWithVectorIndexSchema}},
+				createProductVectorDocs()...,
+			),
+			testUtils.DropIndex{
+				CollectionID: 0,
+				IndexID:      0,
+			},
+			testUtils.Request{
+				Request: req,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{"name": "Sprint"},
+						{"name": "Trail"},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithIndexFetches(0),
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,201 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/internal/db"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestVerifyIndexes_WithConsistentIndexesOfAllTypes_ShouldReportNothing(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Verifying indexes kept up to date by writes reports no inconsistency",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User @index(name: "a_byNameAndAge", direction: DESC, includes: [{field: "name"}, {field: "age"}]) {
+						name: String
+						email: String @index(name: "b_byEmail", unique: true)
+						age: Int @index(name: "c_adultsByAge", filter: {age: {_ge: 18}})
+						hobbies: [String!] @index(name: "d_byHobbies")
+						bio: String @index(name: "e_byBio", type: FULLTEXT)
+						location: GeoPoint @index(name: "f_byLocation", type: GEOHASH)
+						meta: JSON @index(name: "g_byCustomer", includes: [{field: "meta.customerId"}])
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"email": "john@example.com",
+					"age":	21,
+					"hobbies": ["chess", "climbing"],
+					"bio": "Plays chess every day",
+					"location": {"lat": 48.8566, "lon": 2.3522},
+					"meta": {"customerId": 10}
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"Andy",
+					"age":	15,
+					"hobbies": ["chess"],
+					"meta": {"tags": ["new"]}
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"Shahzad",
+					"email": "shahzad@example.com",
+					"age":	30,
+					"hobbies": ["hiking"],
+					"bio": "Climbs mountains",
+					"location": {"lat": 51.5074, "lon": -0.1278},
+					"meta": {"customerId": "abc"}
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 1,
+				Doc: `{
+					"age":	19,
+					"hobbies": ["chess", "running"],
+					"bio": "Runs a lot"
+				}`,
+			},
+			testUtils.DeleteDoc{
+				DocID: 2,
+			},
+			testUtils.VerifyIndexes{
+				ExpectedResults: []client.IndexVerifyResult{
+					{IndexName: "a_byNameAndAge", DocsChecked: 2},
+					{IndexName: "b_byEmail", DocsChecked: 2},
+					{IndexName: "c_adultsByAge", DocsChecked: 2},
+					{IndexName: "d_byHobbies", DocsChecked: 2},
+					{IndexName: "e_byBio", DocsChecked: 2},
+					{IndexName: "f_byLocation", DocsChecked: 2},
+					{IndexName: "g_byCustomer", DocsChecked: 2},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestVerifyIndexes_WithIndexNameAndRepair_ShouldOnlyVerifyThatIndex(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Verifying a single index only reports on that index",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String @index(name: "byName")
+						age: Int @index(name: "byAge")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age":	21
+				}`,
+			},
+			testUtils.VerifyIndexes{
+				IndexName: "byAge",
+				Repair:    true,
+				ExpectedResults: []client.IndexVerifyResult{
+					{IndexName: "byAge", DocsChecked: 1},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestVerifyIndexes_IfIndexDoesNotExist_ReturnError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Verifying a non-existing index returns an error",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String @index
+					}
+				`,
+			},
+			testUtils.VerifyIndexes{
+				IndexName:     "non_existing",
+				ExpectedError: db.NewErrIndexWithNameDoesNotExists("non_existing").Error(),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestVerifyIndexes_WithVectorIndex_ShouldSkipIt(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Verifying all indexes reports the vector index as skipped and verifies the others",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Product {
+						name: String @index(name: "byName")
+						embedding: [Float!] @vector(dimension: 2) @index(name: "byEmbedding", type: VECTOR)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"Chair",
+					"embedding": [0.5, 0.5]
+				}`,
+			},
+			testUtils.VerifyIndexes{
+				ExpectedResults: []client.IndexVerifyResult{
+					{
+						IndexName:  "byEmbedding",
+						Skipped:    true,
+						SkipReason: db.NewErrCanNotVerifyIndexType("byEmbedding", client.IndexTypeVector).Error(),
+					},
+					{IndexName: "byName", DocsChecked: 1},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestVerifyIndexes_OnVectorIndexByName_ReturnError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Verifying a vector index by name returns an error",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Product {
+						embedding: [Float!] @vector(dimension: 2) @index(name: "byEmbedding", type: VECTOR)
+					}
+				`,
+			},
+			testUtils.VerifyIndexes{
+				IndexName:     "byEmbedding",
+				ExpectedError: db.NewErrCanNotVerifyIndexType("byEmbedding", client.IndexTypeVector).Error(),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package field_kinds
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationCreate_WithGeoPoint_NoError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Shop {
+						location: GeoPoint
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"location": {"lat": 48.8566, "lon": 2.3522}
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Shop {
+						location
+					}
+				}`,
+				Results: map[string]any{
+					"Shop": []map[string]any{
+						{"location": client.GeoPoint{Lat: 48.8566, Lon: 2.3522}},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithNullGeoPoint_NoError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Shop {
+						location: GeoPoint
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"location": null
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Shop {
+						location
+					}
+				}`,
+				Results: map[string]any{
+					"Shop": []map[string]any{
+						{"location": nil},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithGeoPointOutOfRange_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Shop {
+						location: GeoPoint
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"location": {"lat": 91, "lon": 2.3522}
+				}`,
+				ExpectedError: client.NewErrInvalidGeoPoint(91, 2.3522).Error(),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package field_kinds
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationCreate_WithVector_NoError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Product {
+						embedding: [Float!] @vector(dimension: 2)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"embedding": [0.5, -1]
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Product {
+						embedding
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{"embedding": []float64{0.5, -1}},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithVectorOfWrongDimension_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Product {
+						embedding: [Float!] @vector(dimension: 2)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"embedding": [1, 2, 3]
+				}`,
+				ExpectedError: client.NewErrVectorDimensionMismatch("embedding", 2, 3).Error(),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
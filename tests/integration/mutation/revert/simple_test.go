@@ -0,0 +1,269 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package revert
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationRevert_ToFirstVersion(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Revert a document to its first version",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"name": "Fred",
+					"age": 33
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					revert_Users(
+						docID: "bae-0a3c95e8-7df7-5d12-8448-1891cd671b4d",
+						cid: "bafyreigr3imqykrjcn3mr72f5igr6gekfkf37mq7bdigu4wgnuobooy6bq"
+					) {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"revert_Users": []map[string]any{
+						{
+							"name": "John",
+							"age":  nil,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationRevert_KeepsHistory(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Revert a document is written as a new version",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"name": "Fred"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					revert_Users(
+						docID: "bae-0a3c95e8-7df7-5d12-8448-1891cd671b4d",
+						cid: "bafyreigr3imqykrjcn3mr72f5igr6gekfkf37mq7bdigu4wgnuobooy6bq"
+					) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"revert_Users": []map[string]any{
+						{
+							"name": "John",
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					commits(fieldId: "C", order: {height: DESC}) {
+						height
+					}
+				}`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"height": int64(3),
+						},
+						{
+							"height": int64(2),
+						},
+						{
+							"height": int64(1),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationRevert_ToCurrentVersion_NoChange(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Revert a document to its current version",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					revert_Users(
+						docID: "bae-6845cfdf-cb0f-56a3-be3a-b5a67be5fbdc",
+						cid: "bafyreib7afkd5hepl45wdtwwpai433bhnbd3ps5m2rv3masctda7b6mmxe"
+					) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"revert_Users": []map[string]any{
+						{
+							"name": "John",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationRevert_WithVersionOfOtherDocument_Error(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Revert a document to a version of another document",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Fred"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					revert_Users(
+						docID: "bae-50ed05ce-4b1b-517b-ad66-dd68e79c2c55",
+						cid: "bafyreigr3imqykrjcn3mr72f5igr6gekfkf37mq7bdigu4wgnuobooy6bq"
+					) {
+						name
+					}
+				}`,
+				ExpectedError: "the given version is not a version of the document",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationRevert_WithUnknownVersion_Error(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Revert a document to an unknown version",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					revert_Users(
+						docID: "bae-6845cfdf-cb0f-56a3-be3a-b5a67be5fbdc",
+						cid: "bafybeid57gpbwi4i6bg7g357vwwyzsmr4bjo22rmhoxrwqvdxlqxcgaqvu"
+					) {
+						name
+					}
+				}`,
+				ExpectedError: "the given version is not a version of the document",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationRevert_WithInvalidCid_Error(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Revert a document with an invalid cid",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					revert_Users(
+						docID: "bae-6845cfdf-cb0f-56a3-be3a-b5a67be5fbdc",
+						cid: "invalid cid"
+					) {
+						name
+					}
+				}`,
+				ExpectedError: "invalid cid: selected encoding not supported",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
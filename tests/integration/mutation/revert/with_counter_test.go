@@ -0,0 +1,111 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package revert
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationRevert_WithPNCounter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Revert a document with pn counter fields to its first version",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						points: Int @crdt(type: pncounter)
+						score: Float @crdt(type: pcounter)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"points": 10,
+					"score": 1.5
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"points": -25
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					revert_Users(
+						docID: "bae-b44b53a3-f989-5599-8a6b-e137b63dbe31",
+						cid: "bafyreignepxlu5iwdf4ybf563uzjoekimvtygumrakv3lkwku7gmyqfjqy"
+					) {
+						name
+						points
+						score
+					}
+				}`,
+				Results: map[string]any{
+					"revert_Users": []map[string]any{
+						{
+							"name":   "John",
+							"points": int64(10),
+							"score":  float64(1.5),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationRevert_WithPCounterDecrement_Error(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Revert a document with a p counter field to a lower value",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						points: Int @crdt(type: pncounter)
+						score: Float @crdt(type: pcounter)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"points": 10,
+					"score": 1.5
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"score": 2
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					revert_Users(
+						docID: "bae-b44b53a3-f989-5599-8a6b-e137b63dbe31",
+						cid: "bafyreignepxlu5iwdf4ybf563uzjoekimvtygumrakv3lkwku7gmyqfjqy"
+					) {
+						score
+					}
+				}`,
+				ExpectedError: "can not revert a p counter field to a lower value",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
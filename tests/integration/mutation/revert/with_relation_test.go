@@ -0,0 +1,114 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package revert
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationRevert_WithOneToManyRelation_RestoresRelatedDoc(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Revert a document restores the related document of its first version",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						author: Author
+					}
+					type Author {
+						name: String
+						published: [Book]
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "John Grisham"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "Cornelia Funke"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				DocMap: map[string]any{
+					"name":   "Painted House",
+					"author": testUtils.NewDocIndex(1, 0),
+				},
+			},
+			testUtils.UpdateDoc{
+				CollectionID: 0,
+				DocID:        0,
+				Doc: `{
+					"author_id": "bae-d17ae618-38bf-5433-8a1a-2053b79eab16"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					revert_Book(
+						docID: "bae-7b77c313-a2c5-52b5-91ee-11b81da8eca9",
+						cid: "bafyreid6gdwc4tpjyxhs3322iejcosgbfhwz5j65yx75vd3pqwjonmmn4m"
+					) {
+						name
+						author {
+							name
+						}
+					}
+				}`,
+				Results: map[string]any{
+					"revert_Book": []map[string]any{
+						{
+							"name": "Painted House",
+							"author": map[string]any{
+								"name": "John Grisham",
+							},
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Author {
+						name
+						published {
+							name
+						}
+					}
+				}`,
+				Results: map[string]any{
+					"Author": []map[string]any{
+						{
+							"name":      "Cornelia Funke",
+							"published": []map[string]any{},
+						},
+						{
+							"name": "John Grisham",
+							"published": []map[string]any{
+								{
+									"name": "Painted House",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
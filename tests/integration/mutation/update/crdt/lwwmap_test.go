@@ -0,0 +1,115 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package update
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestLWWMapUpdate_WithNestedKeys_ShouldUpdate(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Update the keys of an LWW map with JSON type",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						settings: JSON @crdt(type: lwwmap)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"settings": {"theme": "dark", "font": {"family": "mono", "bold": true}}
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"settings": {"font": {"family": "serif", "bold": true}, "tags": ["a", "b"]}
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						settings
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"settings": map[string]any{
+								"font": map[string]any{
+									"family": "serif",
+									"bold":   true,
+								},
+								"tags": []any{"a", "b"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestLWWMapUpdate_WithNull_ShouldBeNil(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Setting an LWW map to null removes the whole value",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						settings: JSON @crdt(type: lwwmap)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"settings": {"theme": "dark"}
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"settings": null
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						settings
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":     "John",
+							"settings": nil,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
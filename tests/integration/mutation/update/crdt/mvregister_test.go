@@ -0,0 +1,100 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package update
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMVRegisterUpdate_WithoutConcurrentWrites_ShouldUpdateWithoutConflicts(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Sequential updates of a multi-value register do not conflict",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						amount: Int @crdt(type: mvregister)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"amount": 100
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"amount": 200
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						amount
+						_conflicts {
+							fieldName
+							values
+							heads
+						}
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":       "John",
+							"amount":     int64(200),
+							"_conflicts": []map[string]any{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMVRegisterResolve_WithInvalidHead_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						amount: Int @crdt(type: mvregister)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"amount": 100
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					resolve_Users(docID: "bae-6693369d-d4a0-5f98-bab3-199bfd987cce", heads: ["not a head"], input: {amount: 150}) {
+						amount
+					}
+				}`,
+				ExpectedError: "invalid conflict head",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
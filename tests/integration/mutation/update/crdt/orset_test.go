@@ -0,0 +1,109 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package update
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestORSetUpdate_StringArrayWithAddedAndRemovedElements_ShouldUpdate(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Add and remove elements of an OR-Set with String array type",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						tags: [String!] @crdt(type: orset)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"tags": ["red", "blue"]
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"tags": ["blue", "green", "green"]
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						tags
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"tags": []string{"blue", "green"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestORSetUpdate_IntArrayWithNil_ShouldClearElements(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Setting an OR-Set with Int array type to null removes all elements",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						scores: [Int!] @crdt(type: orset)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"scores": [1, 2]
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"scores": null
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						scores
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":   "John",
+							"scores": []int64{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
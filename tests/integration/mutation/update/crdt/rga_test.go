@@ -0,0 +1,202 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package update
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestRGATextUpdate_WithNewValue_ShouldUpdate(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Replace the value of an RGA text field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						notes: String @crdt(type: rga)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"notes": "hello world"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"notes": "hello brave new world"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						notes
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":  "John",
+							"notes": "hello brave new world",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestRGATextUpdate_WithSplices_ShouldApplySplicesInOrder(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Splice the value of an RGA text field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						notes: String @crdt(type: rga)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"notes": "héllo world"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Users(splice: [
+						{field: "notes", index: 6, delete: 5, insert: "there"},
+						{field: "notes", index: 0, insert: "Oh, "}
+					]) {
+						notes
+					}
+				}`,
+				Results: map[string]any{
+					"update_Users": []map[string]any{
+						{
+							"notes": "Oh, héllo there",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestRGATextUpdate_WithSpliceOnNilField_ShouldInsert(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						notes: String @crdt(type: rga)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Users(splice: [{field: "notes", index: 0, insert: "hello"}]) {
+						notes
+					}
+				}`,
+				Results: map[string]any{
+					"update_Users": []map[string]any{
+						{
+							"notes": "hello",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestRGATextUpdate_WithSpliceOutOfRange_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						notes: String @crdt(type: rga)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"notes": "hello"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Users(splice: [{field: "notes", index: 3, delete: 3}]) {
+						notes
+					}
+				}`,
+				ExpectedError: "splice is out of the range of the field value",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestRGATextUpdate_WithSpliceOnNonStringField_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Users(splice: [{field: "age", index: 0, insert: "1"}]) {
+						age
+					}
+				}`,
+				ExpectedError: "splice can only be applied to String fields",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
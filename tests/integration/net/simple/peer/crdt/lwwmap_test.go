@@ -0,0 +1,99 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package peer_test
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestP2PUpdate_WithLWWMapConcurrentEditsToDifferentKeys_KeepsAllKeys(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						settings: JSON @crdt(type: lwwmap)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				// Create John on all nodes
+				Doc: `{
+					"name": "John",
+					"settings": {"theme": "dark", "font": {"family": "mono"}}
+				}`,
+			},
+			testUtils.UpdateDoc{
+				// The nodes are not yet connected so this update is concurrent with the next one
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"settings": {"theme": "light", "font": {"family": "mono"}}
+				}`,
+			},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"settings": {"theme": "dark", "font": {"family": "mono", "weight": "bold"}, "lang": "en"}
+				}`,
+			},
+			testUtils.ConnectPeers{
+				SourceNodeID: 0,
+				TargetNodeID: 1,
+			},
+			testUtils.UpdateDoc{
+				// Push the divergent branch of the first node to the second node
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"name": "Johnny"
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.UpdateDoc{
+				// Push the merged branches back to the first node
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						settings
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"settings": map[string]any{
+								"theme": "light",
+								"font": map[string]any{
+									"family": "mono",
+									"weight": "bold",
+								},
+								"lang": "en",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
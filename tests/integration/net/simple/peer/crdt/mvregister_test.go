@@ -0,0 +1,184 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package peer_test
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+// concurrentMVRegisterActions returns the actions required to make concurrent
+// writes to the multi-value register on two nodes and sync them.
+func concurrentMVRegisterActions() []any {
+	return []any{
+		testUtils.RandomNetworkingConfig(),
+		testUtils.RandomNetworkingConfig(),
+		testUtils.SchemaUpdate{
+			Schema: `
+				type Users {
+					name: String
+					amount: Int @crdt(type: mvregister)
+				}
+			`,
+		},
+		testUtils.CreateDoc{
+			// Create John on all nodes
+			Doc: `{
+				"name": "John",
+				"amount": 100
+			}`,
+		},
+		testUtils.UpdateDoc{
+			// The nodes are not yet connected so this update is concurrent with the next one
+			NodeID: immutable.Some(0),
+			Doc: `{
+				"amount": 200
+			}`,
+		},
+		testUtils.UpdateDoc{
+			NodeID: immutable.Some(1),
+			Doc: `{
+				"amount": 300
+			}`,
+		},
+		testUtils.ConnectPeers{
+			SourceNodeID: 0,
+			TargetNodeID: 1,
+		},
+		testUtils.UpdateDoc{
+			// Push the divergent branch of the first node to the second node
+			NodeID: immutable.Some(0),
+			Doc: `{
+				"name": "Johnny"
+			}`,
+		},
+		testUtils.WaitForSync{},
+		testUtils.UpdateDoc{
+			// Push the merged branches back to the first node
+			NodeID: immutable.Some(1),
+			Doc: `{
+				"name": "John"
+			}`,
+		},
+		testUtils.WaitForSync{},
+	}
+}
+
+func TestP2PUpdate_WithMVRegisterConcurrentUpdates_KeepsConflictingValues(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			concurrentMVRegisterActions(),
+			testUtils.Request{
+				Request: `query {
+					Users {
+						amount
+						_conflicts {
+							fieldName
+							values
+							heads
+						}
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"amount": int64(300),
+							"_conflicts": []map[string]any{
+								{
+									"fieldName": "amount",
+									"values":    []any{int64(200), int64(300)},
+									"heads":     []any{"9fba1dea8424d1c8e49ec4f561a7ca7e", "b130ae1cf8752a90c729bcc5673617ef"},
+								},
+							},
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestP2PUpdate_WithMVRegisterConflictResolved_RemovesConflict(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			concurrentMVRegisterActions(),
+			testUtils.Request{
+				NodeID: immutable.Some(0),
+				Request: `mutation {
+					resolve_Users(
+						docID: "bae-6693369d-d4a0-5f98-bab3-199bfd987cce",
+						heads: ["9fba1dea8424d1c8e49ec4f561a7ca7e", "b130ae1cf8752a90c729bcc5673617ef"],
+						input: {amount: 250}
+					) {
+						amount
+						_conflicts {
+							fieldName
+						}
+					}
+				}`,
+				Results: map[string]any{
+					"resolve_Users": []map[string]any{
+						{
+							"amount":     int64(250),
+							"_conflicts": []map[string]any{},
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestP2PUpdate_WithMVRegisterConflictPartiallyResolved_KeepsUnlistedValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			concurrentMVRegisterActions(),
+			testUtils.Request{
+				NodeID: immutable.Some(0),
+				Request: `mutation {
+					resolve_Users(
+						docID: "bae-6693369d-d4a0-5f98-bab3-199bfd987cce",
+						heads: ["9fba1dea8424d1c8e49ec4f561a7ca7e"],
+						input: {amount: 250}
+					) {
+						amount
+						_conflicts {
+							fieldName
+							values
+						}
+					}
+				}`,
+				Results: map[string]any{
+					"resolve_Users": []map[string]any{
+						{
+							"amount": int64(250),
+							"_conflicts": []map[string]any{
+								{
+									"fieldName": "amount",
+									"values":    []any{int64(250), int64(300)},
+								},
+							},
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
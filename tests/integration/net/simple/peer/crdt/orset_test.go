@@ -0,0 +1,300 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package peer_test
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestP2PUpdate_WithORSet_NoError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						tags: [String!] @crdt(type: orset)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				// Create John on all nodes
+				Doc: `{
+					"name": "John",
+					"tags": ["a", "b"]
+				}`,
+			},
+			testUtils.ConnectPeers{
+				SourceNodeID: 1,
+				TargetNodeID: 0,
+			},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"tags": ["b", "c"]
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						tags
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"tags": []string{"b", "c"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestP2PUpdate_WithORSetConcurrentUpdates_MergesElements(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						tags: [String!] @crdt(type: orset)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				// Create John on all nodes
+				Doc: `{
+					"name": "John",
+					"tags": ["a", "b"]
+				}`,
+			},
+			testUtils.UpdateDoc{
+				// The nodes are not yet connected so this update is concurrent with the next one
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"tags": ["a", "b", "c"]
+				}`,
+			},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"tags": ["b", "d"]
+				}`,
+			},
+			testUtils.ConnectPeers{
+				SourceNodeID: 0,
+				TargetNodeID: 1,
+			},
+			testUtils.UpdateDoc{
+				// Push the divergent branch of the first node to the second node
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"name": "Johnny"
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.UpdateDoc{
+				// Push the merged branches back to the first node
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						tags
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"tags": []string{"b", "c", "d"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestP2PUpdate_WithORSetConcurrentAddAndRemove_AddWins(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						tags: [String!] @crdt(type: orset)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				// Create John on all nodes
+				Doc: `{
+					"name": "John",
+					"tags": ["a"]
+				}`,
+			},
+			testUtils.UpdateDoc{
+				// The nodes are not yet connected so this update is concurrent with the next ones
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"tags": []
+				}`,
+			},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"tags": []
+				}`,
+			},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"tags": ["a"]
+				}`,
+			},
+			testUtils.ConnectPeers{
+				SourceNodeID: 0,
+				TargetNodeID: 1,
+			},
+			testUtils.UpdateDoc{
+				// Push the divergent branch of the first node to the second node
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"name": "Johnny"
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.UpdateDoc{
+				// Push the merged branches back to the first node
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						tags
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"tags": []string{"a"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestP2PUpdate_WithORSetConcurrentUpdates_QueryAsOfMergedVersion(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						tags: [String!] @crdt(type: orset)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				// Create John on all nodes
+				Doc: `{
+					"name": "John",
+					"tags": ["a", "b"]
+				}`,
+			},
+			testUtils.UpdateDoc{
+				// The nodes are not yet connected so this update is concurrent with the next one
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"tags": ["a", "b", "c"]
+				}`,
+			},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"tags": ["b", "d"]
+				}`,
+			},
+			testUtils.ConnectPeers{
+				SourceNodeID: 0,
+				TargetNodeID: 1,
+			},
+			testUtils.UpdateDoc{
+				// Push the divergent branch of the first node to the second node
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"name": "Johnny"
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.UpdateDoc{
+				// The block of this update has the heads of both branches as parents
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.Request{
+				// The version is recomposed from the blocks of both branches, which must be
+				// merged after the creation block adding the elements they remove
+				NodeID: immutable.Some(1),
+				Request: `query {
+					Users(asOf: "2100-01-01T00:00:00Z") {
+						name
+						tags
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"tags": []string{"b", "c", "d"},
+						},
+					},
+				},
+			},
+		},
+		EnableTimestamps: true,
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,170 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package peer_test
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestP2PUpdate_WithRGATextConcurrentEdits_InterleavesEdits(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						notes: String @crdt(type: rga)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				// Create John on all nodes
+				Doc: `{
+					"name": "John",
+					"notes": "The fox jumps."
+				}`,
+			},
+			testUtils.UpdateDoc{
+				// The nodes are not yet connected so this update is concurrent with the next one
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"notes": "The quick fox jumps."
+				}`,
+			},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"notes": "The fox jumps over the dog."
+				}`,
+			},
+			testUtils.ConnectPeers{
+				SourceNodeID: 0,
+				TargetNodeID: 1,
+			},
+			testUtils.UpdateDoc{
+				// Push the divergent branch of the first node to the second node
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"name": "Johnny"
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.UpdateDoc{
+				// Push the merged branches back to the first node
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						notes
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"notes": "The quick fox jumps over the dog.",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestP2PUpdate_WithRGATextConcurrentEdits_QueryAsOfMergedVersion(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						notes: String @crdt(type: rga)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				// Create John on all nodes
+				Doc: `{
+					"name": "John",
+					"notes": "The fox jumps."
+				}`,
+			},
+			testUtils.UpdateDoc{
+				// The nodes are not yet connected so this update is concurrent with the next one
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"notes": "The quick fox jumps."
+				}`,
+			},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"notes": "The fox jumps over the dog."
+				}`,
+			},
+			testUtils.ConnectPeers{
+				SourceNodeID: 0,
+				TargetNodeID: 1,
+			},
+			testUtils.UpdateDoc{
+				// Push the divergent branch of the first node to the second node
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"name": "Johnny"
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.UpdateDoc{
+				// The block of this update has the heads of both branches as parents
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.Request{
+				// The version is recomposed from the blocks of both branches, which must be
+				// merged after the creation block they are both inserting after
+				NodeID: immutable.Some(1),
+				Request: `query {
+					Users(asOf: "2100-01-01T00:00:00Z") {
+						name
+						notes
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":  "John",
+							"notes": "The quick fox jumps over the dog.",
+						},
+					},
+				},
+			},
+		},
+		EnableTimestamps: true,
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,154 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package peer_test
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestP2PWithCompactedDAG_UpdateFromPeerWithFullHistory(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				// Create John on all nodes
+				Doc: `{
+					"Name": "John",
+					"Age": 21
+				}`,
+			},
+			testUtils.ConnectPeers{
+				SourceNodeID: 0,
+				TargetNodeID: 1,
+			},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"Age": 22
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"Age": 23
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.CompactDAG{
+				// Only the first node squashes its history, the second node keeps every block.
+				NodeID: immutable.Some(0),
+				Config: client.CompactionConfig{
+					KeepHeight: 1,
+				},
+				ExpectedResult: immutable.Some(client.CompactionResult{
+					Documents:    1,
+					PrunedBlocks: 4,
+				}),
+			},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"Age": 24
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"Age": 25
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						Name
+						Age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name": "John",
+							"Age":  int64(25),
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				// The pruned blocks are not fetched back from the second node.
+				NodeID: immutable.Some(0),
+				Request: `query {
+					commits(fieldId: "C") {
+						height
+					}
+				}`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"height": int64(5),
+						},
+						{
+							"height": int64(4),
+						},
+						{
+							"height": int64(3),
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				NodeID: immutable.Some(1),
+				Request: `query {
+					commits(fieldId: "C") {
+						height
+					}
+				}`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"height": int64(5),
+						},
+						{
+							"height": int64(4),
+						},
+						{
+							"height": int64(3),
+						},
+						{
+							"height": int64(2),
+						},
+						{
+							"height": int64(1),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
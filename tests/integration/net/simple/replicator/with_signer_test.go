@@ -0,0 +1,75 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package replicator
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestP2POneToOneReplicatorWithSignedCreate_SyncsSigner(t *testing.T) {
+	test := testUtils.TestCase{
+		// Only the Go client holds the private key of the identity, the other clients
+		// authenticate with a bearer token and so cannot sign the blocks.
+		SupportedClientTypes: immutable.Some([]testUtils.ClientType{testUtils.GoClientType}),
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+					}
+				`,
+			},
+			testUtils.ConfigureReplicator{
+				SourceNodeID: 0,
+				TargetNodeID: 1,
+			},
+			testUtils.CreateDoc{
+				NodeID:   immutable.Some(0),
+				Identity: immutable.Some(1),
+				Doc: `{
+					"Name": "John"
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.Request{
+				NodeID: immutable.Some(1),
+				Request: `query {
+					Users {
+						Name
+					}
+					commits(fieldId: "C") {
+						_signer
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name": "John",
+						},
+					},
+					"commits": []map[string]any{
+						{
+							"_signer": "did:key:z7r8oqkfiiVe4bHLYBjHZTJqGiUqCuMo6q7qiNGNYogBb8CZhDZ6RmFocZYYrsxCLew1E9bdWJ5tC7bVCGosfQDrSy7nf",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -39,3 +39,9 @@ func updateCompaniesCollectionSchema() testUtils.SchemaUpdate {
 		Schema: companiesCollectionGQLSchema,
 	}
 }
+
+// The DIDs of the test identities with the same index.
+const (
+	identity1DID = "did:key:z7r8oqkfiiVe4bHLYBjHZTJqGiUqCuMo6q7qiNGNYogBb8CZhDZ6RmFocZYYrsxCLew1E9bdWJ5tC7bVCGosfQDrSy7nf"
+	identity2DID = "did:key:z7r8osxKxkzjxkYKzPRwFuorR8JJk7iACNEfNK7NoTMXEuohAkkZJNDSeTxnbvh7o9Pyr1wXvzBxKqhandbUNZVwgm8ph"
+)
@@ -0,0 +1,139 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package commits
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryCommitsWithSigner_WithoutIdentity_ReturnsNil(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple all commits query with signer, no identity",
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc: `{
+						"name":	"John"
+					}`,
+			},
+			testUtils.Request{
+				Request: `query {
+						commits {
+							height
+							_signer
+						}
+					}`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"height":  int64(1),
+							"_signer": nil,
+						},
+						{
+							"height":  int64(1),
+							"_signer": nil,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryCommitsWithSigner_WithIdentity_ReturnsIdentityDID(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple all commits query with signer, with identity",
+		// Only the Go client holds the private key of the identity, the other clients
+		// authenticate with a bearer token and so cannot sign the blocks.
+		SupportedClientTypes: immutable.Some([]testUtils.ClientType{testUtils.GoClientType}),
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Identity: immutable.Some(1),
+				Doc: `{
+						"name":	"John"
+					}`,
+			},
+			testUtils.Request{
+				Request: `query {
+						commits {
+							height
+							_signer
+						}
+					}`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"height":  int64(1),
+							"_signer": identity1DID,
+						},
+						{
+							"height":  int64(1),
+							"_signer": identity1DID,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryCommitsWithSigner_WithUpdateByOtherIdentity_ReturnsEachIdentityDID(t *testing.T) {
+	test := testUtils.TestCase{
+		Description:          "Commits query with signer, updated by another identity",
+		SupportedClientTypes: immutable.Some([]testUtils.ClientType{testUtils.GoClientType}),
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Identity: immutable.Some(1),
+				Doc: `{
+						"name":	"John"
+					}`,
+			},
+			testUtils.UpdateDoc{
+				Identity: immutable.Some(2),
+				Doc: `{
+						"name":	"Johnny"
+					}`,
+			},
+			testUtils.Request{
+				Request: `query {
+						commits(fieldId: "C", order: {height: DESC}) {
+							height
+							_signer
+						}
+					}`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"height":  int64(2),
+							"_signer": identity2DID,
+						},
+						{
+							"height":  int64(1),
+							"_signer": identity1DID,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
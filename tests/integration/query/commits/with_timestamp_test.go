@@ -0,0 +1,207 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package commits
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryCommitsWithTimestamp_WithTimestampsDisabled_ReturnsNil(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple all commits query with timestamp, timestamps disabled",
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc: `{
+						"name":	"John"
+					}`,
+			},
+			testUtils.Request{
+				Request: `query {
+						commits {
+							height
+							timestamp
+						}
+					}`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"height":    int64(1),
+							"timestamp": nil,
+						},
+						{
+							"height":    int64(1),
+							"timestamp": nil,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryCommitsWithTimestampFilter_WithTimestampAfter_ReturnsAllCommits(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Commits query with timestamp greater than filter",
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc: `{
+						"name":	"John"
+					}`,
+			},
+			testUtils.Request{
+				Request: `query {
+						commits(filter: {timestamp: {_gt: "2020-01-01T00:00:00Z"}}) {
+							height
+						}
+					}`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"height": int64(1),
+						},
+						{
+							"height": int64(1),
+						},
+					},
+				},
+			},
+		},
+		EnableTimestamps: true,
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryCommitsWithTimestampFilter_WithTimestampBefore_ReturnsNoCommits(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Commits query with timestamp less than filter",
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc: `{
+						"name":	"John"
+					}`,
+			},
+			testUtils.Request{
+				Request: `query {
+						commits(filter: {timestamp: {_lt: "2020-01-01T00:00:00Z"}}) {
+							height
+						}
+					}`,
+				Results: map[string]any{
+					"commits": []map[string]any{},
+				},
+			},
+		},
+		EnableTimestamps: true,
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryCommitsWithTimestampOrder_WithUpdate_ReturnsLatestFirst(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Commits query ordered by timestamp",
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc: `{
+						"name":	"John",
+						"age":	21
+					}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+						"age":	22
+					}`,
+			},
+			testUtils.Request{
+				Request: `query {
+						commits(order: {timestamp: DESC}) {
+							height
+							fieldName
+						}
+					}`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"height":    int64(2),
+							"fieldName": nil,
+						},
+						{
+							"height":    int64(2),
+							"fieldName": "age",
+						},
+						{
+							"height":    int64(1),
+							"fieldName": nil,
+						},
+						{
+							"height":    int64(1),
+							"fieldName": testUtils.AnyOf{"name", "age"},
+						},
+						{
+							"height":    int64(1),
+							"fieldName": testUtils.AnyOf{"name", "age"},
+						},
+					},
+				},
+			},
+		},
+		EnableTimestamps: true,
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryCommitsWithHeightFilter_ReturnsMatchingCommits(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Commits query with height filter",
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc: `{
+						"name":	"John",
+						"age":	21
+					}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+						"age":	22
+					}`,
+			},
+			testUtils.Request{
+				Request: `query {
+						commits(filter: {height: {_eq: 2}, fieldName: {_eq: "age"}}) {
+							height
+							fieldName
+						}
+					}`,
+				Results: map[string]any{
+					"commits": []map[string]any{
+						{
+							"height":    int64(2),
+							"fieldName": "age",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,194 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package inline_array
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryInlineIntegerArrayWithStatistics(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple inline array, statistics of integer array",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Shahzad",
+					"favouriteIntegers": [-1, 2, -1, 10, 0]
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						_median(favouriteIntegers: {})
+						_percentile(p: 75, favouriteIntegers: {})
+						_countDistinct(favouriteIntegers: {})
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":           "Shahzad",
+							"_median":        float64(0),
+							"_percentile":    float64(2),
+							"_countDistinct": 4,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryInlineIntegerArrayWithStatisticsAndEmptyArray(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple inline array, statistics of empty integer array",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"favouriteIntegers": []
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						_median(favouriteIntegers: {})
+						_variance(favouriteIntegers: {})
+						_countDistinct(favouriteIntegers: {})
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":           "John",
+							"_median":        nil,
+							"_variance":      nil,
+							"_countDistinct": 0,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryInlineIntegerArrayWithMedianWithFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple inline array, median of filtered integer array",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Shahzad",
+					"favouriteIntegers": [-1, 2, -1, 10, 0]
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						_median(favouriteIntegers: {filter: {_gt: 0}})
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":    "Shahzad",
+							"_median": float64(6),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryInlineNillableFloatArrayWithStatistics(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple inline array, statistics of nillable float array",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Shahzad",
+					"pageRatings": [3.5, null, 1.5, 3.5]
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						_median(pageRatings: {})
+						_variance(pageRatings: {})
+						_countDistinct(pageRatings: {})
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":           "Shahzad",
+							"_median":        float64(3.5),
+							"_variance":      float64(8) / 9,
+							"_countDistinct": 2,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryInlineNillableStringArrayWithCountDistinct(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple inline array, count distinct of nillable string and boolean arrays",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Shahzad",
+					"pageHeaders": ["first", null, "second", "first", ""],
+					"likedIndexes": [true, true, false]
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						headers: _countDistinct(pageHeaders: {})
+						headersWithLimit: _countDistinct(pageHeaders: {limit: 2})
+						liked: _countDistinct(likedIndexes: {filter: {_eq: true}})
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":             "Shahzad",
+							"headers":          3,
+							"headersWithLimit": 1,
+							"liked":            1,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
@@ -0,0 +1,241 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package one_to_many
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func createParentFieldReferenceTestDocs() []any {
+	return []any{
+		testUtils.SchemaUpdate{
+			Schema: `
+				type Warehouse {
+					name: String
+					minStock: Int
+					products: [Product]
+				}
+
+				type Product {
+					name: String
+					stock: Int
+					reorderLevel: Int
+					warehouse: Warehouse
+				}
+			`,
+		},
+		testUtils.CreateDoc{
+			CollectionID: 0,
+			Doc: `{
+				"name": "North",
+				"minStock": 10
+			}`,
+		},
+		testUtils.CreateDoc{
+			CollectionID: 0,
+			Doc: `{
+				"name": "South",
+				"minStock": 2
+			}`,
+		},
+		testUtils.CreateDoc{
+			CollectionID: 1,
+			DocMap: map[string]any{
+				"name":         "Pencil",
+				"stock":        3,
+				"reorderLevel": 5,
+				"warehouse_id": testUtils.NewDocIndex(0, 0),
+			},
+		},
+		testUtils.CreateDoc{
+			CollectionID: 1,
+			DocMap: map[string]any{
+				"name":         "Notebook",
+				"stock":        25,
+				"reorderLevel": 30,
+				"warehouse_id": testUtils.NewDocIndex(0, 0),
+			},
+		},
+		testUtils.CreateDoc{
+			CollectionID: 1,
+			DocMap: map[string]any{
+				"name":         "Eraser",
+				"stock":        3,
+				"reorderLevel": 1,
+				"warehouse_id": testUtils.NewDocIndex(0, 1),
+			},
+		},
+	}
+}
+
+func TestQueryOneToMany_WithParentFieldReferenceFilter_ShouldCompareWithParent(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many relation query from one side, filtering children by a field of the parent",
+		Actions: append(
+			createParentFieldReferenceTestDocs(),
+			testUtils.Request{
+				Request: `query {
+					Warehouse(order: {name: ASC}) {
+						name
+						products(filter: {stock: {_lt: {_parentField: "minStock"}}}) {
+							name
+						}
+					}
+				}`,
+				Results: map[string]any{
+					"Warehouse": []map[string]any{
+						{
+							"name": "North",
+							"products": []map[string]any{
+								{
+									"name": "Pencil",
+								},
+							},
+						},
+						{
+							"name":     "South",
+							"products": []map[string]any{},
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryOneToMany_WithParentAndChildFieldReferenceFilter_ShouldCompareWithBoth(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many relation query from one side, filtering children by a field of the parent and of the child",
+		Actions: append(
+			createParentFieldReferenceTestDocs(),
+			testUtils.Request{
+				Request: `query {
+					Warehouse(order: {name: ASC}) {
+						name
+						products(
+							filter: {
+								stock: {_gt: {_parentField: "minStock"}, _lt: {_field: "reorderLevel"}}
+							}
+						) {
+							name
+						}
+					}
+				}`,
+				Results: map[string]any{
+					"Warehouse": []map[string]any{
+						{
+							"name": "North",
+							"products": []map[string]any{
+								{
+									"name": "Notebook",
+								},
+							},
+						},
+						{
+							"name":     "South",
+							"products": []map[string]any{},
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryOneToMany_FromManySideWithParentFieldReferenceFilter_ShouldCompareWithParent(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many relation query from many side, filtering the related object by a field of the parent",
+		Actions: append(
+			createParentFieldReferenceTestDocs(),
+			testUtils.Request{
+				Request: `query {
+					Product(order: {name: ASC}) {
+						name
+						warehouse(filter: {minStock: {_gt: {_parentField: "stock"}}}) {
+							name
+						}
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{
+							"name":      "Eraser",
+							"warehouse": nil,
+						},
+						{
+							"name":      "Notebook",
+							"warehouse": nil,
+						},
+						{
+							"name": "Pencil",
+							"warehouse": map[string]any{
+								"name": "North",
+							},
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryOneToMany_WithRelatedFieldReferenceFilter_ShouldCompareRelatedFields(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many relation query from one side, filtering parents by comparing fields of their children",
+		Actions: append(
+			createParentFieldReferenceTestDocs(),
+			testUtils.Request{
+				Request: `query {
+					Warehouse(filter: {products: {stock: {_lt: {_field: "reorderLevel"}}}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Warehouse": []map[string]any{
+						{
+							"name": "North",
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryOneToMany_WithParentFieldReferenceInAggregateFilter_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many relation query from one side, with a parent field reference in an aggregate filter",
+		Actions: append(
+			createParentFieldReferenceTestDocs(),
+			testUtils.Request{
+				Request: `query {
+					Warehouse {
+						name
+						_count(products: {filter: {stock: {_lt: {_parentField: "minStock"}}}})
+					}
+				}`,
+				ExpectedError: "parent fields can only be referenced in the filter of a related object",
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,308 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package one_to_many
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func createOrderAggregateTestDocs() []any {
+	return []any{
+		testUtils.CreateDoc{
+			CollectionID: 1,
+			Doc: `{
+				"name": "John Grisham",
+				"age": 65,
+				"verified": true
+			}`,
+		},
+		testUtils.CreateDoc{
+			CollectionID: 1,
+			Doc: `{
+				"name": "Cornelia Funke",
+				"age": 62,
+				"verified": false
+			}`,
+		},
+		testUtils.CreateDoc{
+			CollectionID: 1,
+			Doc: `{
+				"name": "Andrzej Sapkowski",
+				"age": 76,
+				"verified": true
+			}`,
+		},
+		testUtils.CreateDoc{
+			CollectionID: 0,
+			DocMap: map[string]any{
+				"name":      "Painted House",
+				"rating":    4.9,
+				"author_id": testUtils.NewDocIndex(1, 0),
+			},
+		},
+		testUtils.CreateDoc{
+			CollectionID: 0,
+			DocMap: map[string]any{
+				"name":      "A Time for Mercy",
+				"rating":    4.5,
+				"author_id": testUtils.NewDocIndex(1, 0),
+			},
+		},
+		testUtils.CreateDoc{
+			CollectionID: 0,
+			DocMap: map[string]any{
+				"name":      "The Client",
+				"rating":    4.1,
+				"author_id": testUtils.NewDocIndex(1, 0),
+			},
+		},
+		testUtils.CreateDoc{
+			CollectionID: 0,
+			DocMap: map[string]any{
+				"name":      "Theif Lord",
+				"rating":    4.8,
+				"author_id": testUtils.NewDocIndex(1, 1),
+			},
+		},
+		testUtils.CreateDoc{
+			CollectionID: 0,
+			DocMap: map[string]any{
+				"name":      "Inkheart",
+				"rating":    4.7,
+				"author_id": testUtils.NewDocIndex(1, 1),
+			},
+		},
+	}
+}
+
+func TestQueryOneToManyWithOrderByCount(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many relation query from many side, ordered by the count of the relation",
+		Actions: append(
+			createOrderAggregateTestDocs(),
+			testUtils.Request{
+				Request: `query {
+					Author(order: {_count: {published: DESC}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Author": []map[string]any{
+						{
+							"name": "John Grisham",
+						},
+						{
+							"name": "Cornelia Funke",
+						},
+						{
+							"name": "Andrzej Sapkowski",
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryOneToManyWithOrderByCountWithCountSelected(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many relation query from many side, ordered by the count of the relation, count selected",
+		Actions: append(
+			createOrderAggregateTestDocs(),
+			testUtils.Request{
+				Request: `query {
+					Author(order: {_count: {published: ASC}}) {
+						name
+						_count(published: {filter: {rating: {_gt: 4.6}}})
+					}
+				}`,
+				Results: map[string]any{
+					"Author": []map[string]any{
+						{
+							"name":   "Andrzej Sapkowski",
+							"_count": 0,
+						},
+						{
+							"name":   "Cornelia Funke",
+							"_count": 2,
+						},
+						{
+							"name":   "John Grisham",
+							"_count": 1,
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryOneToManyWithOrderByCountAndLimit(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many relation query from many side, ordered by the count of the relation, with limit",
+		Actions: append(
+			createOrderAggregateTestDocs(),
+			testUtils.Request{
+				Request: `query {
+					Author(order: {_count: {published: DESC}}, limit: 1) {
+						name
+						published(order: {rating: DESC}) {
+							name
+						}
+					}
+				}`,
+				Results: map[string]any{
+					"Author": []map[string]any{
+						{
+							"name": "John Grisham",
+							"published": []map[string]any{
+								{
+									"name": "Painted House",
+								},
+								{
+									"name": "A Time for Mercy",
+								},
+								{
+									"name": "The Client",
+								},
+							},
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryOneToManyWithOrderByAverage(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many relation query from many side, ordered by the average of a related field",
+		Actions: append(
+			createOrderAggregateTestDocs(),
+			testUtils.Request{
+				Request: `query {
+					Author(order: {_avg: {published: {rating: DESC}}}, filter: {verified: {_eq: true}}) {
+						name
+						_avg(published: {field: rating})
+					}
+				}`,
+				Results: map[string]any{
+					"Author": []map[string]any{
+						{
+							"name": "John Grisham",
+							"_avg": 4.5,
+						},
+						{
+							"name": "Andrzej Sapkowski",
+							"_avg": float64(0),
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryOneToManyWithOrderByMaxThenName(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many relation query from many side, ordered by the maximum of a related field and then name",
+		Actions: append(
+			createOrderAggregateTestDocs(),
+			testUtils.Request{
+				Request: `query {
+					Author(order: [{_max: {published: {rating: DESC}}}, {name: ASC}]) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Author": []map[string]any{
+						{
+							"name": "John Grisham",
+						},
+						{
+							"name": "Cornelia Funke",
+						},
+						{
+							"name": "Andrzej Sapkowski",
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryOneToManyWithOrderByRelatedField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many relation query from one side, ordered by a field of the related object",
+		Actions: append(
+			createOrderAggregateTestDocs(),
+			testUtils.Request{
+				Request: `query {
+					Book(order: [{author: {age: DESC}}, {rating: DESC}]) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Book": []map[string]any{
+						{
+							"name": "Painted House",
+						},
+						{
+							"name": "A Time for Mercy",
+						},
+						{
+							"name": "The Client",
+						},
+						{
+							"name": "Theif Lord",
+						},
+						{
+							"name": "Inkheart",
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryOneToManyWithOrderByRelatedCount_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many relation query from one side, ordered by an aggregate of the related object",
+		Actions: []any{
+			testUtils.Request{
+				Request: `query {
+					Book(order: {author: {_count: {published: DESC}}}) {
+						name
+					}
+				}`,
+				ExpectedError: "invalid aggregate to order by",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
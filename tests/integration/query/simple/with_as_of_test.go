@@ -0,0 +1,307 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+// asOfTestActions returns the actions creating the history queried by the asOf tests.
+//
+// With timestamps enabled every block is created one second after the previous one,
+// starting from [testUtils.TestClockStart], so the composite blocks are created at:
+// - 00:00:02 the creation of John
+// - 00:00:05 the creation of Islam
+// - 00:00:07 the update of John
+// - 00:00:08 the deletion of Islam
+func asOfTestActions() []any {
+	return []any{
+		testUtils.SchemaUpdate{
+			Schema: `
+				type Users {
+					name: String
+					age: Int
+				}
+			`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"name": "John",
+				"age": 21
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"name": "Islam",
+				"age": 32
+			}`,
+		},
+		testUtils.UpdateDoc{
+			DocID: 0,
+			Doc: `{
+				"age": 22
+			}`,
+		},
+		testUtils.DeleteDoc{
+			DocID: 1,
+		},
+	}
+}
+
+func TestQuerySimpleWithAsOf_BeforeCreation_ReturnsNoDocuments(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with asOf before any document was created",
+		Actions: append(
+			asOfTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(asOf: "2024-01-01T00:00:01Z") {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{},
+				},
+			},
+		),
+		EnableTimestamps: true,
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithAsOf_AfterFirstCreation_ReturnsFirstDocument(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with asOf after the first document was created",
+		Actions: append(
+			asOfTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(asOf: "2024-01-01T00:00:02Z") {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"age":  int64(21),
+						},
+					},
+				},
+			},
+		),
+		EnableTimestamps: true,
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithAsOf_BeforeUpdate_ReturnsPreviousValues(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with asOf before the update, returns the values at that time",
+		Actions: append(
+			asOfTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(asOf: "2024-01-01T00:00:06Z", order: {name: ASC}) {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Islam",
+							"age":  int64(32),
+						},
+						{
+							"name": "John",
+							"age":  int64(21),
+						},
+					},
+				},
+			},
+		),
+		EnableTimestamps: true,
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithAsOf_BeforeDelete_ReturnsDeletedDocument(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with asOf before the delete, returns the since deleted document",
+		Actions: append(
+			asOfTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(asOf: "2024-01-01T00:00:07Z", order: {name: ASC}) {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Islam",
+							"age":  int64(32),
+						},
+						{
+							"name": "John",
+							"age":  int64(22),
+						},
+					},
+				},
+			},
+		),
+		EnableTimestamps: true,
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithAsOf_AfterDelete_ReturnsCurrentState(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with asOf after every change, returns the current state",
+		Actions: append(
+			asOfTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(asOf: "2030-01-01T00:00:00Z") {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"age":  int64(22),
+						},
+					},
+				},
+			},
+		),
+		EnableTimestamps: true,
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithAsOfAndFilter_FiltersOnPreviousValues(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with asOf and filter, filters on the values at that time",
+		Actions: append(
+			asOfTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(asOf: "2024-01-01T00:00:06Z", filter: {age: {_lt: 30}}) {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"age":  int64(21),
+						},
+					},
+				},
+			},
+		),
+		EnableTimestamps: true,
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithAsOfAndDocID_ReturnsDocumentAtTime(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with asOf and docID",
+		Actions: append(
+			asOfTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(asOf: "2024-01-01T00:00:06Z", docID: ["bae-0b2f15e5-bfe7-5cb7-8045-471318d7dbc3"]) {
+						name
+						age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "John",
+							"age":  int64(21),
+						},
+					},
+				},
+			},
+		),
+		EnableTimestamps: true,
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithAsOfAndCid_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with asOf and cid",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(
+						asOf: "2024-01-01T00:00:00Z",
+						cid: "bafyreib7afkd5hepl45wdtwwpai433bhnbd3ps5m2rv3masctda7b6mmxe",
+						docID: ["bae-d4303725-7db9-53d2-b324-f3ee44020e52"]
+					) {
+						name
+					}
+				}`,
+				ExpectedError: "asOf cannot be used together with cid",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithAsOf_WithoutTimestamps_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with asOf on a db without block timestamps",
+		Actions: append(
+			asOfTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(asOf: "2100-01-01T00:00:00Z") {
+						name
+						age
+					}
+				}`,
+				ExpectedError: "asOf requires the block timestamps to be enabled",
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
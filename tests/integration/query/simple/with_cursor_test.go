@@ -0,0 +1,418 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+// The cursors of the documents created by cursorTestActions, ordered by docID.
+const (
+	carloCursor = "gXgoYmFlLTA2MTliNWNjLTNjNDItNWM0NC1hOGQ3LTdlNTIzZmVjNTc0Mw"
+	bobCursor   = "gXgoYmFlLTIwMmRmOWFkLTUzZjQtNTQyYi1hNjhmLWZlZTZjZDk2ZjU5ZQ"
+	johnCursor  = "gXgoYmFlLWQ0MzAzNzI1LTdkYjktNTNkMi1iMzI0LWYzZWU0NDAyMGU1Mg"
+	aliceCursor = "gXgoYmFlLWYyZWJmODJlLTFjNzAtNTM3Yi05YjNkLWFmN2MzMGU5MDQ4Yw"
+)
+
+// The cursor of Bob, ordered by age descending.
+const bobAgeDescCursor = "ghggeChiYWUtMjAyZGY5YWQtNTNmNC01NDJiLWE2OGYtZmVlNmNkOTZmNTll"
+
+func cursorTestActions() []any {
+	return []any{
+		testUtils.CreateDoc{
+			Doc: `{
+				"Name": "John",
+				"Age": 21
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"Name": "Bob",
+				"Age": 32
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"Name": "Alice",
+				"Age": 19
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"Name": "Carlo",
+				"Age": 55
+			}`,
+		},
+	}
+}
+
+func TestQuerySimpleWithCursor_WithFirst_ReturnsFirstPage(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with first",
+		Actions: append(
+			cursorTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(first: 2) {
+						Name
+						_cursor
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name":    "Carlo",
+							"_cursor": carloCursor,
+						},
+						{
+							"Name":    "Bob",
+							"_cursor": bobCursor,
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_WithFirstAndAfter_ReturnsNextPage(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with first and after",
+		Actions: append(
+			cursorTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(first: 2, after: "` + bobCursor + `") {
+						Name
+						_cursor
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name":    "John",
+							"_cursor": johnCursor,
+						},
+						{
+							"Name":    "Alice",
+							"_cursor": aliceCursor,
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_WithAfterLastDocument_ReturnsEmptyPage(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with after the last document",
+		Actions: append(
+			cursorTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(first: 2, after: "` + aliceCursor + `") {
+						Name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_WithLast_ReturnsLastPage(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with last",
+		Actions: append(
+			cursorTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(last: 2) {
+						Name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name": "John",
+						},
+						{
+							"Name": "Alice",
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_WithLastAndBefore_ReturnsPreviousPage(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with last and before",
+		Actions: append(
+			cursorTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(last: 2, before: "` + aliceCursor + `") {
+						Name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name": "Bob",
+						},
+						{
+							"Name": "John",
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_WithLastAndAfter_ReturnsLastDocumentsAfter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with last and after",
+		Actions: append(
+			cursorTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(last: 3, after: "` + bobCursor + `") {
+						Name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name": "John",
+						},
+						{
+							"Name": "Alice",
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_WithLastAndDocIDOrderDesc_ReturnsLastPage(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with last and docID order descending",
+		Actions: append(
+			cursorTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(order: {_docID: DESC}, last: 2) {
+						Name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name": "Bob",
+						},
+						{
+							"Name": "Carlo",
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_WithAfterAndBefore_ReturnsDocumentsBetween(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with after and before",
+		Actions: append(
+			cursorTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(after: "` + carloCursor + `", before: "` + aliceCursor + `") {
+						Name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name": "Bob",
+						},
+						{
+							"Name": "John",
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_WithOrderAndAfter_ReturnsNextPageInOrder(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with order, first and after",
+		Actions: append(
+			cursorTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(order: {Age: DESC}, first: 1, after: "` + bobAgeDescCursor + `") {
+						Name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name": "John",
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_WithDocumentCreatedBeforeCursor_ReturnsStablePage(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with after, with a document created before the cursor",
+		Actions: append(
+			cursorTestActions(),
+			testUtils.CreateDoc{
+				// this document is ordered before Bob
+				Doc: `{
+					"Name": "Dave",
+					"Age": 40
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(order: {Age: DESC}, after: "` + bobAgeDescCursor + `") {
+						Name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name": "John",
+						},
+						{
+							"Name": "Alice",
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_WithMalformedCursor_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with a malformed cursor",
+		Actions: append(
+			cursorTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(first: 1, after: "not a cursor") {
+						Name
+					}
+				}`,
+				ExpectedError: "invalid cursor",
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_WithCursorOfOtherOrder_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with a cursor returned by a select with another order",
+		Actions: append(
+			cursorTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(order: {Age: DESC}, first: 1, after: "` + bobCursor + `") {
+						Name
+					}
+				}`,
+				ExpectedError: "invalid cursor",
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_WithGroupBy_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with first and groupBy",
+		Actions: append(
+			cursorTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(groupBy: [Name], first: 1) {
+						Name
+					}
+				}`,
+				ExpectedError: "cursor pagination cannot be used together with groupBy",
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_WithCursorFieldAndGroupBy_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with the cursor field and groupBy",
+		Actions: append(
+			cursorTestActions(),
+			testUtils.Request{
+				// grouped documents have no docID, so they have no cursor
+				Request: `query {
+					Users(groupBy: [Name]) {
+						Name
+						_cursor
+					}
+				}`,
+				ExpectedError: "cannot select a non-group-by field at group-level. Field: _cursor",
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
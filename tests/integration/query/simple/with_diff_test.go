@@ -0,0 +1,342 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+// diffTestActions returns the actions creating the history queried by the diff tests.
+//
+// The versions of John are:
+// - bafyreia2vlbfkcbyogdjzmbqcjneabwwwtw7ti2xbd7yor5mbu2sk4pcoy the creation
+// - bafyreiffqimuwgyiv75m4mhkxfzct5y5ixiy5hyllfikek7cv3o5bdgzny the update of name and verified
+// - bafyreids3daafexnitg5iyn47ved2pckhcoez53xvjmubwhutfn4rajroq the update of age
+//
+// The creation of Fred is bafyreicu7yh2232x3iujetrit3fr5srlbl2ky4pnrjgdu4gwha3qbsxxb4.
+func diffTestActions() []any {
+	return []any{
+		testUtils.SchemaUpdate{
+			Schema: `
+				type Users {
+					name: String
+					age: Int
+					verified: Boolean
+				}
+			`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"name": "John",
+				"age": 21
+			}`,
+		},
+		testUtils.UpdateDoc{
+			DocID: 0,
+			Doc: `{
+				"name": "Johnn",
+				"verified": true
+			}`,
+		},
+		testUtils.UpdateDoc{
+			DocID: 0,
+			Doc: `{
+				"age": 22
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"name": "Fred",
+				"age": 21
+			}`,
+		},
+	}
+}
+
+func TestQuerySimpleWithDiff_BetweenTwoVersions(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with diff between two versions",
+		Actions: append(
+			diffTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(docID: "bae-c9fb0fa4-1195-589c-aa54-e68333fb90b3") {
+						name
+						_diff(
+							from: "bafyreia2vlbfkcbyogdjzmbqcjneabwwwtw7ti2xbd7yor5mbu2sk4pcoy",
+							to: "bafyreiffqimuwgyiv75m4mhkxfzct5y5ixiy5hyllfikek7cv3o5bdgzny"
+						) {
+							fieldName
+							old
+							new
+						}
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name": "Johnn",
+							"_diff": []map[string]any{
+								{
+									"fieldName": "name",
+									"old":       "John",
+									"new":       "Johnn",
+								},
+								{
+									"fieldName": "verified",
+									"old":       nil,
+									"new":       true,
+								},
+							},
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithDiff_WithoutTo_ComparesToCurrentVersion(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with diff without to argument",
+		Actions: append(
+			diffTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(docID: "bae-c9fb0fa4-1195-589c-aa54-e68333fb90b3") {
+						_diff(from: "bafyreiffqimuwgyiv75m4mhkxfzct5y5ixiy5hyllfikek7cv3o5bdgzny") {
+							fieldName
+							old
+							new
+						}
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"_diff": []map[string]any{
+								{
+									"fieldName": "age",
+									"old":       int64(21),
+									"new":       int64(22),
+								},
+							},
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithDiff_FromNewerVersion_ReturnsReversedChanges(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with diff from a newer to an older version",
+		Actions: append(
+			diffTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(docID: "bae-c9fb0fa4-1195-589c-aa54-e68333fb90b3") {
+						_diff(
+							from: "bafyreids3daafexnitg5iyn47ved2pckhcoez53xvjmubwhutfn4rajroq",
+							to: "bafyreia2vlbfkcbyogdjzmbqcjneabwwwtw7ti2xbd7yor5mbu2sk4pcoy"
+						) {
+							fieldName
+							old
+							new
+						}
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"_diff": []map[string]any{
+								{
+									"fieldName": "age",
+									"old":       int64(22),
+									"new":       int64(21),
+								},
+								{
+									"fieldName": "name",
+									"old":       "Johnn",
+									"new":       "John",
+								},
+								{
+									"fieldName": "verified",
+									"old":       true,
+									"new":       nil,
+								},
+							},
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithDiff_WithSameVersion_ReturnsNoChanges(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with diff between the same version",
+		Actions: append(
+			diffTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(docID: "bae-c9fb0fa4-1195-589c-aa54-e68333fb90b3") {
+						_diff(
+							from: "bafyreids3daafexnitg5iyn47ved2pckhcoez53xvjmubwhutfn4rajroq",
+							to: "bafyreids3daafexnitg5iyn47ved2pckhcoez53xvjmubwhutfn4rajroq"
+						) {
+							fieldName
+						}
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"_diff": []map[string]any{},
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithDiff_WithVersionOfOtherDocument_ReturnsNoChanges(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with diff from a version of another document",
+		Actions: append(
+			diffTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users(docID: "bae-c9fb0fa4-1195-589c-aa54-e68333fb90b3") {
+						_diff(from: "bafyreicu7yh2232x3iujetrit3fr5srlbl2ky4pnrjgdu4gwha3qbsxxb4") {
+							fieldName
+						}
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"_diff": []map[string]any{},
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithDiff_OnCollection_ReturnsChangesOfVersionDocument(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with diff over all the documents of the collection",
+		Actions: append(
+			diffTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						_diff(from: "bafyreia2vlbfkcbyogdjzmbqcjneabwwwtw7ti2xbd7yor5mbu2sk4pcoy") {
+							fieldName
+							old
+							new
+						}
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"name":  "Fred",
+							"_diff": []map[string]any{},
+						},
+						{
+							"name": "Johnn",
+							"_diff": []map[string]any{
+								{
+									"fieldName": "age",
+									"old":       int64(21),
+									"new":       int64(22),
+								},
+								{
+									"fieldName": "name",
+									"old":       "John",
+									"new":       "Johnn",
+								},
+								{
+									"fieldName": "verified",
+									"old":       nil,
+									"new":       true,
+								},
+							},
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithDiff_WithUnknownVersion_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with diff from a version that does not exist",
+		Actions: append(
+			diffTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users {
+						_diff(from: "bafyreibmx7ohkhjhjhfs7sbtjm2ahrxjynfb3zwwh6acunmj4ffd3vbnoq") {
+							fieldName
+						}
+					}
+				}`,
+				ExpectedError: "the given version is not a version of the document",
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithDiff_WithInvalidCid_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with diff from an invalid cid",
+		Actions: append(
+			diffTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Users {
+						_diff(from: "invalid") {
+							fieldName
+						}
+					}
+				}`,
+				ExpectedError: "invalid cid",
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
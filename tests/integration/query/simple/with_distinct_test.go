@@ -0,0 +1,326 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQuerySimpleWithDistinct_OnSingleField_ReturnsOneDocPerValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with distinct on a single field",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 32
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Alice",
+					"Age": 19
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(distinct: [Age]) {
+						Age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Age": int64(32),
+						},
+						{
+							"Age": int64(19),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithDistinct_WithOtherFieldsSelected_ReturnsFirstDocOfEachValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with distinct, selecting fields that are not distinct",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 32
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Alice",
+					"Age": 19
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(distinct: [Age]) {
+						Name
+						Age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name": "Bob",
+							"Age":  int64(32),
+						},
+						{
+							"Name": "Alice",
+							"Age":  int64(19),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithDistinct_OnMultipleFields_ReturnsOneDocPerCombination(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with distinct on multiple fields",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 32,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Carlo",
+					"Age": 32,
+					"Verified": false
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Alice",
+					"Age": 19,
+					"Verified": true
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(distinct: [Age, Verified], order: [{Age: ASC}, {Verified: ASC}]) {
+						Age
+						Verified
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Age":      int64(19),
+							"Verified": true,
+						},
+						{
+							"Age":      int64(32),
+							"Verified": false,
+						},
+						{
+							"Age":      int64(32),
+							"Verified": true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithDistinct_WithNilValues_ReturnsNilAsAValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with distinct, nil values are a distinct value",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 32
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Alice"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(distinct: [Age], order: {Age: ASC}) {
+						Age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Age": nil,
+						},
+						{
+							"Age": int64(32),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithDistinct_WithFilterAndLimit_AppliesBoth(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with distinct, filter and limit",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 32
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Alice",
+					"Age": 19
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Carlo",
+					"Age": 55
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(distinct: [Age], filter: {Age: {_gt: 20}}, order: {Age: DESC}, limit: 1) {
+						Age
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Age": int64(55),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithDistinct_WithDistinctFieldNotSelected_ReturnsFirstDocOfEachValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with distinct on a field that is not selected",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 32
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Alice",
+					"Age": 19
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(distinct: [Age]) {
+						Name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name": "Bob",
+						},
+						{
+							"Name": "Alice",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithDistinct_WithGroupBy_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with distinct and groupBy",
+		Actions: []any{
+			testUtils.Request{
+				Request: `query {
+					Users(distinct: [Age], groupBy: [Age]) {
+						Age
+					}
+				}`,
+				ExpectedError: "distinct cannot be used together with groupBy",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
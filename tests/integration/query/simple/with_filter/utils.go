@@ -26,6 +26,13 @@ var userCollectionGQLSchema = (`
 	}
 `)
 
+var shopCollectionGQLSchema = (`
+	type Shop {
+		name: String
+		location: GeoPoint
+	}
+`)
+
 func executeTestCase(t *testing.T, test testUtils.TestCase) {
 	testUtils.ExecuteTestCase(
 		t,
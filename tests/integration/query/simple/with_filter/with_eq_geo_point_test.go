@@ -0,0 +1,165 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQuerySimpleWithGeoPointEqualsFilterBlock(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: shopCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Paris",
+					"location": {"lat": 48.8566, "lon": 2.3522}
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Versailles",
+					"location": {"lat": 48.8049, "lon": 2.1204}
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "London",
+					"location": {"lat": 51.5074, "lon": -0.1278}
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Nowhere"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Shop(filter: {location: {_eq: {lat: 51.5074, lon: -0.1278}}}) {
+						name
+						location
+					}
+				}`,
+				Results: map[string]any{
+					"Shop": []map[string]any{
+						{
+							"name":     "London",
+							"location": client.GeoPoint{Lat: 51.5074, Lon: -0.1278},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithGeoPointEqualsNilFilterBlock(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: shopCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Paris",
+					"location": {"lat": 48.8566, "lon": 2.3522}
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Versailles",
+					"location": {"lat": 48.8049, "lon": 2.1204}
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "London",
+					"location": {"lat": 51.5074, "lon": -0.1278}
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Nowhere"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Shop(filter: {location: {_eq: null}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Shop": []map[string]any{
+						{"name": "Nowhere"},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithGeoPointNotEqualsFilterBlock(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: shopCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Paris",
+					"location": {"lat": 48.8566, "lon": 2.3522}
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Versailles",
+					"location": {"lat": 48.8049, "lon": 2.1204}
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "London",
+					"location": {"lat": 51.5074, "lon": -0.1278}
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Nowhere"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Shop(filter: {location: {_ne: {lat: 51.5074, lon: -0.1278}}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Shop": []map[string]any{
+						{"name": "Versailles"},
+						{"name": "Paris"},
+						{"name": "Nowhere"},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,240 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+var productCollectionGQLSchema = (`
+	type Product {
+		name: String
+		stock: Int @index
+		reorderLevel: Int
+		price: Float
+		publishedAt: DateTime
+		updatedAt: DateTime
+	}
+`)
+
+func createFieldReferenceTestDocs() []any {
+	return []any{
+		testUtils.SchemaUpdate{
+			Schema: productCollectionGQLSchema,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"name": "Pencil",
+				"stock": 3,
+				"reorderLevel": 10,
+				"price": 1.5,
+				"publishedAt": "2024-01-01T00:00:00Z",
+				"updatedAt": "2024-03-01T00:00:00Z"
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"name": "Notebook",
+				"stock": 25,
+				"reorderLevel": 10,
+				"price": 4,
+				"publishedAt": "2024-02-01T00:00:00Z",
+				"updatedAt": "2024-02-01T00:00:00Z"
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"name": "Eraser",
+				"stock": 0,
+				"reorderLevel": 5,
+				"price": 0.5,
+				"publishedAt": "2024-01-15T00:00:00Z"
+			}`,
+		},
+	}
+}
+
+func TestQuerySimple_WithFieldReferenceLessThanFilter_ShouldCompareFields(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with a filter comparing a field with another field",
+		Actions: append(
+			createFieldReferenceTestDocs(),
+			testUtils.Request{
+				Request: `query {
+					Product(filter: {stock: {_lt: {_field: "reorderLevel"}}}, order: {name: ASC}) {
+						name
+						stock
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{
+							"name":  "Eraser",
+							"stock": int64(0),
+						},
+						{
+							"name":  "Pencil",
+							"stock": int64(3),
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithFieldReferenceAndValueFilter_ShouldCompareBoth(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with a filter comparing a field with another field and a value",
+		Actions: append(
+			createFieldReferenceTestDocs(),
+			testUtils.Request{
+				Request: `query {
+					Product(filter: {stock: {_gt: 0, _lt: {_field: "reorderLevel"}}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{
+							"name": "Pencil",
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithFieldReferenceOfOtherTypeFilter_ShouldCompareFields(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with a filter comparing a float field with an int field",
+		Actions: append(
+			createFieldReferenceTestDocs(),
+			testUtils.Request{
+				Request: `query {
+					Product(filter: {price: {_lt: {_field: "stock"}}}, order: {name: ASC}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{
+							"name": "Notebook",
+						},
+						{
+							"name": "Pencil",
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithDateTimeFieldReferenceFilter_ShouldCompareFields(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with a filter comparing two datetime fields",
+		Actions: append(
+			createFieldReferenceTestDocs(),
+			testUtils.Request{
+				Request: `query {
+					Product(filter: {updatedAt: {_gt: {_field: "publishedAt"}}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{
+							"name": "Pencil",
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithFieldReferenceEqualFilterWithinNot_ShouldCompareFields(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with a negated filter comparing two fields for equality",
+		Actions: append(
+			createFieldReferenceTestDocs(),
+			testUtils.Request{
+				Request: `query {
+					Product(filter: {_not: {updatedAt: {_eq: {_field: "publishedAt"}}}}, order: {name: ASC}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{
+							"name": "Eraser",
+						},
+						{
+							"name": "Pencil",
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithUnknownFieldReferenceFilter_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with a filter comparing a field with a field that does not exist",
+		Actions: append(
+			createFieldReferenceTestDocs(),
+			testUtils.Request{
+				Request: `query {
+					Product(filter: {stock: {_lt: {_field: "minimum"}}}) {
+						name
+					}
+				}`,
+				ExpectedError: "referenced field not found",
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithParentFieldReferenceFilter_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with a filter comparing a field with a field of a parent that does not exist",
+		Actions: append(
+			createFieldReferenceTestDocs(),
+			testUtils.Request{
+				Request: `query {
+					Product(filter: {stock: {_lt: {_parentField: "reorderLevel"}}}) {
+						name
+					}
+				}`,
+				ExpectedError: "parent fields can only be referenced in the filter of a related object",
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,128 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQuerySimple_WithNotRegexFilter_ShouldMatchString(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with not-regex filter",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Daenerys Stormborn of House Targaryen, the First of Her Name",
+					"HeightM": 1.65
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Viserys I Targaryen, King of the Andals",
+					"HeightM": 1.82
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Name: {_nregex: {pattern: "Storm(born|bringer)"}}}) {
+						Name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name": "Viserys I Targaryen, King of the Andals",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimple_WithNotRegexFilterNoMatches_ShouldReturnAll(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with not-regex filter matching no documents",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Daenerys Stormborn of House Targaryen, the First of Her Name",
+					"HeightM": 1.65
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Viserys I Targaryen, King of the Andals",
+					"HeightM": 1.82
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Name: {_nregex: {pattern: "Lannister"}}}) {
+						Name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name": "Viserys I Targaryen, King of the Andals",
+						},
+						{
+							"Name": "Daenerys Stormborn of House Targaryen, the First of Her Name",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimple_WithCaseInsensitiveNotRegexFilter_ShouldMatchString(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with case insensitive not-regex filter",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Daenerys Stormborn of House Targaryen, the First of Her Name",
+					"HeightM": 1.65
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Viserys I Targaryen, King of the Andals",
+					"HeightM": 1.82
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Name: {_nregex: {pattern: "stormborn", caseInsensitive: true}}}) {
+						Name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name": "Viserys I Targaryen, King of the Andals",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
@@ -0,0 +1,223 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQuerySimple_WithRegexFilter_ShouldMatchString(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with regex filter",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Daenerys Stormborn of House Targaryen, the First of Her Name",
+					"HeightM": 1.65
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Viserys I Targaryen, King of the Andals",
+					"HeightM": 1.82
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Name: {_regex: {pattern: "Storm(born|bringer)"}}}) {
+						Name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name": "Daenerys Stormborn of House Targaryen, the First of Her Name",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimple_WithAnchoredRegexFilter_ShouldMatchString(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with regex filter anchored at the start",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Daenerys Stormborn of House Targaryen, the First of Her Name",
+					"HeightM": 1.65
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Viserys I Targaryen, King of the Andals",
+					"HeightM": 1.82
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Name: {_regex: {pattern: "^Viserys [IVX]+ "}}}) {
+						Name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name": "Viserys I Targaryen, King of the Andals",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimple_WithRegexFilterNoMatches_ShouldReturnEmpty(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with regex filter with no matches",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Daenerys Stormborn of House Targaryen, the First of Her Name",
+					"HeightM": 1.65
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Viserys I Targaryen, King of the Andals",
+					"HeightM": 1.82
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Name: {_regex: {pattern: "^Targaryen"}}}) {
+						Name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimple_WithRegexFilterInlineFlag_ShouldMatchString(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with regex filter with inline case insensitive flag",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Daenerys Stormborn of House Targaryen, the First of Her Name",
+					"HeightM": 1.65
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Viserys I Targaryen, King of the Andals",
+					"HeightM": 1.82
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Name: {_regex: {pattern: "(?i)^viserys"}}}) {
+						Name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name": "Viserys I Targaryen, King of the Andals",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimple_WithCaseInsensitiveRegexFilter_ShouldMatchString(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with case insensitive regex filter",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Daenerys Stormborn of House Targaryen, the First of Her Name",
+					"HeightM": 1.65
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Viserys I Targaryen, King of the Andals",
+					"HeightM": 1.82
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Name: {_regex: {pattern: "stormborn", caseInsensitive: true}}}) {
+						Name
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name": "Daenerys Stormborn of House Targaryen, the First of Her Name",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimple_WithInvalidRegexFilter_ShouldError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with invalid regex filter",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Daenerys Stormborn of House Targaryen, the First of Her Name",
+					"HeightM": 1.65
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Viserys I Targaryen, King of the Andals",
+					"HeightM": 1.82
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Name: {_regex: {pattern: "Storm(born"}}}) {
+						Name
+					}
+				}`,
+				ExpectedError: "invalid regular expression",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
@@ -0,0 +1,156 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQuerySimpleWithWithinBoxFilterBlock(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: shopCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Paris",
+					"location": {"lat": 48.8566, "lon": 2.3522}
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Versailles",
+					"location": {"lat": 48.8049, "lon": 2.1204}
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "London",
+					"location": {"lat": 51.5074, "lon": -0.1278}
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Nowhere"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Shop(filter: {location: {_withinBox: {minLat: 48, minLon: -1, maxLat: 52, maxLon: 2.2}}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Shop": []map[string]any{
+						{"name": "London"},
+						{"name": "Versailles"},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithWithinBoxFilterBlock_WithNotFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: shopCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Paris",
+					"location": {"lat": 48.8566, "lon": 2.3522}
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Versailles",
+					"location": {"lat": 48.8049, "lon": 2.1204}
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "London",
+					"location": {"lat": 51.5074, "lon": -0.1278}
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Nowhere"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Shop(filter: {_not: {location: {_withinBox: {minLat: 48, minLon: -1, maxLat: 52, maxLon: 2.2}}}}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Shop": []map[string]any{
+						{"name": "Paris"},
+						{"name": "Nowhere"},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithWithinBoxFilterBlock_WithInvertedLatitudes_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: shopCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Paris",
+					"location": {"lat": 48.8566, "lon": 2.3522}
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Versailles",
+					"location": {"lat": 48.8049, "lon": 2.1204}
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "London",
+					"location": {"lat": 51.5074, "lon": -0.1278}
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Nowhere"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Shop(filter: {location: {_withinBox: {minLat: 52, minLon: -1, maxLat: 48, maxLon: 2.2}}}) {
+						name
+					}
+				}`,
+				ExpectedError: "invalid geo area",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,246 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func createGroupAggregateFilterTestDocs() []any {
+	return []any{
+		testUtils.CreateDoc{
+			Doc: `{
+				"Name": "John",
+				"Age": 32,
+				"HeightM": 1.82
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"Name": "Bob",
+				"Age": 32,
+				"HeightM": 1.65
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"Name": "Shahzad",
+				"Age": 32,
+				"HeightM": 1.70
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"Name": "Alice",
+				"Age": 19,
+				"HeightM": 1.91
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"Name": "Carlo",
+				"Age": 55,
+				"HeightM": 1.75
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"Name": "Fred",
+				"Age": 55,
+				"HeightM": 1.60
+			}`,
+		},
+	}
+}
+
+func TestQuerySimpleWithGroupByNumberWithCountFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with group by number, filtered by the count of each group",
+		Actions: append(
+			createGroupAggregateFilterTestDocs(),
+			testUtils.Request{
+				Request: `query {
+					Users(groupBy: [Age], filter: {_count: {_gt: 1}}, order: {Age: ASC}) {
+						Age
+						_count(_group: {})
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Age":    int64(32),
+							"_count": int64(3),
+						},
+						{
+							"Age":    int64(55),
+							"_count": int64(2),
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithGroupByNumberWithDocumentAndCountFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with group by number, filtered by document fields and by the count of each group",
+		Actions: append(
+			createGroupAggregateFilterTestDocs(),
+			testUtils.Request{
+				Request: `query {
+					Users(groupBy: [Age], filter: {Name: {_ne: "Fred"}, _count: {_gt: 1}}) {
+						Age
+						_count(_group: {})
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Age":    int64(32),
+							"_count": int64(3),
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithGroupByNumberWithAverageFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with group by number, filtered by the average of each group",
+		Actions: append(
+			createGroupAggregateFilterTestDocs(),
+			testUtils.Request{
+				Request: `query {
+					Users(groupBy: [Age], filter: {_avg: {_ge: 1.7}}, order: {Age: ASC}) {
+						Age
+						_avg(_group: {field: HeightM})
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Age":  int64(19),
+							"_avg": float64(1.91),
+						},
+						{
+							"Age":  int64(32),
+							"_avg": float64(1.7233333333333334),
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithGroupByNumberWithCountAndSumFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with group by number, filtered by several aggregates of each group",
+		Actions: append(
+			createGroupAggregateFilterTestDocs(),
+			testUtils.Request{
+				Request: `query {
+					Users(groupBy: [Age], filter: {_count: {_eq: 2}, _sum: {_lt: 3.4}}) {
+						Age
+						_count(_group: {})
+						_sum(_group: {field: HeightM})
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Age":    int64(55),
+							"_count": int64(2),
+							"_sum":   float64(3.35),
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithGroupByNumberWithCountFilterAndLimit(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with group by number, filtered by the count of each group, with limit",
+		Actions: append(
+			createGroupAggregateFilterTestDocs(),
+			testUtils.Request{
+				Request: `query {
+					Users(groupBy: [Age], filter: {_count: {_gt: 1}}, order: {Age: DESC}, limit: 1) {
+						Age
+						_count(_group: {})
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Age":    int64(55),
+							"_count": int64(2),
+						},
+					},
+				},
+			},
+		),
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithGroupByNumberWithCountFilterOnNonSelectedCount_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with group by number, filtered by a count that is not selected",
+		Actions: []any{
+			testUtils.Request{
+				Request: `query {
+					Users(groupBy: [Age], filter: {_count: {_gt: 1}}) {
+						Age
+					}
+				}`,
+				ExpectedError: "filtered aggregate must be selected",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithGroupByNumberWithNestedCountFilter_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with group by number, filtered by a count nested in a compound filter",
+		Actions: []any{
+			testUtils.Request{
+				Request: `query {
+					Users(groupBy: [Age], filter: {_or: [{_count: {_gt: 1}}, {Age: {_eq: 19}}]}) {
+						Age
+						_count(_group: {})
+					}
+				}`,
+				ExpectedError: "aggregates can only be filtered at the top level of a select filter",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
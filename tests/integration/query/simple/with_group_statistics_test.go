@@ -0,0 +1,121 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQuerySimpleWithGroupByStringWithoutRenderedGroupAndChildStatistics(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with group by string, statistics on non-rendered group integer value",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 32
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 38
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Alice",
+					"Age": -19
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(groupBy: [Name]) {
+						Name
+						_median(_group: {field: Age})
+						_stddev(_group: {field: Age})
+						_variance(_group: {field: Age})
+						_percentile(p: 25, _group: {field: Age})
+						_countDistinct(_group: {field: Age})
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name":           "John",
+							"_median":        float64(35),
+							"_stddev":        float64(3),
+							"_variance":      float64(9),
+							"_percentile":    float64(33.5),
+							"_countDistinct": 2,
+						},
+						{
+							"Name":           "Alice",
+							"_median":        float64(-19),
+							"_stddev":        float64(0),
+							"_variance":      float64(0),
+							"_percentile":    float64(-19),
+							"_countDistinct": 1,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithGroupByStringWithoutRenderedGroupAndChildMedianWithFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with group by string, median with filter on non-rendered group",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 32
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 38
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 40
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(groupBy: [Name]) {
+						Name
+						_median(_group: {field: Age, filter: {Age: {_gt: 33}}})
+					}
+				}`,
+				Results: map[string]any{
+					"Users": []map[string]any{
+						{
+							"Name":    "John",
+							"_median": float64(39),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
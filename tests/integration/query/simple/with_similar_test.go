@@ -0,0 +1,263 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+// similarTestActions returns the actions creating the documents queried by the _similar tests.
+//
+// The distances of the embeddings to [1, 0] are 0 for Sprint, 1 for Trail, 2 for Chelsea
+// and 5 for Beanie.
+func similarTestActions() []any {
+	return []any{
+		testUtils.SchemaUpdate{
+			Schema: `
+				type Product {
+					name: String
+					embedding: [Float!] @vector(dimension: 2)
+				}
+			`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"name": "Sprint",
+				"embedding": [1, 0]
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"name": "Trail",
+				"embedding": [1, 1]
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"name": "Beanie",
+				"embedding": [4, 4]
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"name": "Chelsea",
+				"embedding": [-1, 0]
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"name": "Gift card"
+			}`,
+		},
+	}
+}
+
+func TestQuerySimpleWithSimilar_ShouldReturnNearest(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			similarTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Product(_similar: {field: "embedding", vector: [1, 0], k: 3}) {
+						name
+						_distance
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{"name": "Sprint", "_distance": float64(0)},
+						{"name": "Trail", "_distance": float64(1)},
+						{"name": "Chelsea", "_distance": float64(2)},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithSimilar_WithKGreaterThanDocCount_ShouldReturnAllDocsWithVector(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			similarTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Product(_similar: {field: "embedding", vector: [1, 0], k: 10}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{"name": "Sprint"},
+						{"name": "Trail"},
+						{"name": "Chelsea"},
+						{"name": "Beanie"},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithSimilar_WithFilter_ShouldReturnNearestMatching(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			similarTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Product(
+						_similar: {field: "embedding", vector: [1, 0], k: 2},
+						filter: {name: {_ne: "Sprint"}}
+					) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{"name": "Trail"},
+						{"name": "Chelsea"},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithSimilar_WithOrderByDistanceDesc_ShouldReturnFarthestFirst(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			similarTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Product(_similar: {field: "embedding", vector: [1, 0], k: 3}, order: {_distance: DESC}) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{"name": "Chelsea"},
+						{"name": "Trail"},
+						{"name": "Sprint"},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithSimilar_WithLimit_ShouldLimitNearest(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			similarTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Product(_similar: {field: "embedding", vector: [1, 0], k: 3}, limit: 1, offset: 1) {
+						name
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{"name": "Trail"},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithoutSimilar_ShouldNotSetDistance(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			similarTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Product(filter: {name: {_eq: "Sprint"}}) {
+						embedding
+						_distance
+					}
+				}`,
+				Results: map[string]any{
+					"Product": []map[string]any{
+						{"embedding": []float64{1, 0}, "_distance": nil},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithSimilar_WithWrongDimension_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			similarTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Product(_similar: {field: "embedding", vector: [1, 0, 0], k: 3}) {
+						name
+					}
+				}`,
+				ExpectedError: client.NewErrVectorDimensionMismatch("embedding", 2, 3).Error(),
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithSimilar_OnNonVectorField_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			similarTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Product(_similar: {field: "name", vector: [1, 0], k: 3}) {
+						name
+					}
+				}`,
+				ExpectedError: "_similar field must be a vector field. Field: name",
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithSimilar_WithZeroK_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: append(
+			similarTestActions(),
+			testUtils.Request{
+				Request: `query {
+					Product(_similar: {field: "embedding", vector: [1, 0], k: 0}) {
+						name
+					}
+				}`,
+				ExpectedError: "_similar k must be greater than zero",
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
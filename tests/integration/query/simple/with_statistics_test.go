@@ -0,0 +1,536 @@
+// Copyright 2026 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"math"
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQuerySimpleWithStatisticsOnEmptyCollection(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query, statistics on empty collection",
+		Actions: []any{
+			testUtils.Request{
+				Request: `query {
+					_median(Users: {field: Age})
+					_stddev(Users: {field: Age})
+					_variance(Users: {field: Age})
+					_percentile(p: 90, Users: {field: Age})
+					_countDistinct(Users: {field: Age})
+				}`,
+				Results: map[string]any{
+					"_median":        nil,
+					"_stddev":        nil,
+					"_variance":      nil,
+					"_percentile":    nil,
+					"_countDistinct": 0,
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithMedian(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query, median",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 28
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob",
+					"Age": 30
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Alice",
+					"Age": 35
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Fred",
+					"Age": 35
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					_median(Users: {field: Age})
+				}`,
+				Results: map[string]any{
+					"_median": float64(32.5),
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithMedianWithFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query, median with filter",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 28
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob",
+					"Age": 30
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Alice",
+					"Age": 35
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Fred",
+					"Age": 35
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					_median(Users: {field: Age, filter: {Age: {_gt: 28}}})
+				}`,
+				Results: map[string]any{
+					"_median": float64(35),
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithVariance(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query, variance",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 28
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob",
+					"Age": 30
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Alice",
+					"Age": 35
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Fred",
+					"Age": 35
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					_variance(Users: {field: Age})
+				}`,
+				Results: map[string]any{
+					"_variance": float64(9.5),
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithStdDev(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query, standard deviation",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 28
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob",
+					"Age": 30
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Alice",
+					"Age": 35
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Fred",
+					"Age": 35
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					_stddev(Users: {field: Age})
+				}`,
+				Results: map[string]any{
+					"_stddev": math.Sqrt(9.5),
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithPercentile(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query, percentile",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 28
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob",
+					"Age": 30
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Alice",
+					"Age": 35
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Fred",
+					"Age": 35
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					_percentile(p: 25, Users: {field: Age})
+				}`,
+				Results: map[string]any{
+					"_percentile": float64(29.5),
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithPercentileBounds(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query, lowest and highest percentiles",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 28
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob",
+					"Age": 30
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Alice",
+					"Age": 35
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Fred",
+					"Age": 35
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					_percentile(p: 0, Users: {field: Age})
+				}`,
+				Results: map[string]any{
+					"_percentile": float64(28),
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					_percentile(p: 100, Users: {field: Age})
+				}`,
+				Results: map[string]any{
+					"_percentile": float64(35),
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithPercentileOutOfRange_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query, percentile out of range",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 28
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob",
+					"Age": 30
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Alice",
+					"Age": 35
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Fred",
+					"Age": 35
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					_percentile(p: 101, Users: {field: Age})
+				}`,
+				ExpectedError: "percentile must be between 0 and 100",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithPercentileWithoutPercentile_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query, percentile without percentile argument",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 28
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob",
+					"Age": 30
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Alice",
+					"Age": 35
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Fred",
+					"Age": 35
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					_percentile(Users: {field: Age})
+				}`,
+				ExpectedError: "Field \"_percentile\" argument \"p\" of type \"Float!\" is required but not provided.",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithCountDistinct(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query, count distinct",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 28
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob",
+					"Age": 30
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Alice",
+					"Age": 35
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Fred",
+					"Age": 35
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					_countDistinct(Users: {field: Age})
+				}`,
+				Results: map[string]any{
+					"_countDistinct": 3,
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithCountDistinctOfNonNumericFields(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query, count distinct of string, boolean, datetime and docID fields",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Verified": true,
+					"CreatedAt": "2017-07-23T03:46:56-05:00"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob",
+					"Verified": true,
+					"CreatedAt": "2017-07-23T03:46:56-05:00"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Verified": false,
+					"CreatedAt": "2019-07-23T03:46:56-05:00"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Fred"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					_countDistinct(Users: {field: Name})
+				}`,
+				Results: map[string]any{
+					"_countDistinct": 3,
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					_countDistinct(Users: {field: Verified})
+				}`,
+				Results: map[string]any{
+					"_countDistinct": 2,
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					_countDistinct(Users: {field: CreatedAt})
+				}`,
+				Results: map[string]any{
+					"_countDistinct": 2,
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					_countDistinct(Users: {field: _docID})
+				}`,
+				Results: map[string]any{
+					"_countDistinct": 4,
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithCountDistinctOfStringFieldWithFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query, count distinct of string field with filter",
+		Actions: []any{
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 28
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 35
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob",
+					"Age": 30
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Alice",
+					"Age": 19
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					_countDistinct(Users: {field: Name, filter: {Age: {_gt: 20}}})
+				}`,
+				Results: map[string]any{
+					"_countDistinct": 2,
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
@@ -148,6 +148,8 @@ func areResultsEqual(expected any, actual any) bool {
 		return areResultArraysEqual(expectedVal, actual)
 	case time.Time:
 		return areResultsEqual(expectedVal.Format(time.RFC3339Nano), actual)
+	case client.GeoPoint:
+		return areResultsEqual(expectedVal.ToMap(), actual)
 	default:
 		return assert.ObjectsAreEqualValues(expected, actual)
 	}
@@ -83,6 +83,46 @@ func TestSchemaAggregateInlineArrayCreatesUsersCount(t *testing.T) {
 											},
 										},
 									},
+									map[string]any{
+										"name": "_conflicts",
+										"type": map[string]any{
+											"name": "Users___conflicts__CountSelector",
+											"inputFields": []any{
+												map[string]any{
+													"name": "limit",
+													"type": map[string]any{
+														"name": "Int",
+													},
+												},
+												map[string]any{
+													"name": "offset",
+													"type": map[string]any{
+														"name": "Int",
+													},
+												},
+											},
+										},
+									},
+									map[string]any{
+										"name": "_diff",
+										"type": map[string]any{
+											"name": "Users___diff__CountSelector",
+											"inputFields": []any{
+												map[string]any{
+													"name": "limit",
+													"type": map[string]any{
+														"name": "Int",
+													},
+												},
+												map[string]any{
+													"name": "offset",
+													"type": map[string]any{
+														"name": "Int",
+													},
+												},
+											},
+										},
+									},
 									map[string]any{
 										"name": "_group",
 										"type": map[string]any{
@@ -411,12 +451,48 @@ func aggregateGroupArg(fieldType string) map[string]any {
 									"name": nil,
 								},
 							},
+							map[string]any{
+								"name": "_avg",
+								"type": map[string]any{
+									"name": "FloatOperatorBlock",
+								},
+							},
+							map[string]any{
+								"name": "_count",
+								"type": map[string]any{
+									"name": "IntOperatorBlock",
+								},
+							},
+							map[string]any{
+								"name": "_countDistinct",
+								"type": map[string]any{
+									"name": "IntOperatorBlock",
+								},
+							},
 							map[string]any{
 								"name": "_docID",
 								"type": map[string]any{
 									"name": "IDOperatorBlock",
 								},
 							},
+							map[string]any{
+								"name": "_max",
+								"type": map[string]any{
+									"name": "FloatOperatorBlock",
+								},
+							},
+							map[string]any{
+								"name": "_median",
+								"type": map[string]any{
+									"name": "FloatOperatorBlock",
+								},
+							},
+							map[string]any{
+								"name": "_min",
+								"type": map[string]any{
+									"name": "FloatOperatorBlock",
+								},
+							},
 							map[string]any{
 								"name": "_not",
 								"type": map[string]any{
@@ -429,6 +505,30 @@ func aggregateGroupArg(fieldType string) map[string]any {
 									"name": nil,
 								},
 							},
+							map[string]any{
+								"name": "_percentile",
+								"type": map[string]any{
+									"name": "FloatOperatorBlock",
+								},
+							},
+							map[string]any{
+								"name": "_stddev",
+								"type": map[string]any{
+									"name": "FloatOperatorBlock",
+								},
+							},
+							map[string]any{
+								"name": "_sum",
+								"type": map[string]any{
+									"name": "FloatOperatorBlock",
+								},
+							},
+							map[string]any{
+								"name": "_variance",
+								"type": map[string]any{
+									"name": "FloatOperatorBlock",
+								},
+							},
 						},
 					},
 				},
@@ -451,6 +551,52 @@ func aggregateGroupArg(fieldType string) map[string]any {
 	}
 }
 
+var aggregateConflictsArg = map[string]any{
+	"name": "_conflicts",
+	"type": map[string]any{
+		"name": "Users___conflicts__CountSelector",
+		"inputFields": []any{
+			map[string]any{
+				"name": "limit",
+				"type": map[string]any{
+					"name":        "Int",
+					"inputFields": nil,
+				},
+			},
+			map[string]any{
+				"name": "offset",
+				"type": map[string]any{
+					"name":        "Int",
+					"inputFields": nil,
+				},
+			},
+		},
+	},
+}
+
+var aggregateDiffArg = map[string]any{
+	"name": "_diff",
+	"type": map[string]any{
+		"name": "Users___diff__CountSelector",
+		"inputFields": []any{
+			map[string]any{
+				"name": "limit",
+				"type": map[string]any{
+					"name":        "Int",
+					"inputFields": nil,
+				},
+			},
+			map[string]any{
+				"name": "offset",
+				"type": map[string]any{
+					"name":        "Int",
+					"inputFields": nil,
+				},
+			},
+		},
+	},
+}
+
 var aggregateVersionArg = map[string]any{
 	"name": "_version",
 	"type": map[string]any{
@@ -586,6 +732,8 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableBooleanCountFilter(t *tes
 											},
 										},
 									},
+									aggregateConflictsArg,
+									aggregateDiffArg,
 									aggregateGroupArg("Boolean"),
 									aggregateVersionArg,
 								},
@@ -712,6 +860,8 @@ func TestSchemaAggregateInlineArrayCreatesUsersBooleanCountFilter(t *testing.T)
 											},
 										},
 									},
+									aggregateConflictsArg,
+									aggregateDiffArg,
 									aggregateGroupArg("NotNullBoolean"),
 									aggregateVersionArg,
 								},
@@ -862,6 +1012,8 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableIntegerCountFilter(t *tes
 											},
 										},
 									},
+									aggregateConflictsArg,
+									aggregateDiffArg,
 									aggregateGroupArg("Int"),
 									aggregateVersionArg,
 								},
@@ -1012,6 +1164,8 @@ func TestSchemaAggregateInlineArrayCreatesUsersIntegerCountFilter(t *testing.T)
 											},
 										},
 									},
+									aggregateConflictsArg,
+									aggregateDiffArg,
 									aggregateGroupArg("NotNullInt"),
 									aggregateVersionArg,
 								},
@@ -1162,6 +1316,8 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableFloatCountFilter(t *testi
 											},
 										},
 									},
+									aggregateConflictsArg,
+									aggregateDiffArg,
 									aggregateGroupArg("Float"),
 									aggregateVersionArg,
 								},
@@ -1312,6 +1468,8 @@ func TestSchemaAggregateInlineArrayCreatesUsersFloatCountFilter(t *testing.T) {
 											},
 										},
 									},
+									aggregateConflictsArg,
+									aggregateDiffArg,
 									aggregateGroupArg("NotNullFloat"),
 									aggregateVersionArg,
 								},
@@ -1436,12 +1594,30 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableStringCountFilter(t *test
 																	"name": "String",
 																},
 															},
+															map[string]any{
+																"name": "_nregex",
+																"type": map[string]any{
+																	"name": "Regex",
+																},
+															},
 															map[string]any{
 																"name": "_or",
 																"type": map[string]any{
 																	"name": nil,
 																},
 															},
+															map[string]any{
+																"name": "_regex",
+																"type": map[string]any{
+																	"name": "Regex",
+																},
+															},
+															map[string]any{
+																"name": "_search",
+																"type": map[string]any{
+																	"name": "String",
+																},
+															},
 														},
 													},
 												},
@@ -1462,6 +1638,8 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableStringCountFilter(t *test
 											},
 										},
 									},
+									aggregateConflictsArg,
+									aggregateDiffArg,
 									aggregateGroupArg("String"),
 									aggregateVersionArg,
 								},
@@ -1586,12 +1764,30 @@ func TestSchemaAggregateInlineArrayCreatesUsersStringCountFilter(t *testing.T) {
 																	"name": "String",
 																},
 															},
+															map[string]any{
+																"name": "_nregex",
+																"type": map[string]any{
+																	"name": "Regex",
+																},
+															},
 															map[string]any{
 																"name": "_or",
 																"type": map[string]any{
 																	"name": nil,
 																},
 															},
+															map[string]any{
+																"name": "_regex",
+																"type": map[string]any{
+																	"name": "Regex",
+																},
+															},
+															map[string]any{
+																"name": "_search",
+																"type": map[string]any{
+																	"name": "String",
+																},
+															},
 														},
 													},
 												},
@@ -1612,6 +1808,8 @@ func TestSchemaAggregateInlineArrayCreatesUsersStringCountFilter(t *testing.T) {
 											},
 										},
 									},
+									aggregateConflictsArg,
+									aggregateDiffArg,
 									aggregateGroupArg("NotNullString"),
 									aggregateVersionArg,
 								},
@@ -54,6 +54,46 @@ func TestSchemaAggregateSimpleCreatesUsersCount(t *testing.T) {
 							map[string]any{
 								"name": "_count",
 								"args": []any{
+									map[string]any{
+										"name": "_conflicts",
+										"type": map[string]any{
+											"name": "Users___conflicts__CountSelector",
+											"inputFields": []any{
+												map[string]any{
+													"name": "limit",
+													"type": map[string]any{
+														"name": "Int",
+													},
+												},
+												map[string]any{
+													"name": "offset",
+													"type": map[string]any{
+														"name": "Int",
+													},
+												},
+											},
+										},
+									},
+									map[string]any{
+										"name": "_diff",
+										"type": map[string]any{
+											"name": "Users___diff__CountSelector",
+											"inputFields": []any{
+												map[string]any{
+													"name": "limit",
+													"type": map[string]any{
+														"name": "Int",
+													},
+												},
+												map[string]any{
+													"name": "offset",
+													"type": map[string]any{
+														"name": "Int",
+													},
+												},
+											},
+										},
+									},
 									map[string]any{
 										"name": "_group",
 										"type": map[string]any{
@@ -225,3 +225,54 @@ func TestSchemaCreate_ContainsPCounterTypeWithWrongKind_Error(t *testing.T) {
 
 	testUtils.ExecuteTestCase(t, test)
 }
+
+func TestSchemaCreate_ContainsORSetTypeWithNonArrayKind_Error(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						tags: String @crdt(type: orset)
+					}
+				`,
+				ExpectedError: "CRDT type orset can't be assigned to field kind String",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaCreate_ContainsRGATextTypeWithNonStringKind_Error(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						notes: Int @crdt(type: rga)
+					}
+				`,
+				ExpectedError: "CRDT type rga can't be assigned to field kind Int",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaCreate_ContainsLWWMapTypeWithNonJSONKind_Error(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						settings: String @crdt(type: lwwmap)
+					}
+				`,
+				ExpectedError: "CRDT type lwwmap can't be assigned to field kind String",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
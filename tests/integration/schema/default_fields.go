@@ -66,6 +66,11 @@ var DefaultFields = concat(
 		versionField,
 		groupField,
 		deletedField,
+		scoreField,
+		distanceField,
+		cursorField,
+		conflictsField,
+		diffField,
 	},
 	aggregateFields,
 )
@@ -95,6 +100,30 @@ var deletedField = Field{
 	},
 }
 
+var scoreField = Field{
+	"name": "_score",
+	"type": map[string]any{
+		"kind": "SCALAR",
+		"name": "Float",
+	},
+}
+
+var distanceField = Field{
+	"name": "_distance",
+	"type": map[string]any{
+		"kind": "SCALAR",
+		"name": "Float",
+	},
+}
+
+var cursorField = Field{
+	"name": "_cursor",
+	"type": map[string]any{
+		"kind": "SCALAR",
+		"name": "String",
+	},
+}
+
 var versionField = Field{
 	"name": "_version",
 	"type": map[string]any{
@@ -103,6 +132,22 @@ var versionField = Field{
 	},
 }
 
+var conflictsField = Field{
+	"name": "_conflicts",
+	"type": map[string]any{
+		"kind": "LIST",
+		"name": nil,
+	},
+}
+
+var diffField = Field{
+	"name": "_diff",
+	"type": map[string]any{
+		"kind": "LIST",
+		"name": nil,
+	},
+}
+
 var groupField = Field{
 	"name": "_group",
 	"type": map[string]any{
@@ -147,8 +192,85 @@ var aggregateFields = fields{
 			"name": "Float",
 		},
 	},
+	map[string]any{
+		"name": "_median",
+		"type": map[string]any{
+			"kind": "SCALAR",
+			"name": "Float",
+		},
+	},
+	map[string]any{
+		"name": "_stddev",
+		"type": map[string]any{
+			"kind": "SCALAR",
+			"name": "Float",
+		},
+	},
+	map[string]any{
+		"name": "_variance",
+		"type": map[string]any{
+			"kind": "SCALAR",
+			"name": "Float",
+		},
+	},
+	map[string]any{
+		"name": "_percentile",
+		"type": map[string]any{
+			"kind": "SCALAR",
+			"name": "Float",
+		},
+	},
+	map[string]any{
+		"name": "_countDistinct",
+		"type": map[string]any{
+			"kind": "SCALAR",
+			"name": "Int",
+		},
+	},
 }
 
+var asOfArg = Field{
+	"name": request.AsOfArgName,
+	"type": map[string]any{
+		"name":        "DateTime",
+		"inputFields": nil,
+	},
+}
+var similarArg = Field{
+	"name": request.SimilarArgName,
+	"type": map[string]any{
+		"name": "SimilarArg",
+		"inputFields": []any{
+			map[string]any{
+				"name": "field",
+				"type": map[string]any{
+					"name": nil,
+					"ofType": map[string]any{
+						"name": "String",
+					},
+				},
+			},
+			map[string]any{
+				"name": "k",
+				"type": map[string]any{
+					"name": nil,
+					"ofType": map[string]any{
+						"name": "Int",
+					},
+				},
+			},
+			map[string]any{
+				"name": "vector",
+				"type": map[string]any{
+					"name": nil,
+					"ofType": map[string]any{
+						"name": nil,
+					},
+				},
+			},
+		},
+	},
+}
 var cidArg = Field{
 	"name": "cid",
 	"type": map[string]any{
@@ -187,6 +309,18 @@ var groupByArg = Field{
 	},
 }
 
+var distinctArg = Field{
+	"name": "distinct",
+	"type": map[string]any{
+		"name":        nil,
+		"inputFields": nil,
+		"ofType": map[string]any{
+			"kind": "NON_NULL",
+			"name": nil,
+		},
+	},
+}
+
 var limitArg = Field{
 	"name": "limit",
 	"type": map[string]any{
@@ -205,6 +339,42 @@ var offsetArg = Field{
 	},
 }
 
+var firstArg = Field{
+	"name": "first",
+	"type": map[string]any{
+		"name":        "Int",
+		"inputFields": nil,
+		"ofType":      nil,
+	},
+}
+
+var afterArg = Field{
+	"name": "after",
+	"type": map[string]any{
+		"name":        "String",
+		"inputFields": nil,
+		"ofType":      nil,
+	},
+}
+
+var lastArg = Field{
+	"name": "last",
+	"type": map[string]any{
+		"name":        "Int",
+		"inputFields": nil,
+		"ofType":      nil,
+	},
+}
+
+var beforeArg = Field{
+	"name": "before",
+	"type": map[string]any{
+		"name":        "String",
+		"inputFields": nil,
+		"ofType":      nil,
+	},
+}
+
 type argDef struct {
 	fieldName string
 	typeName  string
@@ -232,12 +402,22 @@ func buildFilterArg(objectName string, fields []argDef) Field {
 			"kind": "NON_NULL",
 			"name": nil,
 		}),
+		makeInputObject("_avg", "FloatOperatorBlock", nil),
+		makeInputObject("_count", "IntOperatorBlock", nil),
+		makeInputObject("_countDistinct", "IntOperatorBlock", nil),
 		makeInputObject("_docID", "IDOperatorBlock", nil),
+		makeInputObject("_max", "FloatOperatorBlock", nil),
+		makeInputObject("_median", "FloatOperatorBlock", nil),
+		makeInputObject("_min", "FloatOperatorBlock", nil),
 		makeInputObject("_not", filterArgName, nil),
 		makeInputObject("_or", nil, map[string]any{
 			"kind": "NON_NULL",
 			"name": nil,
 		}),
+		makeInputObject("_percentile", "FloatOperatorBlock", nil),
+		makeInputObject("_stddev", "FloatOperatorBlock", nil),
+		makeInputObject("_sum", "FloatOperatorBlock", nil),
+		makeInputObject("_variance", "FloatOperatorBlock", nil),
 	}
 
 	for _, field := range fields {
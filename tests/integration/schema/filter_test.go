@@ -75,6 +75,27 @@ func TestFilterForSimpleSchema(t *testing.T) {
 															},
 														},
 													},
+													map[string]any{
+														"name": "_avg",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
+													map[string]any{
+														"name": "_count",
+														"type": map[string]any{
+															"name":   "IntOperatorBlock",
+															"ofType": nil,
+														},
+													},
+													map[string]any{
+														"name": "_countDistinct",
+														"type": map[string]any{
+															"name":   "IntOperatorBlock",
+															"ofType": nil,
+														},
+													},
 													map[string]any{
 														"name": "_docID",
 														"type": map[string]any{
@@ -82,6 +103,27 @@ func TestFilterForSimpleSchema(t *testing.T) {
 															"ofType": nil,
 														},
 													},
+													map[string]any{
+														"name": "_max",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
+													map[string]any{
+														"name": "_median",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
+													map[string]any{
+														"name": "_min",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
 													map[string]any{
 														"name": "_not",
 														"type": map[string]any{
@@ -98,6 +140,34 @@ func TestFilterForSimpleSchema(t *testing.T) {
 															},
 														},
 													},
+													map[string]any{
+														"name": "_percentile",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
+													map[string]any{
+														"name": "_stddev",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
+													map[string]any{
+														"name": "_sum",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
+													map[string]any{
+														"name": "_variance",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
 													map[string]any{
 														"name": "name",
 														"type": map[string]any{
@@ -131,12 +201,19 @@ var testFilterForSimpleSchemaArgProps = map[string]any{
 
 var defaultUserArgsWithoutFilter = trimFields(
 	fields{
+		asOfArg,
+		similarArg,
 		cidArg,
 		docIDArg,
 		showDeletedArg,
 		groupByArg,
+		distinctArg,
 		limitArg,
 		offsetArg,
+		firstArg,
+		afterArg,
+		lastArg,
+		beforeArg,
 		buildOrderArg("Users"),
 	},
 	testFilterForSimpleSchemaArgProps,
@@ -207,6 +284,27 @@ func TestFilterForOneToOneSchema(t *testing.T) {
 															},
 														},
 													},
+													map[string]any{
+														"name": "_avg",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
+													map[string]any{
+														"name": "_count",
+														"type": map[string]any{
+															"name":   "IntOperatorBlock",
+															"ofType": nil,
+														},
+													},
+													map[string]any{
+														"name": "_countDistinct",
+														"type": map[string]any{
+															"name":   "IntOperatorBlock",
+															"ofType": nil,
+														},
+													},
 													map[string]any{
 														"name": "_docID",
 														"type": map[string]any{
@@ -214,6 +312,27 @@ func TestFilterForOneToOneSchema(t *testing.T) {
 															"ofType": nil,
 														},
 													},
+													map[string]any{
+														"name": "_max",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
+													map[string]any{
+														"name": "_median",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
+													map[string]any{
+														"name": "_min",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
 													map[string]any{
 														"name": "_not",
 														"type": map[string]any{
@@ -230,6 +349,34 @@ func TestFilterForOneToOneSchema(t *testing.T) {
 															},
 														},
 													},
+													map[string]any{
+														"name": "_percentile",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
+													map[string]any{
+														"name": "_stddev",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
+													map[string]any{
+														"name": "_sum",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
+													map[string]any{
+														"name": "_variance",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
 													map[string]any{
 														"name": "author",
 														"type": map[string]any{
@@ -277,12 +424,19 @@ var testFilterForOneToOneSchemaArgProps = map[string]any{
 
 var defaultBookArgsWithoutFilter = trimFields(
 	fields{
+		asOfArg,
+		similarArg,
 		cidArg,
 		docIDArg,
 		showDeletedArg,
 		groupByArg,
+		distinctArg,
 		limitArg,
 		offsetArg,
+		firstArg,
+		afterArg,
+		lastArg,
+		beforeArg,
 		buildOrderArg("Book"),
 	},
 	testFilterForOneToOneSchemaArgProps,
@@ -335,12 +489,19 @@ func TestSchemaFilterInputs_WithJSONField_Succeeds(t *testing.T) {
 										// default args without filter
 										trimFields(
 											fields{
+												asOfArg,
+												similarArg,
 												cidArg,
 												docIDArg,
 												showDeletedArg,
 												groupByArg,
+												distinctArg,
 												limitArg,
 												offsetArg,
+												firstArg,
+												afterArg,
+												lastArg,
+												beforeArg,
 												buildOrderArg("Users"),
 											},
 											map[string]any{
@@ -365,6 +526,27 @@ func TestSchemaFilterInputs_WithJSONField_Succeeds(t *testing.T) {
 															},
 														},
 													},
+													map[string]any{
+														"name": "_avg",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
+													map[string]any{
+														"name": "_count",
+														"type": map[string]any{
+															"name":   "IntOperatorBlock",
+															"ofType": nil,
+														},
+													},
+													map[string]any{
+														"name": "_countDistinct",
+														"type": map[string]any{
+															"name":   "IntOperatorBlock",
+															"ofType": nil,
+														},
+													},
 													map[string]any{
 														"name": "_docID",
 														"type": map[string]any{
@@ -372,6 +554,27 @@ func TestSchemaFilterInputs_WithJSONField_Succeeds(t *testing.T) {
 															"ofType": nil,
 														},
 													},
+													map[string]any{
+														"name": "_max",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
+													map[string]any{
+														"name": "_median",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
+													map[string]any{
+														"name": "_min",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
 													map[string]any{
 														"name": "_not",
 														"type": map[string]any{
@@ -388,6 +591,34 @@ func TestSchemaFilterInputs_WithJSONField_Succeeds(t *testing.T) {
 															},
 														},
 													},
+													map[string]any{
+														"name": "_percentile",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
+													map[string]any{
+														"name": "_stddev",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
+													map[string]any{
+														"name": "_sum",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
+													map[string]any{
+														"name": "_variance",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
 													map[string]any{
 														"name": "custom",
 														"type": map[string]any{
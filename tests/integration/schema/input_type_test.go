@@ -85,8 +85,13 @@ func TestInputTypeOfOrderFieldWhereSchemaHasManyRelationType(t *testing.T) {
 												},
 											}),
 											groupByArg,
+											distinctArg,
 											limitArg,
 											offsetArg,
+											firstArg,
+											afterArg,
+											lastArg,
+											beforeArg,
 										},
 										testInputTypeOfOrderFieldWhereSchemaHasRelationTypeArgProps,
 									),
@@ -235,8 +240,13 @@ var defaultGroupArgsWithoutOrder = trimFields(
 			},
 		}),
 		groupByArg,
+		distinctArg,
 		limitArg,
 		offsetArg,
+		firstArg,
+		afterArg,
+		lastArg,
+		beforeArg,
 	},
 	testInputTypeOfOrderFieldWhereSchemaHasRelationTypeArgProps,
 )
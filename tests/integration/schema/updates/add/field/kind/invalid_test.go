@@ -90,9 +90,9 @@ func TestSchemaUpdatesAddFieldKind15(t *testing.T) {
 
 // This test is currently the first unsupported value, if it becomes supported
 // please update this test to be the newly lowest unsupported value.
-func TestSchemaUpdatesAddFieldKind22(t *testing.T) {
+func TestSchemaUpdatesAddFieldKind23(t *testing.T) {
 	test := testUtils.TestCase{
-		Description: "Test schema update, add field with kind unsupported (22)",
+		Description: "Test schema update, add field with kind unsupported (23)",
 		Actions: []any{
 			testUtils.SchemaUpdate{
 				Schema: `
@@ -104,10 +104,10 @@ func TestSchemaUpdatesAddFieldKind22(t *testing.T) {
 			testUtils.SchemaPatch{
 				Patch: `
 					[
-						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "foo", "Kind": 22} }
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "foo", "Kind": 23} }
 					]
 				`,
-				ExpectedError: "no type found for given name. Type: 22",
+				ExpectedError: "no type found for given name. Type: 23",
 			},
 		},
 	}
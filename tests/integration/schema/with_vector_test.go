@@ -0,0 +1,93 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchemaCreate_WithVectorField_NoError(t *testing.T) {
+	schemaVersionID := "bafkreiadmsscmgjjbpqklok2rdat6kfucztycvjmrv2xyllcsdqjv4kwxu"
+
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Product {
+						embedding: [Float!] @vector(dimension: 3)
+					}
+				`,
+			},
+			testUtils.GetSchema{
+				VersionID: immutable.Some(schemaVersionID),
+				ExpectedResults: []client.SchemaDescription{
+					{
+						Name:      "Product",
+						VersionID: schemaVersionID,
+						Root:      schemaVersionID,
+						Fields: []client.SchemaFieldDescription{
+							{
+								Name: "_docID",
+								Kind: client.FieldKind_DocID,
+							},
+							{
+								Name: "embedding",
+								Kind: client.NewVectorKind(3),
+								Typ:  client.LWW_REGISTER,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaCreate_WithVectorOnNonFloatArrayField_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Product {
+						embedding: [Int!] @vector(dimension: 3)
+					}
+				`,
+				ExpectedError: "vector field must be of type [Float!]",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaCreate_WithVectorOfZeroDimension_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Product {
+						embedding: [Float!] @vector(dimension: 0)
+					}
+				`,
+				ExpectedError: "vector dimension must be a positive integer",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
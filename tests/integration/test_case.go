@@ -69,6 +69,15 @@ type TestCase struct {
 
 	// Configuration for KMS to be used in the test
 	KMS KMS
+
+	// EnableTimestamps enables the hybrid logical clock timestamps of the blocks created
+	// by the nodes.
+	//
+	// Timestamps are disabled by default, as on the nodes, since they make the CIDs of the
+	// blocks depend on the time at which they are created. When enabled, the wall clock of
+	// each node starts at [TestClockStart] and advances by one second every time it is read,
+	// so that the timestamps are the same on every run.
+	EnableTimestamps bool
 }
 
 // KMS contains the configuration for KMS to be used in the test
@@ -487,6 +496,17 @@ type CreateIndex struct {
 	// If Unique is true, the index will be created as a unique index.
 	Unique bool
 
+	// The type of the index. If not provided, a value index will be created.
+	Type client.IndexType
+
+	// Filter restricts the index to the documents matching it. Optional.
+	Filter map[string]any
+
+	// If Background is true, the existing documents will be indexed in the background.
+	//
+	// [WaitForIndexBuilds] can be used to wait for the build to finish.
+	Background bool
+
 	// Any error expected from the action. Optional.
 	//
 	// String can be a partial, and the test will pass if an error is returned that
@@ -542,6 +562,44 @@ type GetIndexes struct {
 	ExpectedError string
 }
 
+// VerifyIndexes will attempt to verify the secondary indexes of the given collection.
+type VerifyIndexes struct {
+	// NodeID may hold the ID (index) of a node to verify the secondary indexes on.
+	//
+	// If a value is not provided the indexes will be verified on all nodes.
+	NodeID immutable.Option[int]
+
+	// The collection of which the indexes should be verified.
+	CollectionID int
+
+	// The name of the index to verify. If empty, all the indexes of the collection are verified.
+	IndexName string
+
+	// If Repair is true, the inconsistencies found will be fixed.
+	Repair bool
+
+	// The expected results of the verification, one per verified index.
+	ExpectedResults []client.IndexVerifyResult
+
+	// Any error expected from the action. Optional.
+	//
+	// String can be a partial, and the test will pass if an error is returned that
+	// contains this string.
+	ExpectedError string
+}
+
+// WaitForIndexBuilds is an action that instructs the test framework to wait for the background
+// builds of the indexes of the given collection to finish before progressing.
+type WaitForIndexBuilds struct {
+	// NodeID may hold the ID (index) of a node to wait on.
+	//
+	// If a value is not provided the test framework will wait on all nodes.
+	NodeID immutable.Option[int]
+
+	// The collection of which the index builds should be waited for.
+	CollectionID int
+}
+
 // ResultAsserter is an interface that can be implemented to provide custom result
 // assertions.
 type ResultAsserter interface {
@@ -737,6 +795,26 @@ type ClientIntrospectionRequest struct {
 	ExpectedError string
 }
 
+// CompactDAG will attempt to compact the document DAGs using the db api.
+type CompactDAG struct {
+	// NodeID may hold the ID (index) of a node to compact.
+	//
+	// If a value is not provided the compaction will be done on all the nodes.
+	NodeID immutable.Option[int]
+
+	// The compaction configuration.
+	Config client.CompactionConfig
+
+	// The result expected from the compaction. Optional.
+	ExpectedResult immutable.Option[client.CompactionResult]
+
+	// Any error expected from the action. Optional.
+	//
+	// String can be a partial, and the test will pass if an error is returned that
+	// contains this string.
+	ExpectedError string
+}
+
 // BackupExport will attempt to export data from the datastore using the db api.
 type BackupExport struct {
 	// NodeID may hold the ID (index) of a node to generate the backup from.
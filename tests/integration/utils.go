@@ -97,6 +97,8 @@ var (
 const (
 	// subscriptionTimeout is the maximum time to wait for subscription results to be returned.
 	subscriptionTimeout = 1 * time.Second
+	// indexBuildTimeout is the maximum time to wait for background index builds to finish.
+	indexBuildTimeout = 10 * time.Second
 	// Instantiating lenses is expensive, and our tests do not benefit from a large number of them,
 	// so we explicitly set it to a low value.
 	lensPoolSize = 2
@@ -374,12 +376,21 @@ func performAction(
 	case GetIndexes:
 		getIndexes(s, action)
 
+	case WaitForIndexBuilds:
+		waitForIndexBuilds(s, action)
+
+	case VerifyIndexes:
+		verifyIndexes(s, action)
+
 	case BackupExport:
 		backupExport(s, action)
 
 	case BackupImport:
 		backupImport(s, action)
 
+	case CompactDAG:
+		compactDAG(s, action)
+
 	case TransactionCommit:
 		commitTransaction(s, action)
 
@@ -766,7 +777,14 @@ func startNodes(s *state, action Start) {
 		nodeOpts := s.nodeConfigs[nodeIndex]
 		nodeOpts = append(nodeOpts, net.WithListenAddresses(addresses...))
 
-		node.Peer, err = net.NewPeer(s.ctx, node.DB.Blockstore(), node.DB.Encstore(), node.DB.Events(), nodeOpts...)
+		node.Peer, err = net.NewPeer(
+			s.ctx,
+			node.DB.Blockstore(),
+			node.DB.Encstore(),
+			node.DB.Headstore(),
+			node.DB.Events(),
+			nodeOpts...,
+		)
 		require.NoError(s.t, err)
 
 		c, err := setupClient(s, node)
@@ -984,6 +1002,66 @@ func getIndexes(
 	assertExpectedErrorRaised(s.t, s.testCase.Description, action.ExpectedError, expectedErrorRaised)
 }
 
+// verifyIndexes verifies the secondary indexes of the given collection and asserts the results.
+func verifyIndexes(
+	s *state,
+	action VerifyIndexes,
+) {
+	var expectedErrorRaised bool
+
+	nodeIDs, _ := getNodesWithIDs(action.NodeID, s.nodes)
+	for _, nodeID := range nodeIDs {
+		collection := s.collections[nodeID][action.CollectionID]
+		var results []client.IndexVerifyResult
+		err := withRetryOnNode(
+			s.nodes[nodeID],
+			func() error {
+				var err error
+				results, err = collection.VerifyIndexes(s.ctx, client.IndexVerifyOptions{
+					IndexName: action.IndexName,
+					Repair:    action.Repair,
+				})
+				return err
+			},
+		)
+		expectedErrorRaised = AssertError(s.t, s.testCase.Description, err, action.ExpectedError)
+
+		if !expectedErrorRaised {
+			assert.Equal(s.t, action.ExpectedResults, results, s.testCase.Description)
+		}
+	}
+
+	assertExpectedErrorRaised(s.t, s.testCase.Description, action.ExpectedError, expectedErrorRaised)
+}
+
+// waitForIndexBuilds waits until none of the indexes of the given collection are
+// being built in the background.
+func waitForIndexBuilds(
+	s *state,
+	action WaitForIndexBuilds,
+) {
+	nodeIDs, _ := getNodesWithIDs(action.NodeID, s.nodes)
+	for _, nodeID := range nodeIDs {
+		collection := s.collections[nodeID][action.CollectionID]
+		deadline := time.Now().Add(indexBuildTimeout)
+		for {
+			indexes, err := collection.GetIndexes(s.ctx)
+			require.NoError(s.t, err, s.testCase.Description)
+
+			isBuilding := slices.ContainsFunc(indexes, func(index client.IndexDescription) bool {
+				return index.Build != nil && index.Build.State == client.IndexBuildStateBuilding
+			})
+			if !isBuilding {
+				break
+			}
+			if time.Now().After(deadline) {
+				require.Fail(s.t, "timeout waiting for index builds", s.testCase.Description)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+}
+
 func assertIndexesListsEqual(
 	expectedIndexes []client.IndexDescription,
 	actualIndexes []client.IndexDescription,
@@ -1045,6 +1123,16 @@ func assertIndexesEqual(expectedIndex, actualIndex client.IndexDescription,
 	for key := range expectedMap {
 		assert.Equal(t, expectedMap[key], actualMap[key], testDescription)
 	}
+
+	assert.Equal(t, expectedIndex.Filter, actualIndex.Filter, testDescription)
+
+	if expectedIndex.Build != nil {
+		require.NotNil(t, actualIndex.Build, testDescription)
+		assert.Equal(t, expectedIndex.Build.State, actualIndex.Build.State, testDescription)
+		assert.Equal(t, expectedIndex.Build.DocsProcessed, actualIndex.Build.DocsProcessed, testDescription)
+		// the error of a failed build can hold document IDs, so only a part of it is expected
+		assert.Contains(t, actualIndex.Build.Error, expectedIndex.Build.Error, testDescription)
+	}
 }
 
 // updateSchema updates the schema using the given details.
@@ -1646,6 +1734,9 @@ func createIndex(
 		}
 
 		indexDesc.Unique = action.Unique
+		indexDesc.Type = action.Type
+		indexDesc.Filter = action.Filter
+		indexDesc.Background = action.Background
 		err := withRetryOnNode(
 			node,
 			func() error {
@@ -1750,6 +1841,34 @@ func backupImport(
 	assertExpectedErrorRaised(s.t, s.testCase.Description, action.ExpectedError, expectedErrorRaised)
 }
 
+// compactDAG compacts the document DAGs using the db api.
+func compactDAG(
+	s *state,
+	action CompactDAG,
+) {
+	var expectedErrorRaised bool
+
+	_, nodes := getNodesWithIDs(action.NodeID, s.nodes)
+	for _, node := range nodes {
+		var result client.CompactionResult
+		err := withRetryOnNode(
+			node,
+			func() error {
+				var err error
+				result, err = node.CompactDAG(s.ctx, action.Config)
+				return err
+			},
+		)
+		expectedErrorRaised = AssertError(s.t, s.testCase.Description, err, action.ExpectedError)
+
+		if !expectedErrorRaised && action.ExpectedResult.HasValue() {
+			require.Equal(s.t, action.ExpectedResult.Value(), result, s.testCase.Description)
+		}
+	}
+
+	assertExpectedErrorRaised(s.t, s.testCase.Description, action.ExpectedError, expectedErrorRaised)
+}
+
 // withRetryOnNode attempts to perform the given action, retrying up to a DB-defined
 // maximum attempt count if a transaction conflict error is returned.
 //